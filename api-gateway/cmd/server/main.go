@@ -20,6 +20,7 @@ import (
 	"github.com/malytinKonstantin/go-messenger-mono/api-gateway/internal/handlers"
 	"github.com/malytinKonstantin/go-messenger-mono/api-gateway/internal/middleware"
 	"github.com/malytinKonstantin/go-messenger-mono/shared/cache"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/malytinKonstantin/go-messenger-mono/shared/ratelimiter"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -74,7 +75,9 @@ func run() error {
 		}
 	}()
 
-	app := setupFiberApp(grpcMux)
+	broker := pubsub.NewRedisBroker(cache.GetRedisClient())
+
+	app := setupFiberApp(grpcMux, broker)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -175,13 +178,19 @@ func setupGRPCMux(ctx context.Context, grpcMux *runtime.ServeMux) error {
 	return nil
 }
 
-func setupFiberApp(grpcMux *runtime.ServeMux) *fiber.App {
-	app := fiber.New()
+func setupFiberApp(grpcMux *runtime.ServeMux, broker pubsub.Broker) *fiber.App {
+	app := fiber.New(fiber.Config{
+		// Загрузка вложений передается через шлюз целиком
+		BodyLimit: handlers.MaxAttachmentRequestSize,
+	})
 	app.Use(recover.New())
 	app.Use(ratelimiter.NewRateLimiter(100, time.Second))
 	app.Get("/health", func(c *fiber.Ctx) error {
 		return c.SendString("OK")
 	})
+	// События реального времени для клиентов
+	app.Use("/v1/ws", handlers.WebSocketAuth())
+	app.Get("/v1/ws", handlers.NewWebSocketHandler(broker))
 	app.All("/*", adaptor.HTTPHandler(grpcMux))
 	return app
 }
@@ -26,6 +26,9 @@ func RegisterFriendshipService(ctx context.Context, mux *runtime.ServeMux, endpo
 			{"POST", "/v1/friendship/remove-friend", withJWTValidation(handleRemoveFriend(client))},
 			{"GET", "/v1/friendship/friends-list", withJWTValidation(handleGetFriendsList(client))},
 			{"GET", "/v1/friendship/pending-requests", withJWTValidation(handleGetPendingRequests(client))},
+			// GetRelationship доступен только сервисам: по нему можно узнать, кто заблокировал пользователя
+			{"POST", "/v1/friendship/block-user", withJWTValidation(handleBlockUser(client))},
+			{"POST", "/v1/friendship/unblock-user", withJWTValidation(handleUnblockUser(client))},
 		}
 
 		for _, h := range handlers {
@@ -127,6 +130,50 @@ func handleRemoveFriend(client friendship_service.FriendshipServiceClient) runti
 	}
 }
 
+func handleBlockUser(client friendship_service.FriendshipServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req friendship_service.BlockUserRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.BlockUser(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*friendship_service.BlockUserResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUnblockUser(client friendship_service.FriendshipServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req friendship_service.UnblockUserRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UnblockUser(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*friendship_service.UnblockUserResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
 func handleGetFriendsList(client friendship_service.FriendshipServiceClient) runtime.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		userID := parseStringParam(r, "user_id", "")
@@ -2,7 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -11,9 +16,23 @@ import (
 	messaging_service "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
 )
 
+const (
+	// MaxAttachmentRequestSize — максимальный размер запроса загрузки вложения с учетом заголовков multipart.
+	// Ограничения по типам файлов проверяет messaging-service.
+	MaxAttachmentRequestSize = 101 << 20
+	// Размер части файла, передаваемой в messaging-service
+	attachmentChunkSize = 64 * 1024
+	// Таймаут передачи вложения
+	attachmentTimeout = 5 * time.Minute
+	// Таймаут выгрузки беседы, длинная история читается постранично
+	exportTimeout = 10 * time.Minute
+)
+
 func RegisterMessagingService(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
 	return registerService(ctx, mux, endpoint, opts, func(conn *grpc.ClientConn) error {
 		client := messaging_service.NewMessagingServiceClient(conn)
+		// Каталог ключей работает в том же messaging-service
+		keyClient := messaging_service.NewKeyDirectoryServiceClient(conn)
 
 		handlers := []struct {
 			method  string
@@ -23,6 +42,47 @@ func RegisterMessagingService(ctx context.Context, mux *runtime.ServeMux, endpoi
 			{"POST", "/v1/messaging/send-message", withJWTValidation(handleSendMessage(client))},
 			{"GET", "/v1/messaging/messages", withJWTValidation(handleGetMessages(client))},
 			{"POST", "/v1/messaging/update-message-status", withJWTValidation(handleUpdateMessageStatus(client))},
+			{"POST", "/v1/messaging/edit-message", withJWTValidation(handleEditMessage(client))},
+			{"POST", "/v1/messaging/delete-message", withJWTValidation(handleDeleteMessage(client))},
+			{"GET", "/v1/messaging/messages/{message_id}/edits", withJWTValidation(handleGetMessageEditHistory(client))},
+			{"POST", "/v1/messaging/messages/{message_id}/reactions", withJWTValidation(handleAddReaction(client))},
+			{"POST", "/v1/messaging/messages/{message_id}/reactions/remove", withJWTValidation(handleRemoveReaction(client))},
+			{"GET", "/v1/messaging/messages/{message_id}/reactions", withJWTValidation(handleListReactions(client))},
+			{"GET", "/v1/messaging/messages/{message_id}/thread", withJWTValidation(handleGetThread(client))},
+			{"GET", "/v1/messaging/search", withJWTValidation(handleSearchMessages(client))},
+			{"POST", "/v1/messaging/attachments", withJWTValidation(handleUploadAttachment(client))},
+			{"GET", "/v1/messaging/attachments/{attachment_id}/url", withJWTValidation(handleGetAttachmentURL(client))},
+			// Ссылка на скачивание подписана messaging-service и не требует JWT
+			{"GET", "/v1/messaging/attachments/{attachment_id}/download", handleDownloadAttachment(client)},
+			{"POST", "/v1/messaging/conversations", withJWTValidation(handleCreateConversation(client))},
+			{"GET", "/v1/messaging/conversations", withJWTValidation(handleListConversations(client))},
+			{"GET", "/v1/messaging/conversations/{conversation_id}", withJWTValidation(handleGetConversation(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/members", withJWTValidation(handleAddConversationMembers(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/remove-member", withJWTValidation(handleRemoveConversationMember(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/member-role", withJWTValidation(handleUpdateConversationMemberRole(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/leave", withJWTValidation(handleLeaveConversation(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/read", withJWTValidation(handleMarkConversationRead(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/message-ttl", withJWTValidation(handleSetMessageTTL(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/typing", withJWTValidation(handleSetTyping(client))},
+			{"POST", "/v1/messaging/presence", withJWTValidation(handleUpdatePresence(client))},
+			{"GET", "/v1/messaging/presence", withJWTValidation(handleGetPresence(client))},
+			{"POST", "/v1/messaging/presence/settings", withJWTValidation(handleUpdatePresenceSettings(client))},
+			{"POST", "/v1/messaging/settings", withJWTValidation(handleUpdateMessagingSettings(client))},
+			{"GET", "/v1/messaging/settings", withJWTValidation(handleGetMessagingSettings(client))},
+			{"POST", "/v1/messaging/messages/{message_id}/pin", withJWTValidation(handlePinMessage(client))},
+			{"POST", "/v1/messaging/messages/{message_id}/unpin", withJWTValidation(handleUnpinMessage(client))},
+			{"GET", "/v1/messaging/conversations/{conversation_id}/pins", withJWTValidation(handleListPinnedMessages(client))},
+			{"GET", "/v1/messaging/scheduled-messages", withJWTValidation(handleListScheduledMessages(client))},
+			{"POST", "/v1/messaging/scheduled-messages/{scheduled_message_id}/cancel", withJWTValidation(handleCancelScheduledMessage(client))},
+			{"POST", "/v1/messaging/scheduled-messages/{scheduled_message_id}/reschedule", withJWTValidation(handleRescheduleMessage(client))},
+			{"GET", "/v1/messaging/conversations/{conversation_id}/export", withJWTValidation(handleExportConversation(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/archive", withJWTValidation(handleSetConversationArchived(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/mute", withJWTValidation(handleSetConversationMuted(client))},
+			{"POST", "/v1/messaging/conversations/{conversation_id}/unread", withJWTValidation(handleMarkConversationUnread(client))},
+			{"GET", "/v1/messaging/updates", withJWTValidation(handleGetUpdates(client))},
+			{"POST", "/v1/keys/devices/{device_id}", withJWTValidation(handleUploadKeys(keyClient))},
+			{"GET", "/v1/keys/devices/{device_id}/status", withJWTValidation(handleGetPreKeyStatus(keyClient))},
+			{"GET", "/v1/keys/users/{target_user_id}/bundles", withJWTValidation(handleGetPreKeyBundles(keyClient))},
 		}
 
 		for _, h := range handlers {
@@ -38,7 +98,10 @@ func RegisterMessagingService(ctx context.Context, mux *runtime.ServeMux, endpoi
 func handleSendMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		var req messaging_service.SendMessageRequest
-		if err := decodeJSONBody(w, r, &req); err != nil {
+		if err := decodeProtoJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "sender_id", &req.SenderId) {
 			return
 		}
 
@@ -62,14 +125,27 @@ func handleGetMessages(client messaging_service.MessagingServiceClient) runtime.
 		var req messaging_service.GetMessagesRequest
 		req.UserId = parseStringParam(r, "user_id", "")
 		req.ConversationUserId = parseStringParam(r, "conversation_user_id", "")
+		req.ConversationId = parseStringParam(r, "conversation_id", "")
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
 
-		if req.UserId == "" || req.ConversationUserId == "" {
-			http.Error(w, "Parameters 'user_id' and 'conversation_user_id' are required", http.StatusBadRequest)
+		if req.ConversationUserId == "" && req.ConversationId == "" {
+			http.Error(w, "Parameter 'conversation_user_id' or 'conversation_id' is required", http.StatusBadRequest)
 			return
 		}
 
 		req.Limit = int32(parseIntParam(r, "limit", 0))
-		req.Offset = int32(parseIntParam(r, "offset", 0))
+		req.PageToken = parseStringParam(r, "page_token", "")
+		switch parseStringParam(r, "direction", "older") {
+		case "older":
+			req.Direction = messaging_service.PageDirection_PAGE_DIRECTION_OLDER
+		case "newer":
+			req.Direction = messaging_service.PageDirection_PAGE_DIRECTION_NEWER
+		default:
+			http.Error(w, "Parameter 'direction' must be 'older' or 'newer'", http.StatusBadRequest)
+			return
+		}
 
 		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
 		defer cancel()
@@ -92,6 +168,9 @@ func handleUpdateMessageStatus(client messaging_service.MessagingServiceClient)
 		if err := decodeJSONBody(w, r, &req); err != nil {
 			return
 		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
 
 		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
 		defer cancel()
@@ -107,3 +186,1329 @@ func handleUpdateMessageStatus(client messaging_service.MessagingServiceClient)
 		writeJSONResponse(w, http.StatusOK, resp)
 	}
 }
+
+func handleAddReaction(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.AddReactionRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.MessageId = messageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.AddReaction(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.AddReactionResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleRemoveReaction(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.RemoveReactionRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.MessageId = messageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.RemoveReaction(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.RemoveReactionResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleListReactions(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.ListReactionsRequest{
+			MessageId: messageID,
+			UserId:    parseStringParam(r, "user_id", ""),
+			Emoji:     parseStringParam(r, "emoji", ""),
+			PageToken: parseStringParam(r, "page_token", ""),
+			Limit:     int32(parseIntParam(r, "limit", 0)),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.ListReactions(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.ListReactionsResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetThread(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetThreadRequest{
+			MessageId: messageID,
+			UserId:    parseStringParam(r, "user_id", ""),
+			PageToken: parseStringParam(r, "page_token", ""),
+			Limit:     int32(parseIntParam(r, "limit", 0)),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetThread(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetThreadResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetAttachmentURL(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		attachmentID, ok := pathParams["attachment_id"]
+		if !ok {
+			http.Error(w, "attachment_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetAttachmentURLRequest{
+			AttachmentId: attachmentID,
+			UserId:       parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetAttachmentURL(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetAttachmentURLResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+// handleUploadAttachment принимает файл в multipart/form-data и передает его потоком в messaging-service.
+// Загружает владелец JWT, необязательное поле uploader_id проверяется, только если предшествует полю file.
+func handleUploadAttachment(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		r.Body = http.MaxBytesReader(w, r.Body, MaxAttachmentRequestSize)
+		reader, err := r.MultipartReader()
+		if err != nil {
+			http.Error(w, "multipart/form-data body is required", http.StatusBadRequest)
+			return
+		}
+
+		var uploaderID string
+		if !bindAuthenticatedUser(w, r, "uploader_id", &uploaderID) {
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				http.Error(w, "Field 'file' is required", http.StatusBadRequest)
+				return
+			}
+			if err != nil {
+				http.Error(w, "Invalid multipart body: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			switch part.FormName() {
+			case "uploader_id":
+				value, err := io.ReadAll(io.LimitReader(part, 64))
+				if err != nil {
+					http.Error(w, "Invalid multipart body: "+err.Error(), http.StatusBadRequest)
+					return
+				}
+				if formUploaderID := string(value); !bindAuthenticatedUser(w, r, "uploader_id", &formUploaderID) {
+					return
+				}
+			case "file":
+				uploadAttachment(w, r, client, uploaderID, part.FileName(), part)
+				return
+			}
+		}
+	}
+}
+
+func uploadAttachment(w http.ResponseWriter, r *http.Request, client messaging_service.MessagingServiceClient, uploaderID, fileName string, content io.Reader) {
+	ctx, cancel := withTimeout(r.Context(), attachmentTimeout)
+	defer cancel()
+
+	respInterface, err := cb.Execute(func() (interface{}, error) {
+		stream, err := client.UploadAttachment(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := stream.Send(&messaging_service.UploadAttachmentRequest{
+			Data: &messaging_service.UploadAttachmentRequest_Info{
+				Info: &messaging_service.UploadAttachmentInfo{
+					UploaderId: uploaderID,
+					FileName:   fileName,
+				},
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		for {
+			chunk := make([]byte, attachmentChunkSize)
+			n, readErr := io.ReadFull(content, chunk)
+			if n > 0 {
+				if err := stream.Send(&messaging_service.UploadAttachmentRequest{
+					Data: &messaging_service.UploadAttachmentRequest_Chunk{Chunk: chunk[:n]},
+				}); err != nil {
+					// Причину отказа сервер возвращает при закрытии потока
+					if errors.Is(err, io.EOF) {
+						return stream.CloseAndRecv()
+					}
+					return nil, err
+				}
+			}
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				return stream.CloseAndRecv()
+			}
+			if readErr != nil {
+				return nil, readErr
+			}
+		}
+	})
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "attachment is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		handleGrpcError(w, err)
+		return
+	}
+	resp := respInterface.(*messaging_service.UploadAttachmentResponse)
+	writeJSONResponse(w, http.StatusCreated, resp)
+}
+
+// handleDownloadAttachment отдает содержимое вложения по подписанной ссылке
+func handleDownloadAttachment(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		attachmentID, ok := pathParams["attachment_id"]
+		if !ok {
+			http.Error(w, "attachment_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.DownloadAttachmentRequest{
+			AttachmentId: attachmentID,
+			Token:        parseStringParam(r, "token", ""),
+		}
+
+		ctx, cancel := withTimeout(r.Context(), attachmentTimeout)
+		defer cancel()
+
+		// Первое сообщение потока содержит сведения о файле, ошибки доступа приходят до него
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			stream, err := client.DownloadAttachment(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			first, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if first.GetAttachment() == nil {
+				return nil, errors.New("attachment info is missing in download stream")
+			}
+			return &attachmentDownload{info: first.GetAttachment(), stream: stream}, nil
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		download := respInterface.(*attachmentDownload)
+
+		w.Header().Set("Content-Type", download.info.MimeType)
+		w.Header().Set("Content-Length", strconv.FormatInt(download.info.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": download.info.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+
+		// Заголовки уже отправлены, поэтому обрыв потока можно только залогировать
+		for {
+			resp, err := download.stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				log.Printf("error downloading attachment %s: %v", attachmentID, err)
+				return
+			}
+			if _, err := w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+type attachmentDownload struct {
+	info   *messaging_service.Attachment
+	stream messaging_service.MessagingService_DownloadAttachmentClient
+}
+
+// handleExportConversation отдает историю беседы файлом JSON Lines или HTML
+func handleExportConversation(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.ExportConversationRequest{
+			ConversationId: conversationID,
+			UserId:         parseStringParam(r, "user_id", ""),
+		}
+		// Выгрузка идет от имени владельца токена: доступ службы поддержки определяется по его идентификатору
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+		switch parseStringParam(r, "format", "jsonl") {
+		case "jsonl":
+			req.Format = messaging_service.ExportFormat_EXPORT_FORMAT_JSONL
+		case "html":
+			req.Format = messaging_service.ExportFormat_EXPORT_FORMAT_HTML
+		default:
+			http.Error(w, "Parameter 'format' must be 'jsonl' or 'html'", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), exportTimeout)
+		defer cancel()
+
+		// Первое сообщение потока содержит сведения о файле, ошибки доступа приходят до него
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			stream, err := client.ExportConversation(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			first, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if first.GetInfo() == nil {
+				return nil, errors.New("export info is missing in export stream")
+			}
+			return &conversationExport{info: first.GetInfo(), stream: stream}, nil
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		download := respInterface.(*conversationExport)
+
+		w.Header().Set("Content-Type", download.info.MimeType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": download.info.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+
+		// Заголовки уже отправлены, поэтому обрыв потока можно только залогировать
+		for {
+			resp, err := download.stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				log.Printf("error exporting conversation %s: %v", conversationID, err)
+				return
+			}
+			if _, err := w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+type conversationExport struct {
+	info   *messaging_service.ExportInfo
+	stream messaging_service.MessagingService_ExportConversationClient
+}
+
+func handleCreateConversation(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.CreateConversationRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "creator_id", &req.CreatorId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.CreateConversation(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.CreateConversationResponse)
+		writeJSONResponse(w, http.StatusCreated, resp)
+	}
+}
+
+func handleGetConversation(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetConversationRequest{
+			ConversationId: conversationID,
+			UserId:         parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetConversation(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetConversationResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleListConversations(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.ListConversationsRequest{
+			UserId:    parseStringParam(r, "user_id", ""),
+			PageToken: parseStringParam(r, "page_token", ""),
+			Limit:     int32(parseIntParam(r, "limit", 0)),
+			Archived:  parseBoolParam(r, "archived", false),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.ListConversations(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.ListConversationsResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleAddConversationMembers(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.AddConversationMembersRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.AddConversationMembers(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.AddConversationMembersResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleRemoveConversationMember(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.RemoveConversationMemberRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.RemoveConversationMember(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.RemoveConversationMemberResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUpdateConversationMemberRole(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.UpdateConversationMemberRoleRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UpdateConversationMemberRole(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UpdateConversationMemberRoleResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleLeaveConversation(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.LeaveConversationRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.LeaveConversation(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.LeaveConversationResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleMarkConversationRead(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.MarkConversationReadRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.MarkConversationRead(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.MarkConversationReadResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleSetTyping(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.SetTypingRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.SetTyping(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.SetTypingResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUpdatePresence(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.UpdatePresenceRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UpdatePresence(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UpdatePresenceResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetPresence(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.GetPresenceRequest{
+			UserId:  parseStringParam(r, "user_id", ""),
+			UserIds: r.URL.Query()["user_ids"],
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetPresence(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetPresenceResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUpdatePresenceSettings(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.UpdatePresenceSettingsRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UpdatePresenceSettings(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UpdatePresenceSettingsResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleSearchMessages(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.SearchMessagesRequest{
+			UserId:         parseStringParam(r, "user_id", ""),
+			Query:          parseStringParam(r, "query", ""),
+			ConversationId: parseStringParam(r, "conversation_id", ""),
+			SenderId:       parseStringParam(r, "sender_id", ""),
+			From:           int64(parseIntParam(r, "from", 0)),
+			To:             int64(parseIntParam(r, "to", 0)),
+			Limit:          int32(parseIntParam(r, "limit", 0)),
+			PageToken:      parseStringParam(r, "page_token", ""),
+		}
+
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+		if req.Query == "" {
+			http.Error(w, "Parameter 'query' is required", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.SearchMessages(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.SearchMessagesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleSetMessageTTL(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.SetMessageTTLRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.SetMessageTTL(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.SetMessageTTLResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUploadKeys(client messaging_service.KeyDirectoryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		deviceID, ok := pathParams["device_id"]
+		if !ok {
+			http.Error(w, "device_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.UploadKeysRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.DeviceId = deviceID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UploadKeys(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UploadKeysResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetPreKeyBundles(client messaging_service.KeyDirectoryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		targetUserID, ok := pathParams["target_user_id"]
+		if !ok {
+			http.Error(w, "target_user_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetPreKeyBundlesRequest{
+			TargetUserId: targetUserID,
+			UserId:       parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetPreKeyBundles(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetPreKeyBundlesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetPreKeyStatus(client messaging_service.KeyDirectoryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		deviceID, ok := pathParams["device_id"]
+		if !ok {
+			http.Error(w, "device_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetPreKeyStatusRequest{
+			DeviceId: deviceID,
+			UserId:   parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetPreKeyStatus(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetPreKeyStatusResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUpdateMessagingSettings(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.UpdateMessagingSettingsRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UpdateMessagingSettings(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UpdateMessagingSettingsResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetMessagingSettings(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.GetMessagingSettingsRequest{
+			UserId: parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetMessagingSettings(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetMessagingSettingsResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handlePinMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.PinMessageRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.MessageId = messageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.PinMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.PinMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleUnpinMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.UnpinMessageRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.MessageId = messageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.UnpinMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.UnpinMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleListPinnedMessages(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.ListPinnedMessagesRequest{
+			ConversationId: conversationID,
+			UserId:         parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.ListPinnedMessages(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.ListPinnedMessagesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleListScheduledMessages(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.ListScheduledMessagesRequest{
+			UserId: parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.ListScheduledMessages(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.ListScheduledMessagesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleCancelScheduledMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		scheduledMessageID, ok := pathParams["scheduled_message_id"]
+		if !ok {
+			http.Error(w, "scheduled_message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.CancelScheduledMessageRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ScheduledMessageId = scheduledMessageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.CancelScheduledMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.CancelScheduledMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleRescheduleMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		scheduledMessageID, ok := pathParams["scheduled_message_id"]
+		if !ok {
+			http.Error(w, "scheduled_message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.RescheduleMessageRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ScheduledMessageId = scheduledMessageID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.RescheduleMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.RescheduleMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleSetConversationArchived(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.SetConversationArchivedRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.SetConversationArchived(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.SetConversationArchivedResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleSetConversationMuted(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.SetConversationMutedRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.SetConversationMuted(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.SetConversationMutedResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleMarkConversationUnread(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		conversationID, ok := pathParams["conversation_id"]
+		if !ok {
+			http.Error(w, "conversation_id is not specified", http.StatusBadRequest)
+			return
+		}
+		var req messaging_service.MarkConversationUnreadRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		req.ConversationId = conversationID
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.MarkConversationUnread(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.MarkConversationUnreadResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetUpdates(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &messaging_service.GetUpdatesRequest{
+			UserId:   parseStringParam(r, "user_id", ""),
+			SinceSeq: int64(parseIntParam(r, "since_seq", 0)),
+			Limit:    int32(parseIntParam(r, "limit", 0)),
+		}
+
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetUpdates(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetUpdatesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleEditMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.EditMessageRequest
+		if err := decodeProtoJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.EditMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.EditMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleDeleteMessage(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req messaging_service.DeleteMessageRequest
+		if err := decodeJSONBody(w, r, &req); err != nil {
+			return
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.DeleteMessage(ctx, &req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.DeleteMessageResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetMessageEditHistory(client messaging_service.MessagingServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		messageID, ok := pathParams["message_id"]
+		if !ok {
+			http.Error(w, "message_id is not specified", http.StatusBadRequest)
+			return
+		}
+		req := &messaging_service.GetMessageEditHistoryRequest{
+			MessageId: messageID,
+			UserId:    parseStringParam(r, "user_id", ""),
+		}
+		if !bindAuthenticatedUser(w, r, "user_id", &req.UserId) {
+			return
+		}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetMessageEditHistory(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*messaging_service.GetMessageEditHistoryResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
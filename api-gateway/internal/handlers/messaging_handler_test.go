@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	messaging_service "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+const (
+	testJWTSecret = "test-secret"
+	testUserID    = "6f1c2b1e-8a4d-4c3e-9b7a-1d2e3f4a5b6c"
+	testOtherID   = "0b9a8c7d-6e5f-4a3b-8c2d-1e0f9a8b7c6d"
+)
+
+// fakeMessagingClient запоминает запросы, дошедшие до messaging-service
+type fakeMessagingClient struct {
+	messaging_service.MessagingServiceClient
+	sent   *messaging_service.SendMessageRequest
+	status *messaging_service.UpdateMessageStatusRequest
+	edited *messaging_service.EditMessageRequest
+}
+
+func (c *fakeMessagingClient) SendMessage(ctx context.Context, in *messaging_service.SendMessageRequest, opts ...grpc.CallOption) (*messaging_service.SendMessageResponse, error) {
+	c.sent = in
+	return &messaging_service.SendMessageResponse{}, nil
+}
+
+func (c *fakeMessagingClient) UpdateMessageStatus(ctx context.Context, in *messaging_service.UpdateMessageStatusRequest, opts ...grpc.CallOption) (*messaging_service.UpdateMessageStatusResponse, error) {
+	c.status = in
+	return &messaging_service.UpdateMessageStatusResponse{}, nil
+}
+
+func (c *fakeMessagingClient) EditMessage(ctx context.Context, in *messaging_service.EditMessageRequest, opts ...grpc.CallOption) (*messaging_service.EditMessageResponse, error) {
+	c.edited = in
+	return &messaging_service.EditMessageResponse{}, nil
+}
+
+// newAuthorizedRequest готовит запрос с JWT пользователя testUserID
+func newAuthorizedRequest(t *testing.T, method, target, body string) *http.Request {
+	t.Helper()
+	viper.Set("JWT_SECRET", testJWTSecret)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": testUserID,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestHandleSendMessageSpoofedSender(t *testing.T) {
+	client := &fakeMessagingClient{}
+	w := httptest.NewRecorder()
+	r := newAuthorizedRequest(t, http.MethodPost, "/v1/messaging/send-message",
+		`{"sender_id":"`+testOtherID+`","recipient_id":"`+testOtherID+`","content":"hi"}`)
+
+	withJWTValidation(handleSendMessage(client))(w, r, nil)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if client.sent != nil {
+		t.Fatal("request with a spoofed sender_id reached messaging-service")
+	}
+}
+
+func TestHandleSendMessageSenderFromToken(t *testing.T) {
+	client := &fakeMessagingClient{}
+	w := httptest.NewRecorder()
+	r := newAuthorizedRequest(t, http.MethodPost, "/v1/messaging/send-message",
+		`{"recipient_id":"`+testOtherID+`","content":"hi"}`)
+
+	withJWTValidation(handleSendMessage(client))(w, r, nil)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if client.sent.GetSenderId() != testUserID {
+		t.Fatalf("expected sender_id %s from token, got %q", testUserID, client.sent.GetSenderId())
+	}
+}
+
+func TestHandleUpdateMessageStatusSpoofedRecipient(t *testing.T) {
+	client := &fakeMessagingClient{}
+	w := httptest.NewRecorder()
+	r := newAuthorizedRequest(t, http.MethodPost, "/v1/messaging/update-message-status",
+		`{"message_id":"`+testOtherID+`","user_id":"`+testOtherID+`","status":3}`)
+
+	withJWTValidation(handleUpdateMessageStatus(client))(w, r, nil)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if client.status != nil {
+		t.Fatal("request with a spoofed user_id reached messaging-service")
+	}
+}
+
+func TestHandleEditMessageSpoofedSender(t *testing.T) {
+	client := &fakeMessagingClient{}
+	w := httptest.NewRecorder()
+	r := newAuthorizedRequest(t, http.MethodPost, "/v1/messaging/edit-message",
+		`{"message_id":"`+testOtherID+`","user_id":"`+testOtherID+`","content":"rewritten"}`)
+
+	withJWTValidation(handleEditMessage(client))(w, r, nil)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if client.edited != nil {
+		t.Fatal("request with a spoofed user_id reached messaging-service")
+	}
+}
@@ -24,6 +24,7 @@ func RegisterUserService(ctx context.Context, mux *runtime.ServeMux, endpoint st
 			{"POST", "/v1/users", withJWTValidation(handleCreateUserProfile(client))},
 			{"PUT", "/v1/users/{user_id}", withJWTValidation(handleUpdateUserProfile(client))},
 			{"GET", "/v1/users/search", withJWTValidation(handleSearchUsers(client))},
+			{"GET", "/v1/users/by-nicknames", withJWTValidation(handleGetUsersByNicknames(client))},
 		}
 
 		for _, h := range handlers {
@@ -140,3 +141,27 @@ func handleSearchUsers(client user_service.UserServiceClient) runtime.HandlerFun
 		writeJSONResponse(w, http.StatusOK, resp)
 	}
 }
+
+func handleGetUsersByNicknames(client user_service.UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		nicknames := r.URL.Query()["nicknames"]
+		if len(nicknames) == 0 {
+			http.Error(w, "nicknames parameter is required", http.StatusBadRequest)
+			return
+		}
+		req := &user_service.GetUsersByNicknamesRequest{Nicknames: nicknames}
+
+		ctx, cancel := withTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+
+		respInterface, err := cb.Execute(func() (interface{}, error) {
+			return client.GetUsersByNicknames(ctx, req)
+		})
+		if err != nil {
+			handleGrpcError(w, err)
+			return
+		}
+		resp := respInterface.(*user_service.GetUsersByNicknamesResponse)
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
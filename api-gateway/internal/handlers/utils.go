@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,6 +18,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var cb *circuitbreaker.CircuitBreaker
@@ -54,6 +57,19 @@ func parseIntParam(r *http.Request, name string, defaultValue int) int {
 	return intValue
 }
 
+// parseBoolParam извлекает логический параметр из URL-запроса.
+func parseBoolParam(r *http.Request, name string, defaultValue bool) bool {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue
+	}
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue
+	}
+	return boolValue
+}
+
 // parseStringParam извлекает строковый параметр из URL-запроса.
 func parseStringParam(r *http.Request, name string, defaultValue string) string {
 	value := r.URL.Query().Get(name)
@@ -84,6 +100,20 @@ func decodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) err
 	return nil
 }
 
+// decodeProtoJSONBody декодирует тело запроса в proto-сообщение.
+// В отличие от decodeJSONBody разбирает oneof-поля и имена значений enum, неизвестные поля пропускаются.
+func decodeProtoJSONBody(w http.ResponseWriter, r *http.Request, dst proto.Message) error {
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, dst)
+	}
+	if err != nil {
+		http.Error(w, "Invalid JSON format: "+err.Error(), http.StatusBadRequest)
+		return err
+	}
+	return nil
+}
+
 // writeJSONResponse записывает ответ в формате JSON.
 func writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -106,29 +136,84 @@ func withJWTValidation(handler runtime.HandlerFunc) runtime.HandlerFunc {
 			return
 		}
 
-		tokenString := parts[1]
-		secretKey := []byte(viper.GetString("JWT_SECRET"))
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("invalid token signing method")
-			}
-			return secretKey, nil
-		})
-
-		if err != nil || !token.Valid {
+		claims, err := parseJWT(parts[1])
+		if err != nil {
 			http.Error(w, "invalid token", http.StatusUnauthorized)
 			return
 		}
 
-		claims, _ := token.Claims.(jwt.MapClaims)
-		md := metadata.Pairs("user", claims["user"].(string))
+		md := metadata.Pairs("user", claims.UserID)
 		ctx := metadata.NewIncomingContext(r.Context(), md)
 
 		handler(w, r.WithContext(ctx), pathParams)
 	}
 }
 
+// authenticatedUserID возвращает пользователя из JWT, проверенного withJWTValidation
+func authenticatedUserID(r *http.Request) string {
+	md, ok := metadata.FromIncomingContext(r.Context())
+	if !ok {
+		return ""
+	}
+	if users := md.Get("user"); len(users) > 0 {
+		return users[0]
+	}
+	return ""
+}
+
+// bindAuthenticatedUser подставляет в поле пользователя запроса владельца JWT.
+// Значение, переданное клиентом и отличное от владельца токена, отклоняется
+func bindAuthenticatedUser(w http.ResponseWriter, r *http.Request, field string, userID *string) bool {
+	authenticated := authenticatedUserID(r)
+	if authenticated == "" {
+		http.Error(w, "user is not authenticated", http.StatusUnauthorized)
+		return false
+	}
+	if *userID != "" && *userID != authenticated {
+		http.Error(w, field+" does not match the authenticated user", http.StatusForbidden)
+		return false
+	}
+	*userID = authenticated
+	return true
+}
+
+// jwtClaims — данные пользователя из проверенного JWT
+type jwtClaims struct {
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// parseJWT проверяет подпись и срок действия токена и извлекает из него данные пользователя.
+func parseJWT(tokenString string) (*jwtClaims, error) {
+	secretKey := []byte(viper.GetString("JWT_SECRET"))
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid token signing method")
+		}
+		return secretKey, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	userID, _ := claims["user_id"].(string)
+	if userID == "" {
+		return nil, errors.New("token does not contain user_id")
+	}
+
+	result := &jwtClaims{UserID: userID}
+	if exp, ok := claims["exp"].(float64); ok {
+		result.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+	return result, nil
+}
+
 // extractAndForwardAuthHeader извлекает заголовок Authorization из HTTP-запроса и добавляет его в контекст.
 func extractAndForwardAuthHeader(ctx context.Context, r *http.Request) context.Context {
 	authHeader := r.Header.Get("Authorization")
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
+)
+
+const (
+	// Интервал отправки ping клиенту
+	wsPingInterval = 30 * time.Second
+	// Время ожидания pong, после которого соединение считается потерянным
+	wsPongWait = 60 * time.Second
+	// Таймаут записи одного кадра
+	wsWriteWait = 10 * time.Second
+	// Максимальный размер сообщения от клиента
+	wsMaxMessageSize = 4096
+
+	wsLocalUserID      = "ws_user_id"
+	wsLocalExpiresAt   = "ws_expires_at"
+	wsLocalLastEventID = "ws_last_event_id"
+)
+
+// wsEvent — событие, отправляемое клиенту по WebSocket.
+// Тип совпадает с типом события в брокере: message.created, message.status_changed,
+// notification.created, friend_request.received.
+type wsEvent struct {
+	ID      string          `json:"id"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// WebSocketAuth проверяет JWT один раз при апгрейде соединения.
+// Токен передается в заголовке Authorization или в параметре access_token,
+// так как браузерный WebSocket API не позволяет задавать заголовки.
+func WebSocketAuth() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !websocket.IsWebSocketUpgrade(c) {
+			return fiber.ErrUpgradeRequired
+		}
+
+		tokenString := c.Query("access_token")
+		if tokenString == "" {
+			parts := strings.SplitN(c.Get(fiber.HeaderAuthorization), " ", 2)
+			if len(parts) == 2 && strings.EqualFold(parts[0], "Bearer") {
+				tokenString = parts[1]
+			}
+		}
+		if tokenString == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "missing access token")
+		}
+
+		claims, err := parseJWT(tokenString)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid token")
+		}
+
+		// Идентификатор последнего полученного события для продолжения потока после переподключения
+		lastEventID := c.Query("last_event_id")
+		if lastEventID == "" {
+			lastEventID = c.Get("Last-Event-ID")
+		}
+
+		c.Locals(wsLocalUserID, claims.UserID)
+		c.Locals(wsLocalExpiresAt, claims.ExpiresAt)
+		c.Locals(wsLocalLastEventID, lastEventID)
+		return c.Next()
+	}
+}
+
+// NewWebSocketHandler пересылает клиенту события из персонального топика пользователя
+func NewWebSocketHandler(broker pubsub.Broker) fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		userID, _ := conn.Locals(wsLocalUserID).(string)
+		expiresAt, _ := conn.Locals(wsLocalExpiresAt).(time.Time)
+		lastEventID, _ := conn.Locals(wsLocalLastEventID).(string)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sub, err := broker.Subscribe(ctx, pubsub.UserTopic(userID), lastEventID)
+		if err != nil {
+			log.Printf("error subscribing user %s to events: %v", userID, err)
+			closeWebSocket(conn, websocket.CloseInternalServerErr, "subscription failed")
+			return
+		}
+
+		// Чтение нужно для обработки pong и закрытия соединения со стороны клиента
+		closed := make(chan struct{})
+		go readWebSocket(conn, closed)
+		defer func() {
+			conn.Close()
+			<-closed
+		}()
+
+		// Закрытие с кодом и ожиданием ответного кадра закрытия от клиента
+		closeWith := func(code int, reason string) {
+			closeWebSocket(conn, code, reason)
+			select {
+			case <-closed:
+			case <-time.After(wsWriteWait):
+			}
+		}
+
+		ping := time.NewTicker(wsPingInterval)
+		defer ping.Stop()
+
+		var expired <-chan time.Time
+		if !expiresAt.IsZero() {
+			timer := time.NewTimer(time.Until(expiresAt))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		for {
+			select {
+			case <-closed:
+				return
+			case msg, ok := <-sub.Messages():
+				if !ok {
+					closeWith(websocket.CloseServiceRestart, "event stream closed")
+					return
+				}
+				data, err := json.Marshal(wsEvent{ID: msg.ID, Type: msg.Type, Payload: msg.Payload})
+				if err != nil {
+					log.Printf("error encoding event %s: %v", msg.ID, err)
+					continue
+				}
+				conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+				if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+					return
+				}
+			case <-ping.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+					return
+				}
+			case <-expired:
+				closeWith(websocket.ClosePolicyViolation, "token expired")
+				return
+			}
+		}
+	})
+}
+
+func readWebSocket(conn *websocket.Conn, closed chan<- struct{}) {
+	defer close(closed)
+
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func closeWebSocket(conn *websocket.Conn, code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteWait)); err != nil {
+		log.Printf("error closing websocket: %v", err)
+	}
+}
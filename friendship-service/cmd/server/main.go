@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/infrastructure/database"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/infrastructure/queue"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/infrastructure/server"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/cache"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/spf13/viper"
 )
 
@@ -34,7 +37,15 @@ func run() error {
 	}
 	defer producer.Close()
 
-	grpcServer, err := server.SetupGRPCServer(producer, database.Neo4jDriver)
+	cache.InitRedis(viper.GetString("REDIS_HOST"), viper.GetString("REDIS_PASSWORD"), 0)
+
+	if err := cache.Ping(context.Background()); err != nil {
+		return fmt.Errorf("error connecting to Redis: %w", err)
+	}
+
+	broker := pubsub.NewRedisBroker(cache.GetRedisClient())
+
+	grpcServer, err := server.SetupGRPCServer(producer, database.Neo4jDriver, broker)
 	if err != nil {
 		return fmt.Errorf("error setting up gRPC server: %w", err)
 	}
@@ -16,12 +16,13 @@ import (
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship"
 	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/friendship_service/v1"
 	"github.com/malytinKonstantin/go-messenger-mono/shared/middleware"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 )
 
-func SetupGRPCServer(producer *kafka.Producer, driver neo4j.DriverWithContext) (*grpc.Server, error) {
+func SetupGRPCServer(producer *kafka.Producer, driver neo4j.DriverWithContext, broker pubsub.Broker) (*grpc.Server, error) {
 	recoveryInterceptor := middleware.PanicRecoveryInterceptor()
 	server := grpc.NewServer(grpc.UnaryInterceptor(recoveryInterceptor))
 
@@ -30,12 +31,15 @@ func SetupGRPCServer(producer *kafka.Producer, driver neo4j.DriverWithContext) (
 	userRepo := repositories.NewUserRepository(database.Neo4jDriver)
 
 	// Инициализация usecase
-	sendFriendRequestUC := friendship.NewSendFriendRequestUsecase(friendRequestRepo)
+	sendFriendRequestUC := friendship.NewSendFriendRequestUsecase(friendRequestRepo, broker)
 	acceptFriendRequestUC := friendship.NewAcceptFriendRequestUsecase(friendRequestRepo)
 	rejectFriendRequestUC := friendship.NewRejectFriendRequestUsecase(friendRequestRepo)
 	removeFriendUC := friendship.NewRemoveFriendUsecase(friendRequestRepo)
 	getFriendsListUC := friendship.NewGetFriendsListUsecase(userRepo)
 	getFriendRequestsUC := friendship.NewGetFriendRequestsUsecase(friendRequestRepo)
+	blockUserUC := friendship.NewBlockUserUsecase(userRepo)
+	unblockUserUC := friendship.NewUnblockUserUsecase(userRepo)
+	getRelationshipUC := friendship.NewGetRelationshipUsecase(userRepo)
 
 	// Инициализация хендлера
 	friendshipHandler := handler.NewFriendshipHandler(
@@ -46,6 +50,9 @@ func SetupGRPCServer(producer *kafka.Producer, driver neo4j.DriverWithContext) (
 		removeFriendUC,
 		getFriendsListUC,
 		getFriendRequestsUC,
+		blockUserUC,
+		unblockUserUC,
+		getRelationshipUC,
 	)
 
 	pb.RegisterFriendshipServiceServer(server, friendshipHandler)
@@ -21,6 +21,9 @@ type FriendshipHandler struct {
 	removeFriendUC        friendship.RemoveFriendUsecase
 	getFriendsListUC      friendship.GetFriendsListUsecase
 	getFriendRequestsUC   friendship.GetFriendRequestsUsecase
+	blockUserUC           friendship.BlockUserUsecase
+	unblockUserUC         friendship.UnblockUserUsecase
+	getRelationshipUC     friendship.GetRelationshipUsecase
 }
 
 func NewFriendshipHandler(
@@ -31,6 +34,9 @@ func NewFriendshipHandler(
 	removeFriendUC friendship.RemoveFriendUsecase,
 	getFriendsListUC friendship.GetFriendsListUsecase,
 	getFriendRequestsUC friendship.GetFriendRequestsUsecase,
+	blockUserUC friendship.BlockUserUsecase,
+	unblockUserUC friendship.UnblockUserUsecase,
+	getRelationshipUC friendship.GetRelationshipUsecase,
 ) *FriendshipHandler {
 	return &FriendshipHandler{
 		producer:              producer,
@@ -40,6 +46,9 @@ func NewFriendshipHandler(
 		removeFriendUC:        removeFriendUC,
 		getFriendsListUC:      getFriendsListUC,
 		getFriendRequestsUC:   getFriendRequestsUC,
+		blockUserUC:           blockUserUC,
+		unblockUserUC:         unblockUserUC,
+		getRelationshipUC:     getRelationshipUC,
 	}
 }
 
@@ -175,6 +184,53 @@ func (h *FriendshipHandler) GetPendingRequests(ctx context.Context, req *pb.GetP
 	}, nil
 }
 
+func (h *FriendshipHandler) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	err := h.blockUserUC.Execute(ctx, req.UserId, req.BlockedUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error blocking user: %v", err)
+	}
+
+	return &pb.BlockUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *FriendshipHandler) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	err := h.unblockUserUC.Execute(ctx, req.UserId, req.BlockedUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error unblocking user: %v", err)
+	}
+
+	return &pb.UnblockUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *FriendshipHandler) GetRelationship(ctx context.Context, req *pb.GetRelationshipRequest) (*pb.GetRelationshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	relationship, err := h.getRelationshipUC.Execute(ctx, req.UserId, req.TargetUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting relationship: %v", err)
+	}
+
+	return &pb.GetRelationshipResponse{
+		Friends:   relationship.Friends,
+		Blocked:   relationship.Blocked,
+		BlockedBy: relationship.BlockedBy,
+	}, nil
+}
+
 func convertStatusToEnum(status string) pb.FriendRequestStatus {
 	switch status {
 	case "pending":
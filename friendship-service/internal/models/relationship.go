@@ -0,0 +1,8 @@
+package models
+
+// Relationship — отношения пользователя с другим пользователем
+type Relationship struct {
+	Friends   bool `json:"friends"`
+	Blocked   bool `json:"blocked"`
+	BlockedBy bool `json:"blocked_by"`
+}
@@ -17,6 +17,9 @@ type UserRepository interface {
 	AddFriend(ctx context.Context, userID, friendID string) error
 	RemoveFriend(ctx context.Context, userID, friendID string) error
 	GetFriends(ctx context.Context, userID string) ([]*models.User, error)
+	BlockUser(ctx context.Context, userID, blockedUserID string) error
+	UnblockUser(ctx context.Context, userID, blockedUserID string) error
+	GetRelationship(ctx context.Context, userID, targetUserID string) (*models.Relationship, error)
 }
 
 type userRepository struct {
@@ -191,3 +194,80 @@ func (r *userRepository) GetFriends(ctx context.Context, userID string) ([]*mode
 	}
 	return result.([]*models.User), nil
 }
+
+func (r *userRepository) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (interface{}, error) {
+		// Блокировка прекращает дружбу и отменяет запросы на дружбу в обе стороны
+		query := `
+            MATCH (u:User {user_id: $userID}), (b:User {user_id: $blockedUserID})
+            MERGE (u)-[:BLOCKED]->(b)
+            WITH u, b
+            OPTIONAL MATCH (u)-[r:FRIEND_WITH|FRIEND_REQUEST]-(b)
+            DELETE r
+        `
+		params := map[string]interface{}{
+			"userID":        userID,
+			"blockedUserID": blockedUserID,
+		}
+		_, err := tx.Run(ctx, query, params)
+		return nil, err
+	})
+	return err
+}
+
+func (r *userRepository) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (interface{}, error) {
+		query := `
+            MATCH (u:User {user_id: $userID})-[b:BLOCKED]->(:User {user_id: $blockedUserID})
+            DELETE b
+        `
+		params := map[string]interface{}{
+			"userID":        userID,
+			"blockedUserID": blockedUserID,
+		}
+		_, err := tx.Run(ctx, query, params)
+		return nil, err
+	})
+	return err
+}
+
+func (r *userRepository) GetRelationship(ctx context.Context, userID, targetUserID string) (*models.Relationship, error) {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	result, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (interface{}, error) {
+		query := `
+            MATCH (u:User {user_id: $userID}), (t:User {user_id: $targetUserID})
+            RETURN EXISTS { (u)-[:FRIEND_WITH]-(t) } OR EXISTS { (u)-[:FRIEND_REQUEST {status: 'accepted'}]-(t) } AS friends,
+                   EXISTS { (u)-[:BLOCKED]->(t) } AS blocked,
+                   EXISTS { (t)-[:BLOCKED]->(u) } AS blockedBy
+        `
+		params := map[string]interface{}{
+			"userID":       userID,
+			"targetUserID": targetUserID,
+		}
+		record, err := tx.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		// Пользователи без узла в графе еще ни с кем не связаны
+		relationship := &models.Relationship{}
+		if record.Next(ctx) {
+			values := record.Record().Values
+			relationship.Friends = values[0].(bool)
+			relationship.Blocked = values[1].(bool)
+			relationship.BlockedBy = values[2].(bool)
+		}
+		return relationship, record.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*models.Relationship), nil
+}
@@ -0,0 +1,34 @@
+package friendship
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/repositories"
+)
+
+type BlockUserUsecase interface {
+	Execute(ctx context.Context, userID, blockedUserID string) error
+}
+
+type blockUserUsecase struct {
+	repo repositories.UserRepository
+}
+
+func NewBlockUserUsecase(repo repositories.UserRepository) BlockUserUsecase {
+	return &blockUserUsecase{repo: repo}
+}
+
+func (uc *blockUserUsecase) Execute(ctx context.Context, userID, blockedUserID string) error {
+	if userID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+	if blockedUserID == "" {
+		return errors.New("blocked user ID cannot be empty")
+	}
+	if userID == blockedUserID {
+		return errors.New("cannot block yourself")
+	}
+
+	return uc.repo.BlockUser(ctx, userID, blockedUserID)
+}
@@ -0,0 +1,36 @@
+package friendship
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/repositories"
+)
+
+type GetRelationshipUsecase interface {
+	Execute(ctx context.Context, userID, targetUserID string) (*models.Relationship, error)
+}
+
+type getRelationshipUsecase struct {
+	repo repositories.UserRepository
+}
+
+func NewGetRelationshipUsecase(repo repositories.UserRepository) GetRelationshipUsecase {
+	return &getRelationshipUsecase{repo: repo}
+}
+
+func (uc *getRelationshipUsecase) Execute(ctx context.Context, userID, targetUserID string) (*models.Relationship, error) {
+	if userID == "" {
+		return nil, errors.New("user ID cannot be empty")
+	}
+	if targetUserID == "" {
+		return nil, errors.New("target user ID cannot be empty")
+	}
+	// С самим собой пользователь не дружит и не может себя заблокировать
+	if userID == targetUserID {
+		return &models.Relationship{}, nil
+	}
+
+	return uc.repo.GetRelationship(ctx, userID, targetUserID)
+}
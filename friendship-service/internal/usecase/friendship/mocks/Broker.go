@@ -0,0 +1,87 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pubsub "github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Broker is an autogenerated mock type for the Broker type
+type Broker struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: ctx, topic, eventType, payload
+func (_m *Broker) Publish(ctx context.Context, topic string, eventType string, payload []byte) (string, error) {
+	ret := _m.Called(ctx, topic, eventType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) (string, error)); ok {
+		return rf(ctx, topic, eventType, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) string); ok {
+		r0 = rf(ctx, topic, eventType, payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) error); ok {
+		r1 = rf(ctx, topic, eventType, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Subscribe provides a mock function with given fields: ctx, topic, lastID
+func (_m *Broker) Subscribe(ctx context.Context, topic string, lastID string) (*pubsub.Subscription, error) {
+	ret := _m.Called(ctx, topic, lastID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *pubsub.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*pubsub.Subscription, error)); ok {
+		return rf(ctx, topic, lastID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *pubsub.Subscription); ok {
+		r0 = rf(ctx, topic, lastID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pubsub.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, topic, lastID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
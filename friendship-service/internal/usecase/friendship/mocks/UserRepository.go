@@ -32,6 +32,24 @@ func (_m *UserRepository) AddFriend(ctx context.Context, userID string, friendID
 	return r0
 }
 
+// BlockUser provides a mock function with given fields: ctx, userID, blockedUserID
+func (_m *UserRepository) BlockUser(ctx context.Context, userID string, blockedUserID string) error {
+	ret := _m.Called(ctx, userID, blockedUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, blockedUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepository) CreateUser(ctx context.Context, user *models.User) error {
 	ret := _m.Called(ctx, user)
@@ -98,6 +116,36 @@ func (_m *UserRepository) GetFriends(ctx context.Context, userID string) ([]*mod
 	return r0, r1
 }
 
+// GetRelationship provides a mock function with given fields: ctx, userID, targetUserID
+func (_m *UserRepository) GetRelationship(ctx context.Context, userID string, targetUserID string) (*models.Relationship, error) {
+	ret := _m.Called(ctx, userID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationship")
+	}
+
+	var r0 *models.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Relationship, error)); ok {
+		return rf(ctx, userID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Relationship); ok {
+		r0 = rf(ctx, userID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByID provides a mock function with given fields: ctx, userID
 func (_m *UserRepository) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -146,6 +194,24 @@ func (_m *UserRepository) RemoveFriend(ctx context.Context, userID string, frien
 	return r0
 }
 
+// UnblockUser provides a mock function with given fields: ctx, userID, blockedUserID
+func (_m *UserRepository) UnblockUser(ctx context.Context, userID string, blockedUserID string) error {
+	ret := _m.Called(ctx, userID, blockedUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnblockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, blockedUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepository) UpdateUser(ctx context.Context, user *models.User) error {
 	ret := _m.Called(ctx, user)
@@ -2,24 +2,31 @@ package friendship
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/models"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 )
 
+// Тип события о новой заявке в друзья в персональном топике получателя
+const EventFriendRequestReceived = "friend_request.received"
+
 type SendFriendRequestUsecase interface {
 	Execute(ctx context.Context, senderID, receiverID string) (*models.FriendRequest, error)
 }
 
 type sendFriendRequestUsecase struct {
-	repo repositories.FriendRequestRepository
+	repo   repositories.FriendRequestRepository
+	broker pubsub.Broker
 }
 
-func NewSendFriendRequestUsecase(repo repositories.FriendRequestRepository) SendFriendRequestUsecase {
-	return &sendFriendRequestUsecase{repo: repo}
+func NewSendFriendRequestUsecase(repo repositories.FriendRequestRepository, broker pubsub.Broker) SendFriendRequestUsecase {
+	return &sendFriendRequestUsecase{repo: repo, broker: broker}
 }
 
 func (uc *sendFriendRequestUsecase) Execute(ctx context.Context, senderID, receiverID string) (*models.FriendRequest, error) {
@@ -47,5 +54,12 @@ func (uc *sendFriendRequestUsecase) Execute(ctx context.Context, senderID, recei
 		return nil, err
 	}
 
+	// Заявка уже сохранена, ошибка уведомления получателя не отменяет операцию
+	if payload, err := json.Marshal(request); err == nil {
+		if _, err := uc.broker.Publish(ctx, pubsub.UserTopic(receiverID), EventFriendRequestReceived, payload); err != nil {
+			log.Printf("error publishing friend request %s: %v", request.RequestID, err)
+		}
+	}
+
 	return request, nil
 }
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship"
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlockUserUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+	blockedUserID := "blocked-user-id"
+
+	mockRepo := new(mocks.UserRepository)
+	mockRepo.On("BlockUser", mock.Anything, userID, blockedUserID).Return(nil)
+
+	usecase := friendship.NewBlockUserUsecase(mockRepo)
+	err := usecase.Execute(ctx, userID, blockedUserID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBlockUserUsecaseExecuteError(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+	blockedUserID := "blocked-user-id"
+
+	mockRepo := new(mocks.UserRepository)
+	mockRepo.On("BlockUser", mock.Anything, userID, blockedUserID).Return(errors.New("database error"))
+
+	usecase := friendship.NewBlockUserUsecase(mockRepo)
+	err := usecase.Execute(ctx, userID, blockedUserID)
+
+	assert.EqualError(t, err, "database error")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBlockUserUsecaseExecuteInvalidIDs(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		userID        string
+		blockedUserID string
+	}{
+		{"empty user ID", "", "blocked-id"},
+		{"empty blocked user ID", "user-id", ""},
+		{"block yourself", "user-id", "user-id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.UserRepository)
+			usecase := friendship.NewBlockUserUsecase(mockRepo)
+			err := usecase.Execute(ctx, tt.userID, tt.blockedUserID)
+
+			assert.Error(t, err)
+			mockRepo.AssertNotCalled(t, "BlockUser")
+		})
+	}
+}
+
+func TestUnblockUserUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+	blockedUserID := "blocked-user-id"
+
+	mockRepo := new(mocks.UserRepository)
+	mockRepo.On("UnblockUser", mock.Anything, userID, blockedUserID).Return(nil)
+
+	usecase := friendship.NewUnblockUserUsecase(mockRepo)
+	err := usecase.Execute(ctx, userID, blockedUserID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship"
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetRelationshipUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+	targetUserID := "target-user-id"
+	relationship := &models.Relationship{BlockedBy: true}
+
+	mockRepo := new(mocks.UserRepository)
+	mockRepo.On("GetRelationship", mock.Anything, userID, targetUserID).Return(relationship, nil)
+
+	usecase := friendship.NewGetRelationshipUsecase(mockRepo)
+	result, err := usecase.Execute(ctx, userID, targetUserID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, relationship, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRelationshipUsecaseExecuteSelf(t *testing.T) {
+	ctx := context.Background()
+	userID := "test-user-id"
+
+	mockRepo := new(mocks.UserRepository)
+
+	usecase := friendship.NewGetRelationshipUsecase(mockRepo)
+	result, err := usecase.Execute(ctx, userID, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Relationship{}, result)
+	mockRepo.AssertNotCalled(t, "GetRelationship")
+}
+
+func TestGetRelationshipUsecaseExecuteEmptyIDs(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(mocks.UserRepository)
+
+	usecase := friendship.NewGetRelationshipUsecase(mockRepo)
+	_, err := usecase.Execute(ctx, "", "target-user-id")
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "GetRelationship")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship"
 	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/usecase/friendship/mocks"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -20,8 +21,11 @@ func TestSendFriendRequestUsecaseExecute(t *testing.T) {
 
 	mockRepo := new(mocks.FriendRequestRepository)
 	mockRepo.On("CreateFriendRequest", mock.Anything, mock.AnythingOfType("*models.FriendRequest")).Return(nil)
+	mockBroker := new(mocks.Broker)
+	mockBroker.On("Publish", mock.Anything, pubsub.UserTopic(receiverID), friendship.EventFriendRequestReceived, mock.Anything).
+		Return("1-0", nil)
 
-	usecase := friendship.NewSendFriendRequestUsecase(mockRepo)
+	usecase := friendship.NewSendFriendRequestUsecase(mockRepo, mockBroker)
 	request, err := usecase.Execute(ctx, senderID, receiverID)
 
 	assert.NoError(t, err)
@@ -32,6 +36,27 @@ func TestSendFriendRequestUsecaseExecute(t *testing.T) {
 	assert.WithinDuration(t, time.Now(), request.CreatedAt, time.Second)
 	assert.WithinDuration(t, time.Now(), request.UpdatedAt, time.Second)
 	mockRepo.AssertExpectations(t)
+	mockBroker.AssertExpectations(t)
+}
+
+func TestSendFriendRequestUsecaseExecutePublishError(t *testing.T) {
+	ctx := context.Background()
+	senderID := uuid.New().String()
+	receiverID := uuid.New().String()
+
+	mockRepo := new(mocks.FriendRequestRepository)
+	mockRepo.On("CreateFriendRequest", mock.Anything, mock.AnythingOfType("*models.FriendRequest")).Return(nil)
+	mockBroker := new(mocks.Broker)
+	mockBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("", errors.New("redis error"))
+
+	usecase := friendship.NewSendFriendRequestUsecase(mockRepo, mockBroker)
+	request, err := usecase.Execute(ctx, senderID, receiverID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, request)
+	mockRepo.AssertExpectations(t)
+	mockBroker.AssertExpectations(t)
 }
 
 func TestSendFriendRequestUsecaseExecuteError(t *testing.T) {
@@ -43,7 +68,7 @@ func TestSendFriendRequestUsecaseExecuteError(t *testing.T) {
 	mockRepo.On("CreateFriendRequest", mock.Anything, mock.AnythingOfType("*models.FriendRequest")).
 		Return(errors.New("database error"))
 
-	usecase := friendship.NewSendFriendRequestUsecase(mockRepo)
+	usecase := friendship.NewSendFriendRequestUsecase(mockRepo, new(mocks.Broker))
 	request, err := usecase.Execute(ctx, senderID, receiverID)
 
 	assert.Error(t, err)
@@ -67,7 +92,7 @@ func TestSendFriendRequestUsecaseExecuteWithEmptyIDs(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.FriendRequestRepository)
-			usecase := friendship.NewSendFriendRequestUsecase(mockRepo)
+			usecase := friendship.NewSendFriendRequestUsecase(mockRepo, new(mocks.Broker))
 			request, err := usecase.Execute(ctx, tt.senderID, tt.receiverID)
 
 			assert.Error(t, err)
@@ -87,7 +112,7 @@ func TestSendFriendRequestUsecaseExecuteWithCanceledContext(t *testing.T) {
 	mockRepo.On("CreateFriendRequest", ctx, mock.AnythingOfType("*models.FriendRequest")).
 		Return(context.Canceled)
 
-	usecase := friendship.NewSendFriendRequestUsecase(mockRepo)
+	usecase := friendship.NewSendFriendRequestUsecase(mockRepo, new(mocks.Broker))
 	request, err := usecase.Execute(ctx, senderID, receiverID)
 
 	assert.Error(t, err)
@@ -101,7 +126,7 @@ func TestSendFriendRequestUsecaseSameUser(t *testing.T) {
 	userID := uuid.New().String()
 
 	mockRepo := new(mocks.FriendRequestRepository)
-	usecase := friendship.NewSendFriendRequestUsecase(mockRepo)
+	usecase := friendship.NewSendFriendRequestUsecase(mockRepo, new(mocks.Broker))
 	request, err := usecase.Execute(ctx, userID, userID)
 
 	assert.Error(t, err)
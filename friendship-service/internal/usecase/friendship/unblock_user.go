@@ -0,0 +1,31 @@
+package friendship
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malytinKonstantin/go-messenger-mono/friendship-service/internal/repositories"
+)
+
+type UnblockUserUsecase interface {
+	Execute(ctx context.Context, userID, blockedUserID string) error
+}
+
+type unblockUserUsecase struct {
+	repo repositories.UserRepository
+}
+
+func NewUnblockUserUsecase(repo repositories.UserRepository) UnblockUserUsecase {
+	return &unblockUserUsecase{repo: repo}
+}
+
+func (uc *unblockUserUsecase) Execute(ctx context.Context, userID, blockedUserID string) error {
+	if userID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+	if blockedUserID == "" {
+		return errors.New("blocked user ID cannot be empty")
+	}
+
+	return uc.repo.UnblockUser(ctx, userID, blockedUserID)
+}
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/infrastructure/client"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/infrastructure/database"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/infrastructure/queue"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/infrastructure/server"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/cache"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/spf13/viper"
 )
 
@@ -35,11 +41,64 @@ func run() error {
 	}
 	defer producer.Close()
 
-	grpcServer, err := server.SetupGRPCServer(session, producer)
+	cache.InitRedis(viper.GetString("REDIS_HOST"), viper.GetString("REDIS_PASSWORD"), 0)
+
+	if err := cache.Ping(context.Background()); err != nil {
+		return fmt.Errorf("error connecting to Redis: %w", err)
+	}
+
+	broker := pubsub.NewRedisBroker(cache.GetRedisClient())
+
+	blobStore, err := storage.NewLocalBlobStore(viper.GetString("STORAGE_LOCAL_PATH"))
+	if err != nil {
+		return fmt.Errorf("error creating blob store: %w", err)
+	}
+
+	searchIndex, err := search.NewBleveIndex(viper.GetString("SEARCH_INDEX_PATH"))
+	if err != nil {
+		return fmt.Errorf("error opening search index: %w", err)
+	}
+	defer searchIndex.Close()
+
+	consumer, err := queue.CreateKafkaConsumer(server.SearchConsumerGroup())
+	if err != nil {
+		return fmt.Errorf("error creating Kafka consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	syncConsumer, err := queue.CreateKafkaConsumer(server.SyncConsumerGroup())
+	if err != nil {
+		return fmt.Errorf("error creating Kafka consumer: %w", err)
+	}
+	defer syncConsumer.Close()
+
+	userConn, err := client.ConnectToUserService()
+	if err != nil {
+		return fmt.Errorf("error connecting to user-service: %w", err)
+	}
+	defer userConn.Close()
+
+	friendshipConn, err := client.ConnectToFriendshipService()
+	if err != nil {
+		return fmt.Errorf("error connecting to friendship-service: %w", err)
+	}
+	defer friendshipConn.Close()
+
+	grpcServer, err := server.SetupGRPCServer(session, broker, blobStore, searchIndex, userConn, friendshipConn)
 	if err != nil {
 		return fmt.Errorf("error setting up gRPC server: %w", err)
 	}
 
+	// Рассылка событий outbox, индексация поиска, журналы синхронизации, очистка исчезнувших
+	// сообщений и отправка запланированных останавливаются вместе с серверами
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	go server.StartOutboxRelay(relayCtx, server.SetupOutboxRelay(session, producer))
+	go server.StartSearchIndexer(relayCtx, consumer, server.SetupSearchIndexer(session, searchIndex))
+	go server.StartSyncRecorder(relayCtx, syncConsumer, server.SetupSyncRecorder(session))
+	go server.StartExpirySweeper(relayCtx, server.SetupExpirySweeper(session, blobStore), searchIndex)
+	go server.StartScheduler(relayCtx, server.SetupScheduler(session, broker, userConn, friendshipConn))
+
 	httpServer := server.SetupHTTPServer()
 
 	go func() {
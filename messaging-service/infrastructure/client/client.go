@@ -0,0 +1,32 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ConnectToUserService открывает соединение с user-service.
+// Соединение устанавливается лениво, поэтому недоступность сервиса не мешает запуску
+func ConnectToUserService() (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(viper.GetString("USER_SERVICE_ADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user-service: %v", err)
+	}
+	return conn, nil
+}
+
+// ConnectToFriendshipService открывает соединение с friendship-service
+func ConnectToFriendshipService() (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(viper.GetString("FRIENDSHIP_SERVICE_ADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to friendship-service: %v", err)
+	}
+	return conn, nil
+}
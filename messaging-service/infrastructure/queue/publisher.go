@@ -0,0 +1,54 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type kafkaPublisher struct {
+	producer *kafka.Producer
+}
+
+func NewKafkaPublisher(producer *kafka.Producer) events.Publisher {
+	return &kafkaPublisher{
+		producer: producer,
+	}
+}
+
+// Publish дожидается подтверждения брокера, чтобы событие удалялось из outbox только после записи в Kafka
+func (p *kafkaPublisher) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	topic := event.Topic
+	delivery := make(chan kafka.Event, 1)
+	err := p.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            []byte(event.Key),
+		Value:          event.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(event.EventID.String())},
+			{Key: "event_type", Value: []byte(event.Type)},
+			{Key: "event_version", Value: []byte(strconv.Itoa(event.Version))},
+		},
+	}, delivery)
+	if err != nil {
+		return fmt.Errorf("failed to produce event %s: %w", event.EventID, err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case e := <-delivery:
+		msg, ok := e.(*kafka.Message)
+		if !ok {
+			return fmt.Errorf("unexpected delivery report for event %s: %v", event.EventID, e)
+		}
+		if msg.TopicPartition.Error != nil {
+			return fmt.Errorf("failed to deliver event %s: %w", event.EventID, msg.TopicPartition.Error)
+		}
+		return nil
+	}
+}
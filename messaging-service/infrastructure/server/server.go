@@ -1,45 +1,230 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/gocql/gocql"
 	"github.com/gofiber/fiber/v2"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/infrastructure/queue"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
 	handlers "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/delivery/grpc"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/outbox"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence"
+	searchusecase "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates"
+	friendshippb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/friendship_service/v1"
 	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	userpb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/user_service/v1"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/cache"
 	"github.com/malytinKonstantin/go-messenger-mono/shared/middleware"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetupGRPCServer(session *gocql.Session, producer *kafka.Producer) (*grpc.Server, error) {
+const (
+	// Срок действия ссылки на скачивание вложения по умолчанию
+	defaultAttachmentURLTTL = 15 * time.Minute
+	// Префикс ссылок на скачивание вложений в api-gateway
+	defaultAttachmentURLBase = "/v1/messaging/attachments"
+	// Интервал опроса outbox, когда новых событий нет
+	defaultOutboxPollInterval = time.Second
+	// Сколько ждать событие из Kafka, прежде чем проверить остановку потребителя
+	consumerPollTimeout = time.Second
+	// Группа потребителей журнала синхронизации по умолчанию
+	defaultSyncConsumerGroup = "messaging-sync"
+	// Пауза перед повторной записью события в журналы синхронизации, удваивается до maxSyncRetryInterval
+	syncRetryInterval    = time.Second
+	maxSyncRetryInterval = time.Minute
+	// Интервал очистки исчезнувших сообщений по умолчанию
+	defaultExpirySweepInterval = time.Minute
+	// Сколько помнить отношения пользователей из friendship-service по умолчанию
+	defaultFriendshipCacheTTL = 10 * time.Second
+	// Интервал опроса очереди запланированных сообщений по умолчанию
+	defaultSchedulerPollInterval = 5 * time.Second
+)
+
+func SetupGRPCServer(session *gocql.Session, broker pubsub.Broker, blobStore storage.BlobStore, searchIndex search.Index, userConn, friendshipConn *grpc.ClientConn) (*grpc.Server, error) {
+	// Секрет подписи ссылок должен совпадать на всех репликах
+	urlSecret := viper.GetString("ATTACHMENT_URL_SECRET")
+	if urlSecret == "" {
+		return nil, errors.New("ATTACHMENT_URL_SECRET is not set")
+	}
+	urlTTL := viper.GetDuration("ATTACHMENT_URL_TTL")
+	if urlTTL <= 0 {
+		urlTTL = defaultAttachmentURLTTL
+	}
+	urlBase := viper.GetString("ATTACHMENT_URL_BASE")
+	if urlBase == "" {
+		urlBase = defaultAttachmentURLBase
+	}
+	signer := attachment.NewURLSigner([]byte(urlSecret), urlTTL, urlBase)
+
+	limits := attachment.DefaultLimits()
+	if maxSize := viper.GetInt64("ATTACHMENT_MAX_SIZE"); maxSize > 0 {
+		limits = limits.WithMaxSize(maxSize)
+	}
+
+	// Поток событий проверяет JWT, выданный auth-service
+	jwtSecret := viper.GetString("JWT_SECRET")
+	if jwtSecret == "" {
+		return nil, errors.New("JWT_SECRET is not set")
+	}
+
+	// Сотрудники поддержки могут выгружать любые беседы
+	supportUserIDs, err := parseUserIDs(viper.GetString("SUPPORT_USER_IDS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SUPPORT_USER_IDS: %w", err)
+	}
+
 	// Инициализация репозиториев
 	messageRepo := repositories.NewMessageRepository(session)
+	conversationRepo := repositories.NewConversationRepository(session)
+	reactionRepo := repositories.NewReactionRepository(session)
+	attachmentRepo := repositories.NewAttachmentRepository(session)
+	inboxRepo := repositories.NewInboxRepository(session)
+	presenceRepo := repositories.NewPresenceRepository(cache.GetRedisClient())
+	presenceSettingsRepo := repositories.NewPresenceSettingsRepository(session)
+	keyRepo := repositories.NewKeyRepository(session)
+	messagingSettingsRepo := repositories.NewMessagingSettingsRepository(session)
+	pinRepo := repositories.NewPinRepository(session)
+	scheduledRepo := repositories.NewScheduledMessageRepository(session)
+	syncLogRepo := repositories.NewSyncLogRepository(session)
+
+	userDirectory := clients.NewUserDirectory(userpb.NewUserServiceClient(userConn))
+	friendships := newFriendshipDirectory(friendshipConn)
+
+	// Хаб событий поверх общего брокера, чтобы подписчики получали события со всех реплик
+	hub := events.NewHub(broker)
 
 	// Инициализация usecase
-	sendMessageUsecase := message.NewSendMessageUsecase(messageRepo, producer)
-	getMessagesUsecase := message.NewGetMessagesUsecase(messageRepo)
-	updateMessageStatusUsecase := message.NewUpdateMessageStatusUsecase(messageRepo)
+	sendMessageUsecase := message.NewSendMessageUsecase(messageRepo, conversationRepo, inboxRepo, attachmentRepo, keyRepo, messagingSettingsRepo, friendships, userDirectory, hub)
+	getMessagesUsecase := message.NewGetMessagesUsecase(messageRepo, conversationRepo, reactionRepo, inboxRepo)
+	updateMessageStatusUsecase := message.NewUpdateMessageStatusUsecase(messageRepo, conversationRepo, inboxRepo, hub)
+	markConversationReadUsecase := message.NewMarkConversationReadUsecase(messageRepo, conversationRepo, inboxRepo, hub)
+	streamMessagesUsecase := message.NewStreamMessagesUsecase(hub)
+	editMessageUsecase := message.NewEditMessageUsecase(messageRepo, conversationRepo, inboxRepo, userDirectory, hub)
+	deleteMessageUsecase := message.NewDeleteMessageUsecase(messageRepo, conversationRepo, inboxRepo, pinRepo, hub)
+	getMessageEditHistoryUsecase := message.NewGetMessageEditHistoryUsecase(messageRepo, conversationRepo)
+	getThreadUsecase := message.NewGetThreadUsecase(messageRepo, conversationRepo, reactionRepo, inboxRepo)
+	uploadAttachmentUsecase := attachment.NewUploadAttachmentUsecase(attachmentRepo, blobStore, limits)
+	getAttachmentURLUsecase := attachment.NewGetAttachmentURLUsecase(attachmentRepo, messageRepo, conversationRepo, signer)
+	downloadAttachmentUsecase := attachment.NewDownloadAttachmentUsecase(attachmentRepo, blobStore, signer)
+	addReactionUsecase := message.NewAddReactionUsecase(messageRepo, conversationRepo, reactionRepo, hub)
+	removeReactionUsecase := message.NewRemoveReactionUsecase(messageRepo, conversationRepo, reactionRepo, hub)
+	listReactionsUsecase := message.NewListReactionsUsecase(messageRepo, conversationRepo, reactionRepo)
+	getDirectConversationUsecase := conversation.NewGetDirectConversationUsecase(conversationRepo)
+	postSystemEventUsecase := conversation.NewPostSystemEventUsecase(conversationRepo, messageRepo, hub)
+	createConversationUsecase := conversation.NewCreateConversationUsecase(conversationRepo, postSystemEventUsecase)
+	getConversationUsecase := conversation.NewGetConversationUsecase(conversationRepo)
+	listConversationsUsecase := conversation.NewListConversationsUsecase(inboxRepo, conversationRepo, messageRepo, userDirectory)
+	addMembersUsecase := conversation.NewAddMembersUsecase(conversationRepo, postSystemEventUsecase)
+	removeMemberUsecase := conversation.NewRemoveMemberUsecase(conversationRepo, postSystemEventUsecase)
+	updateMemberRoleUsecase := conversation.NewUpdateMemberRoleUsecase(conversationRepo)
+	leaveConversationUsecase := conversation.NewLeaveConversationUsecase(conversationRepo, postSystemEventUsecase)
+	setTypingUsecase := presence.NewSetTypingUsecase(presenceRepo, conversationRepo, hub, viper.GetDuration("TYPING_TTL"))
+	updatePresenceUsecase := presence.NewUpdatePresenceUsecase(presenceRepo, presenceSettingsRepo, inboxRepo, conversationRepo, hub, viper.GetDuration("PRESENCE_TTL"))
+	getPresenceUsecase := presence.NewGetPresenceUsecase(presenceRepo, presenceSettingsRepo)
+	updatePresenceSettingsUsecase := presence.NewUpdatePresenceSettingsUsecase(presenceSettingsRepo)
+	searchMessagesUsecase := searchusecase.NewSearchMessagesUsecase(searchIndex, messageRepo, conversationRepo, inboxRepo)
+	setMessageTTLUsecase := conversation.NewSetMessageTTLUsecase(conversationRepo, postSystemEventUsecase)
+	updateMessagingSettingsUsecase := message.NewUpdateMessagingSettingsUsecase(messagingSettingsRepo)
+	getMessagingSettingsUsecase := message.NewGetMessagingSettingsUsecase(messagingSettingsRepo)
+	uploadKeysUsecase := keys.NewUploadKeysUsecase(keyRepo)
+	getPreKeyBundlesUsecase := keys.NewGetPreKeyBundlesUsecase(keyRepo, hub)
+	getPreKeyStatusUsecase := keys.NewGetPreKeyStatusUsecase(keyRepo)
+	pinMessageUsecase := message.NewPinMessageUsecase(messageRepo, conversationRepo, pinRepo, postSystemEventUsecase, hub, viper.GetInt("MAX_PINNED_MESSAGES"))
+	unpinMessageUsecase := message.NewUnpinMessageUsecase(messageRepo, conversationRepo, pinRepo, hub)
+	listPinnedMessagesUsecase := message.NewListPinnedMessagesUsecase(messageRepo, conversationRepo, pinRepo)
+	scheduleMessageUsecase := message.NewScheduleMessageUsecase(scheduledRepo, conversationRepo)
+	listScheduledMessagesUsecase := message.NewListScheduledMessagesUsecase(scheduledRepo)
+	cancelScheduledMessageUsecase := message.NewCancelScheduledMessageUsecase(scheduledRepo)
+	rescheduleMessageUsecase := message.NewRescheduleMessageUsecase(scheduledRepo)
+	exportConversationUsecase := message.NewExportConversationUsecase(messageRepo, conversationRepo, supportUserIDs)
+	setArchivedUsecase := conversation.NewSetArchivedUsecase(conversationRepo, inboxRepo)
+	setMutedUsecase := conversation.NewSetMutedUsecase(conversationRepo, inboxRepo)
+	markConversationUnreadUsecase := message.NewMarkConversationUnreadUsecase(conversationRepo, inboxRepo)
+	getUpdatesUsecase := updates.NewGetUpdatesUsecase(syncLogRepo, messageRepo)
 
 	recoveryInterceptor := middleware.PanicRecoveryInterceptor()
+	streamRecoveryInterceptor := middleware.StreamPanicRecoveryInterceptor()
+	streamAuthInterceptor := middleware.StreamAuthInterceptor(jwtSecret, pb.MessagingService_StreamMessages_FullMethodName)
 
 	// Создание gRPC сервера
-	server := grpc.NewServer(grpc.UnaryInterceptor(recoveryInterceptor))
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(recoveryInterceptor),
+		grpc.ChainStreamInterceptor(streamRecoveryInterceptor, streamAuthInterceptor),
+	)
 
 	// Регистрация сервиса
 	pb.RegisterMessagingServiceServer(server, handlers.NewMessagingHandler(
 		sendMessageUsecase,
 		getMessagesUsecase,
 		updateMessageStatusUsecase,
+		markConversationReadUsecase,
+		streamMessagesUsecase,
+		editMessageUsecase,
+		deleteMessageUsecase,
+		getMessageEditHistoryUsecase,
+		getThreadUsecase,
+		uploadAttachmentUsecase,
+		getAttachmentURLUsecase,
+		downloadAttachmentUsecase,
+		addReactionUsecase,
+		removeReactionUsecase,
+		listReactionsUsecase,
+		getDirectConversationUsecase,
+		createConversationUsecase,
+		getConversationUsecase,
+		listConversationsUsecase,
+		addMembersUsecase,
+		removeMemberUsecase,
+		updateMemberRoleUsecase,
+		leaveConversationUsecase,
+		setTypingUsecase,
+		updatePresenceUsecase,
+		getPresenceUsecase,
+		updatePresenceSettingsUsecase,
+		searchMessagesUsecase,
+		setMessageTTLUsecase,
+		updateMessagingSettingsUsecase,
+		getMessagingSettingsUsecase,
+		pinMessageUsecase,
+		unpinMessageUsecase,
+		listPinnedMessagesUsecase,
+		scheduleMessageUsecase,
+		listScheduledMessagesUsecase,
+		cancelScheduledMessageUsecase,
+		rescheduleMessageUsecase,
+		exportConversationUsecase,
+		setArchivedUsecase,
+		setMutedUsecase,
+		markConversationUnreadUsecase,
+		getUpdatesUsecase,
+	))
+	pb.RegisterKeyDirectoryServiceServer(server, handlers.NewKeyDirectoryHandler(
+		uploadKeysUsecase,
+		getPreKeyBundlesUsecase,
+		getPreKeyStatusUsecase,
 	))
 
 	// Отражение сервера (для инструментов типа grpcurl)
@@ -48,6 +233,256 @@ func SetupGRPCServer(session *gocql.Session, producer *kafka.Producer) (*grpc.Se
 	return server, nil
 }
 
+// newFriendshipDirectory создает клиент friendship-service, который недолго помнит отношения пользователей
+func newFriendshipDirectory(friendshipConn *grpc.ClientConn) clients.FriendshipDirectory {
+	friendshipCacheTTL := viper.GetDuration("FRIENDSHIP_CACHE_TTL")
+	if friendshipCacheTTL <= 0 {
+		friendshipCacheTTL = defaultFriendshipCacheTTL
+	}
+	return clients.NewCachedFriendshipDirectory(
+		clients.NewFriendshipDirectory(friendshippb.NewFriendshipServiceClient(friendshipConn)),
+		friendshipCacheTTL,
+	)
+}
+
+// parseUserIDs разбирает список идентификаторов пользователей через запятую
+func parseUserIDs(value string) ([]gocql.UUID, error) {
+	var userIDs []gocql.UUID
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		userID, err := gocql.ParseUUID(part)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+// SetupOutboxRelay создает рассылку событий outbox в Kafka
+func SetupOutboxRelay(session *gocql.Session, producer *kafka.Producer) outbox.RelayEventsUsecase {
+	outboxRepo := repositories.NewOutboxRepository(session)
+	publisher := queue.NewKafkaPublisher(producer)
+	return outbox.NewRelayEventsUsecase(outboxRepo, publisher, viper.GetInt("OUTBOX_BATCH_SIZE"))
+}
+
+// StartOutboxRelay публикует события outbox до отмены контекста.
+// Пока события есть, проходы идут подряд, иначе outbox опрашивается с интервалом
+func StartOutboxRelay(ctx context.Context, relay outbox.RelayEventsUsecase) {
+	interval := viper.GetDuration("OUTBOX_POLL_INTERVAL")
+	if interval <= 0 {
+		interval = defaultOutboxPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := relay.Execute(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("error relaying outbox events: %v", err)
+		}
+		if published > 0 && err == nil && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetupSearchIndexer создает обновление поискового индекса по событиям сообщений
+func SetupSearchIndexer(session *gocql.Session, searchIndex search.Index) searchusecase.IndexMessageEventUsecase {
+	return searchusecase.NewIndexMessageEventUsecase(searchIndex, repositories.NewMessageRepository(session))
+}
+
+// SearchConsumerGroup возвращает группу потребителей индексатора.
+// Каждая реплика строит свой индекс, поэтому по умолчанию группа своя у каждого хоста
+func SearchConsumerGroup() string {
+	if group := viper.GetString("SEARCH_CONSUMER_GROUP"); group != "" {
+		return group
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "local"
+	}
+	return "messaging-search-" + hostname
+}
+
+// StartSearchIndexer обновляет поисковый индекс по событиям сообщений до отмены контекста.
+// Смещение фиксируется и после ошибки, чтобы одно битое событие не останавливало индексацию
+func StartSearchIndexer(ctx context.Context, consumer *kafka.Consumer, indexer searchusecase.IndexMessageEventUsecase) {
+	if err := consumer.SubscribeTopics(searchusecase.IndexedTopics, nil); err != nil {
+		log.Printf("error subscribing search indexer: %v", err)
+		return
+	}
+
+	for ctx.Err() == nil {
+		msg, err := consumer.ReadMessage(consumerPollTimeout)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrTimedOut {
+				log.Printf("error reading message events: %v", err)
+			}
+			continue
+		}
+		if err := indexer.Execute(ctx, *msg.TopicPartition.Topic, msg.Value); err != nil {
+			// Событие без зафиксированного смещения будет прочитано снова после перезапуска
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("error indexing message event: %v", err)
+		}
+		if _, err := consumer.CommitMessage(msg); err != nil {
+			log.Printf("error committing message event offset: %v", err)
+		}
+	}
+}
+
+// SetupSyncRecorder создает запись событий в журналы синхронизации пользователей
+func SetupSyncRecorder(session *gocql.Session) updates.RecordSyncEventUsecase {
+	return updates.NewRecordSyncEventUsecase(
+		repositories.NewSyncLogRepository(session),
+		repositories.NewConversationRepository(session),
+	)
+}
+
+// SyncConsumerGroup возвращает группу потребителей журнала синхронизации.
+// Журналы общие для всех реплик, поэтому каждое событие записывает одна из них
+func SyncConsumerGroup() string {
+	if group := viper.GetString("SYNC_CONSUMER_GROUP"); group != "" {
+		return group
+	}
+	return defaultSyncConsumerGroup
+}
+
+// StartSyncRecorder записывает события в журналы синхронизации до отмены контекста.
+// В отличие от индексатора, смещение фиксируется только после записи: пропущенное изменение
+// не заметно клиенту, ведь номера в журнале остаются непрерывными. Пропускаются лишь битые события
+func StartSyncRecorder(ctx context.Context, consumer *kafka.Consumer, recorder updates.RecordSyncEventUsecase) {
+	if err := consumer.SubscribeTopics(updates.SyncedTopics, nil); err != nil {
+		log.Printf("error subscribing sync recorder: %v", err)
+		return
+	}
+
+	for ctx.Err() == nil {
+		msg, err := consumer.ReadMessage(consumerPollTimeout)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrTimedOut {
+				log.Printf("error reading sync events: %v", err)
+			}
+			continue
+		}
+		if !recordSyncEvent(ctx, recorder, msg) {
+			return
+		}
+		if _, err := consumer.CommitMessage(msg); err != nil {
+			log.Printf("error committing sync event offset: %v", err)
+		}
+	}
+}
+
+// recordSyncEvent повторяет запись события, пока она не удастся или не будет отменен контекст.
+// Запись в журнал идемпотентна, поэтому повтор частично записанного события безопасен
+func recordSyncEvent(ctx context.Context, recorder updates.RecordSyncEventUsecase, msg *kafka.Message) bool {
+	retryInterval := syncRetryInterval
+	for {
+		err := recorder.Execute(ctx, *msg.TopicPartition.Topic, msg.Value)
+		if err == nil {
+			return true
+		}
+		if errors.Is(err, updates.ErrMalformedEvent) {
+			log.Printf("skipping malformed sync event at %v: %v", msg.TopicPartition, err)
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		log.Printf("error recording sync event, retrying in %s: %v", retryInterval, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryInterval):
+		}
+		retryInterval = min(retryInterval*2, maxSyncRetryInterval)
+	}
+}
+
+// SetupExpirySweeper создает удаление файлов исчезнувших сообщений
+func SetupExpirySweeper(session *gocql.Session, blobStore storage.BlobStore) attachment.ExpireAttachmentsUsecase {
+	return attachment.NewExpireAttachmentsUsecase(repositories.NewAttachmentRepository(session), blobStore)
+}
+
+// StartExpirySweeper до отмены контекста удаляет данные исчезнувших сообщений, которые
+// не исчезают сами по TTL Cassandra: файлы вложений и документы поискового индекса
+func StartExpirySweeper(ctx context.Context, expireAttachments attachment.ExpireAttachmentsUsecase, searchIndex search.Index) {
+	interval := viper.GetDuration("EXPIRY_SWEEP_INTERVAL")
+	if interval <= 0 {
+		interval = defaultExpirySweepInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		if _, err := expireAttachments.Execute(ctx, now); err != nil && ctx.Err() == nil {
+			log.Printf("error deleting expired attachments: %v", err)
+		}
+		if _, err := searchIndex.DeleteExpired(ctx, now); err != nil && ctx.Err() == nil {
+			log.Printf("error deleting expired messages from search index: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetupScheduler создает отправку запланированных сообщений. Сообщения отправляются тем же usecase,
+// что и обычные, поэтому настройки получателя и вложения проверяются в момент отправки
+func SetupScheduler(session *gocql.Session, broker pubsub.Broker, userConn, friendshipConn *grpc.ClientConn) message.DeliverScheduledMessagesUsecase {
+	messageRepo := repositories.NewMessageRepository(session)
+	sendMessageUsecase := message.NewSendMessageUsecase(
+		messageRepo,
+		repositories.NewConversationRepository(session),
+		repositories.NewInboxRepository(session),
+		repositories.NewAttachmentRepository(session),
+		repositories.NewKeyRepository(session),
+		repositories.NewMessagingSettingsRepository(session),
+		newFriendshipDirectory(friendshipConn),
+		clients.NewUserDirectory(userpb.NewUserServiceClient(userConn)),
+		events.NewHub(broker),
+	)
+	return message.NewDeliverScheduledMessagesUsecase(repositories.NewScheduledMessageRepository(session), messageRepo, sendMessageUsecase)
+}
+
+// StartScheduler отправляет запланированные сообщения до отмены контекста
+func StartScheduler(ctx context.Context, deliver message.DeliverScheduledMessagesUsecase) {
+	interval := viper.GetDuration("SCHEDULER_POLL_INTERVAL")
+	if interval <= 0 {
+		interval = defaultSchedulerPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := deliver.Execute(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("error delivering scheduled messages: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func SetupHTTPServer() *fiber.App {
 	app := fiber.New()
 	app.Get("/health", func(c *fiber.Ctx) error {
@@ -0,0 +1,103 @@
+package clients
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	friendshippb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/friendship_service/v1"
+)
+
+// FriendshipDirectory получает отношения пользователей из friendship-service
+type FriendshipDirectory interface {
+	// GetRelationship возвращает отношения userID с targetUserID
+	GetRelationship(ctx context.Context, userID, targetUserID gocql.UUID) (*models.Relationship, error)
+}
+
+type friendshipDirectory struct {
+	client friendshippb.FriendshipServiceClient
+}
+
+func NewFriendshipDirectory(client friendshippb.FriendshipServiceClient) FriendshipDirectory {
+	return &friendshipDirectory{
+		client: client,
+	}
+}
+
+func (d *friendshipDirectory) GetRelationship(ctx context.Context, userID, targetUserID gocql.UUID) (*models.Relationship, error) {
+	resp, err := d.client.GetRelationship(ctx, &friendshippb.GetRelationshipRequest{
+		UserId:       userID.String(),
+		TargetUserId: targetUserID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.Relationship{
+		Friends:   resp.GetFriends(),
+		Blocked:   resp.GetBlocked(),
+		BlockedBy: resp.GetBlockedBy(),
+	}, nil
+}
+
+type relationshipKey struct {
+	userID, targetUserID gocql.UUID
+}
+
+type cachedRelationship struct {
+	relationship *models.Relationship
+	expiresAt    time.Time
+}
+
+// cachedFriendshipDirectory запоминает отношения на короткое время, чтобы не ходить
+// в friendship-service за каждым сообщением. Блокировка вступает в силу не позже чем через ttl
+type cachedFriendshipDirectory struct {
+	directory FriendshipDirectory
+	ttl       time.Duration
+
+	mu        sync.Mutex
+	entries   map[relationshipKey]cachedRelationship
+	lastSweep time.Time
+}
+
+func NewCachedFriendshipDirectory(directory FriendshipDirectory, ttl time.Duration) FriendshipDirectory {
+	return &cachedFriendshipDirectory{
+		directory: directory,
+		ttl:       ttl,
+		entries:   make(map[relationshipKey]cachedRelationship),
+		lastSweep: time.Now(),
+	}
+}
+
+func (d *cachedFriendshipDirectory) GetRelationship(ctx context.Context, userID, targetUserID gocql.UUID) (*models.Relationship, error) {
+	key := relationshipKey{userID, targetUserID}
+	now := time.Now()
+
+	d.mu.Lock()
+	entry, ok := d.entries[key]
+	d.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.relationship, nil
+	}
+
+	// Ошибки не запоминаются, следующий запрос снова обратится к friendship-service
+	relationship, err := d.directory.GetRelationship(ctx, userID, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries[key] = cachedRelationship{relationship: relationship, expiresAt: now.Add(d.ttl)}
+	// Просроченные записи вычищаются не чаще раза за ttl, чтобы кэш не рос без ограничений
+	if now.Sub(d.lastSweep) >= d.ttl {
+		for k, e := range d.entries {
+			if !now.Before(e.expiresAt) {
+				delete(d.entries, k)
+			}
+		}
+		d.lastSweep = now
+	}
+	return relationship, nil
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDirectory считает обращения к friendship-service
+type countingDirectory struct {
+	calls int
+	err   error
+}
+
+func (d *countingDirectory) GetRelationship(ctx context.Context, userID, targetUserID gocql.UUID) (*models.Relationship, error) {
+	d.calls++
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &models.Relationship{Friends: true}, nil
+}
+
+func TestCachedFriendshipDirectory(t *testing.T) {
+	ctx := context.Background()
+	userID, targetUserID := gocql.TimeUUID(), gocql.TimeUUID()
+	next := &countingDirectory{}
+	directory := clients.NewCachedFriendshipDirectory(next, 50*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		relationship, err := directory.GetRelationship(ctx, userID, targetUserID)
+		require.NoError(t, err)
+		assert.True(t, relationship.Friends)
+	}
+	assert.Equal(t, 1, next.calls)
+
+	// Отношения в обратную сторону кэшируются отдельно
+	_, err := directory.GetRelationship(ctx, targetUserID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = directory.GetRelationship(ctx, userID, targetUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestCachedFriendshipDirectoryError(t *testing.T) {
+	ctx := context.Background()
+	userID, targetUserID := gocql.TimeUUID(), gocql.TimeUUID()
+	next := &countingDirectory{err: errors.New("unavailable")}
+	directory := clients.NewCachedFriendshipDirectory(next, time.Minute)
+
+	_, err := directory.GetRelationship(ctx, userID, targetUserID)
+	require.Error(t, err)
+	_, err = directory.GetRelationship(ctx, userID, targetUserID)
+	require.Error(t, err)
+	assert.Equal(t, 2, next.calls)
+}
@@ -0,0 +1,96 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	userpb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/user_service/v1"
+)
+
+// Число одновременных запросов профилей к user-service
+const maxConcurrentProfileRequests = 8
+
+// UserDirectory получает профили пользователей из user-service
+type UserDirectory interface {
+	// GetProfiles возвращает найденные профили; ошибка не отменяет уже полученные профили
+	GetProfiles(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.UserProfile, error)
+	// GetProfilesByNicknames возвращает профили по точным никнеймам, ненайденные никнеймы пропускаются
+	GetProfilesByNicknames(ctx context.Context, nicknames []string) (map[string]*models.UserProfile, error)
+}
+
+type userDirectory struct {
+	client userpb.UserServiceClient
+}
+
+func NewUserDirectory(client userpb.UserServiceClient) UserDirectory {
+	return &userDirectory{
+		client: client,
+	}
+}
+
+func (d *userDirectory) GetProfiles(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.UserProfile, error) {
+	profiles := make(map[gocql.UUID]*models.UserProfile, len(userIDs))
+	requested := make(map[gocql.UUID]bool, len(userIDs))
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+	sem := make(chan struct{}, maxConcurrentProfileRequests)
+	for _, userID := range userIDs {
+		if requested[userID] {
+			continue
+		}
+		requested[userID] = true
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(userID gocql.UUID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := d.client.GetUser(ctx, &userpb.GetUserRequest{UserId: userID.String()})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to get profile %s: %w", userID, err))
+				return
+			}
+			profiles[userID] = &models.UserProfile{
+				UserID:    userID,
+				Nickname:  resp.GetProfile().GetNickname(),
+				AvatarURL: resp.GetProfile().GetAvatarUrl(),
+			}
+		}(userID)
+	}
+	wg.Wait()
+
+	return profiles, errors.Join(errs...)
+}
+
+func (d *userDirectory) GetProfilesByNicknames(ctx context.Context, nicknames []string) (map[string]*models.UserProfile, error) {
+	resp, err := d.client.GetUsersByNicknames(ctx, &userpb.GetUsersByNicknamesRequest{Nicknames: nicknames})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profiles by nicknames: %w", err)
+	}
+
+	profiles := make(map[string]*models.UserProfile, len(resp.GetUsers()))
+	for _, user := range resp.GetUsers() {
+		userID, err := gocql.ParseUUID(user.GetUserId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id %q in profile: %w", user.GetUserId(), err)
+		}
+		profiles[user.GetNickname()] = &models.UserProfile{
+			UserID:    userID,
+			Nickname:  user.GetNickname(),
+			AvatarURL: user.GetAvatarUrl(),
+		}
+	}
+	return profiles, nil
+}
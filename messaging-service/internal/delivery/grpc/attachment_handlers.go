@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Размер части файла при скачивании
+const downloadChunkSize = 64 * 1024
+
+// Загрузка вложения
+func (h *MessagingHandler) UploadAttachment(stream pb.MessagingService_UploadAttachmentServer) error {
+	// Первое сообщение потока содержит сведения о файле
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Errorf(codes.InvalidArgument, "attachment info must be sent first")
+		}
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "attachment info must be sent first")
+	}
+	if err := info.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	uploaderID, err := gocql.ParseUUID(info.UploaderId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid uploader_id: %v", err)
+	}
+
+	attachment, err := h.uploadAttachmentUsecase.Execute(stream.Context(), uploaderID, info.FileName, &uploadReader{stream: stream})
+	if err != nil {
+		// Ошибки чтения потока уже содержат gRPC статус
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return usecaseError(err, "error uploading attachment")
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{
+		Attachment: mapAttachmentToProto(attachment),
+	})
+}
+
+// Ссылка на скачивание вложения
+func (h *MessagingHandler) GetAttachmentURL(ctx context.Context, req *pb.GetAttachmentURLRequest) (*pb.GetAttachmentURLResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	attachmentID, err := gocql.ParseUUID(req.AttachmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment_id: %v", err)
+	}
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	url, expiresAt, err := h.getAttachmentURLUsecase.Execute(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting attachment url")
+	}
+
+	return &pb.GetAttachmentURLResponse{
+		Url:       url,
+		ExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+// Скачивание вложения по подписанной ссылке
+func (h *MessagingHandler) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.MessagingService_DownloadAttachmentServer) error {
+	if err := req.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	attachmentID, err := gocql.ParseUUID(req.AttachmentId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attachment_id: %v", err)
+	}
+
+	attachment, content, err := h.downloadAttachmentUsecase.Execute(stream.Context(), attachmentID, req.Token)
+	if err != nil {
+		return usecaseError(err, "error downloading attachment")
+	}
+	defer content.Close()
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{
+		Data: &pb.DownloadAttachmentResponse_Attachment{Attachment: mapAttachmentToProto(attachment)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.DownloadAttachmentResponse{
+				Data: &pb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "error reading attachment: %v", err)
+		}
+	}
+}
+
+// uploadReader читает содержимое файла из потока загрузки
+type uploadReader struct {
+	stream pb.MessagingService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetInfo() != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "attachment info must be sent only once")
+		}
+		r.buf = req.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func mapAttachmentToProto(attachment *models.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		AttachmentId: attachment.AttachmentID.String(),
+		FileName:     attachment.FileName,
+		MimeType:     attachment.MimeType,
+		Size:         attachment.Size,
+		Checksum:     attachment.Checksum,
+		Width:        int32(attachment.Width),
+		Height:       int32(attachment.Height),
+	}
+}
+
+func mapAttachmentsToProto(attachments []*models.Attachment) []*pb.Attachment {
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		pbAttachments[i] = mapAttachmentToProto(attachment)
+	}
+	return pbAttachments
+}
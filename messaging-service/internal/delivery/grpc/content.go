@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+)
+
+// parseSendPayload переносит содержимое запроса в сообщение. Без payload сообщение текстовое
+func parseSendPayload(msg *models.Message, req *pb.SendMessageRequest) error {
+	switch payload := req.Payload.(type) {
+	case nil:
+		msg.ContentType = models.ContentText
+		msg.Content = req.Content
+	case *pb.SendMessageRequest_Text:
+		msg.ContentType = models.ContentText
+		msg.Content = payload.Text.GetText()
+		msg.Entities = parseTextEntities(payload.Text.GetEntities())
+	case *pb.SendMessageRequest_Image:
+		attachmentID, err := gocql.ParseUUID(payload.Image.GetAttachmentId())
+		if err != nil {
+			return fmt.Errorf("invalid image attachment_id: %w", err)
+		}
+		msg.ContentType = models.ContentImage
+		msg.ContentAttachmentID = attachmentID
+		msg.Content = payload.Image.GetCaption()
+	case *pb.SendMessageRequest_File:
+		attachmentID, err := gocql.ParseUUID(payload.File.GetAttachmentId())
+		if err != nil {
+			return fmt.Errorf("invalid file attachment_id: %w", err)
+		}
+		msg.ContentType = models.ContentFile
+		msg.ContentAttachmentID = attachmentID
+		msg.Content = payload.File.GetCaption()
+	case *pb.SendMessageRequest_Location:
+		msg.ContentType = models.ContentLocation
+		msg.Location = &models.Location{
+			Latitude:  payload.Location.GetLatitude(),
+			Longitude: payload.Location.GetLongitude(),
+			Title:     payload.Location.GetTitle(),
+			Address:   payload.Location.GetAddress(),
+		}
+	case *pb.SendMessageRequest_Contact:
+		contact := &models.ContactCard{
+			Name:        payload.Contact.GetName(),
+			PhoneNumber: payload.Contact.GetPhoneNumber(),
+			Email:       payload.Contact.GetEmail(),
+		}
+		if userID := payload.Contact.GetUserId(); userID != "" {
+			var err error
+			if contact.UserID, err = gocql.ParseUUID(userID); err != nil {
+				return fmt.Errorf("invalid contact user_id: %w", err)
+			}
+		}
+		msg.ContentType = models.ContentContact
+		msg.Contact = contact
+	default:
+		return fmt.Errorf("unsupported payload %T", payload)
+	}
+	return nil
+}
+
+func parseTextEntities(entities []*pb.TextEntity) []*models.TextEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+	result := make([]*models.TextEntity, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, &models.TextEntity{
+			Type:     models.TextEntityType(entity.Type),
+			Offset:   int(entity.Offset),
+			Length:   int(entity.Length),
+			URL:      entity.Url,
+			Language: entity.Language,
+		})
+	}
+	return result
+}
+
+// setMessagePayload заполняет содержимое сообщения по типу. У удаленных и зашифрованных
+// сообщений его нет, текстовое сообщение только с вложениями тоже обходится без него
+func setMessagePayload(result *pb.Message, msg *models.Message) {
+	if msg.Deleted || msg.Encrypted() {
+		return
+	}
+	switch msg.ContentType {
+	case models.ContentTypeUnspecified, models.ContentText:
+		if msg.Content != "" {
+			result.Payload = &pb.Message_Text{Text: &pb.TextContent{
+				Text:     msg.Content,
+				Entities: mapTextEntitiesToProto(msg.Entities),
+			}}
+		}
+	case models.ContentImage:
+		result.Payload = &pb.Message_Image{Image: &pb.ImageContent{
+			AttachmentId: msg.ContentAttachmentID.String(),
+			Caption:      msg.Content,
+		}}
+	case models.ContentFile:
+		result.Payload = &pb.Message_File{File: &pb.FileContent{
+			AttachmentId: msg.ContentAttachmentID.String(),
+			Caption:      msg.Content,
+		}}
+	case models.ContentLocation:
+		if msg.Location != nil {
+			result.Payload = &pb.Message_Location{Location: &pb.LocationContent{
+				Latitude:  msg.Location.Latitude,
+				Longitude: msg.Location.Longitude,
+				Title:     msg.Location.Title,
+				Address:   msg.Location.Address,
+			}}
+		}
+	case models.ContentContact:
+		if msg.Contact != nil {
+			result.Payload = &pb.Message_Contact{Contact: &pb.ContactContent{
+				Name:        msg.Contact.Name,
+				PhoneNumber: msg.Contact.PhoneNumber,
+				Email:       msg.Contact.Email,
+				UserId:      uuidOrEmpty(msg.Contact.UserID),
+			}}
+		}
+	case models.ContentSystemEvent:
+		if msg.SystemEvent != nil {
+			memberIDs := make([]string, len(msg.SystemEvent.MemberIDs))
+			for i, memberID := range msg.SystemEvent.MemberIDs {
+				memberIDs[i] = memberID.String()
+			}
+			result.Payload = &pb.Message_SystemEvent{SystemEvent: &pb.SystemEventContent{
+				Type:              pb.SystemEventType(msg.SystemEvent.Type),
+				ActorId:           uuidOrEmpty(msg.SystemEvent.ActorID),
+				MemberIds:         memberIDs,
+				MessageTtlSeconds: int32(msg.SystemEvent.MessageTTL),
+				MessageId:         uuidOrEmpty(msg.SystemEvent.MessageID),
+			}}
+		}
+	}
+}
+
+func mapTextEntitiesToProto(entities []*models.TextEntity) []*pb.TextEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+	result := make([]*pb.TextEntity, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, &pb.TextEntity{
+			Type:     pb.TextEntityType(entity.Type),
+			Offset:   int32(entity.Offset),
+			Length:   int32(entity.Length),
+			Url:      entity.URL,
+			Language: entity.Language,
+		})
+	}
+	return result
+}
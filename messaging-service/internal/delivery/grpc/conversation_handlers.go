@@ -0,0 +1,381 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Создание групповой беседы
+func (h *MessagingHandler) CreateConversation(ctx context.Context, req *pb.CreateConversationRequest) (*pb.CreateConversationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	creatorID, err := gocql.ParseUUID(req.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator_id: %v", err)
+	}
+
+	memberIDs, err := parseUUIDs(req.MemberIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member_ids: %v", err)
+	}
+
+	conv, err := h.createConvUsecase.Execute(ctx, creatorID, req.Title, memberIDs)
+	if err != nil {
+		return nil, usecaseError(err, "error creating conversation")
+	}
+
+	return &pb.CreateConversationResponse{
+		Conversation: mapConversationToProto(conv),
+	}, nil
+}
+
+// Получение беседы с участниками
+func (h *MessagingHandler) GetConversation(ctx context.Context, req *pb.GetConversationRequest) (*pb.GetConversationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, err := h.getConvUsecase.Execute(ctx, conversationID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting conversation")
+	}
+
+	return &pb.GetConversationResponse{
+		Conversation: mapConversationToProto(conv),
+	}, nil
+}
+
+// Список бесед пользователя
+func (h *MessagingHandler) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	cursor, err := message.DecodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+
+	folder := models.InboxMain
+	if req.Archived {
+		folder = models.InboxArchive
+	}
+	page, err := h.listConvUsecase.Execute(ctx, userID, cursor, folder, int(req.Limit))
+	if err != nil {
+		return nil, usecaseError(err, "error listing conversations")
+	}
+
+	summaries := make([]*pb.ConversationSummary, len(page.Conversations))
+	for i, summary := range page.Conversations {
+		summaries[i] = mapConversationSummaryToProto(summary)
+	}
+
+	resp := &pb.ListConversationsResponse{
+		Conversations: summaries,
+		HasMore:       page.HasMore,
+	}
+	if page.HasMore {
+		resp.NextPageToken = message.EncodePageToken(page.NextCursor)
+	}
+	return resp, nil
+}
+
+// Добавление участников в беседу
+func (h *MessagingHandler) AddConversationMembers(ctx context.Context, req *pb.AddConversationMembersRequest) (*pb.AddConversationMembersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := parseUUIDs(req.MemberIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member_ids: %v", err)
+	}
+
+	members, err := h.addMembersUsecase.Execute(ctx, conversationID, userID, memberIDs)
+	if err != nil {
+		return nil, usecaseError(err, "error adding members")
+	}
+
+	pbMembers := make([]*pb.ConversationMember, len(members))
+	for i, member := range members {
+		pbMembers[i] = mapMemberToProto(member)
+	}
+
+	return &pb.AddConversationMembersResponse{
+		Members: pbMembers,
+	}, nil
+}
+
+// Исключение участника из беседы
+func (h *MessagingHandler) RemoveConversationMember(ctx context.Context, req *pb.RemoveConversationMemberRequest) (*pb.RemoveConversationMemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := gocql.ParseUUID(req.MemberId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member_id: %v", err)
+	}
+
+	if err := h.removeMemberUsecase.Execute(ctx, conversationID, userID, memberID); err != nil {
+		return nil, usecaseError(err, "error removing member")
+	}
+
+	return &pb.RemoveConversationMemberResponse{
+		Success: true,
+	}, nil
+}
+
+// Изменение роли участника беседы
+func (h *MessagingHandler) UpdateConversationMemberRole(ctx context.Context, req *pb.UpdateConversationMemberRoleRequest) (*pb.UpdateConversationMemberRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := gocql.ParseUUID(req.MemberId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member_id: %v", err)
+	}
+
+	if err := h.updateMemberRoleUsecase.Execute(ctx, conversationID, userID, memberID, models.MemberRole(req.Role)); err != nil {
+		return nil, usecaseError(err, "error updating member role")
+	}
+
+	return &pb.UpdateConversationMemberRoleResponse{
+		Success: true,
+	}, nil
+}
+
+// Выход из беседы
+func (h *MessagingHandler) LeaveConversation(ctx context.Context, req *pb.LeaveConversationRequest) (*pb.LeaveConversationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.leaveConvUsecase.Execute(ctx, conversationID, userID); err != nil {
+		return nil, usecaseError(err, "error leaving conversation")
+	}
+
+	return &pb.LeaveConversationResponse{
+		Success: true,
+	}, nil
+}
+
+// Срок жизни новых сообщений беседы
+func (h *MessagingHandler) SetMessageTTL(ctx context.Context, req *pb.SetMessageTTLRequest) (*pb.SetMessageTTLResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	conv, err := h.setMessageTTLUsecase.Execute(ctx, conversationID, userID, ttl)
+	if err != nil {
+		return nil, usecaseError(err, "error setting message ttl")
+	}
+
+	return &pb.SetMessageTTLResponse{
+		Conversation: mapConversationToProto(conv),
+	}, nil
+}
+
+// Архивирование беседы
+func (h *MessagingHandler) SetConversationArchived(ctx context.Context, req *pb.SetConversationArchivedRequest) (*pb.SetConversationArchivedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := h.setArchivedUsecase.Execute(ctx, conversationID, userID, req.Archived)
+	if err != nil {
+		return nil, usecaseError(err, "error archiving conversation")
+	}
+
+	return &pb.SetConversationArchivedResponse{
+		Settings: mapConversationSettingsToProto(entry),
+	}, nil
+}
+
+// Отключение уведомлений беседы
+func (h *MessagingHandler) SetConversationMuted(ctx context.Context, req *pb.SetConversationMutedRequest) (*pb.SetConversationMutedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutedUntil time.Time
+	if req.Muted {
+		mutedUntil = models.MutedForever
+		if req.MutedUntil > 0 {
+			mutedUntil = time.Unix(req.MutedUntil, 0)
+		}
+	}
+	entry, err := h.setMutedUsecase.Execute(ctx, conversationID, userID, mutedUntil)
+	if err != nil {
+		return nil, usecaseError(err, "error muting conversation")
+	}
+
+	return &pb.SetConversationMutedResponse{
+		Settings: mapConversationSettingsToProto(entry),
+	}, nil
+}
+
+// Отметка беседы непрочитанной
+func (h *MessagingHandler) MarkConversationUnread(ctx context.Context, req *pb.MarkConversationUnreadRequest) (*pb.MarkConversationUnreadResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadConversations, err := h.markUnreadUsecase.Execute(ctx, conversationID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error marking conversation unread")
+	}
+
+	return &pb.MarkConversationUnreadResponse{
+		UnreadConversations: int32(unreadConversations),
+	}, nil
+}
+
+func parseConversationAndUser(conversationID, userID string) (gocql.UUID, gocql.UUID, error) {
+	convID, err := gocql.ParseUUID(conversationID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid conversation_id: %v", err)
+	}
+	uID, err := gocql.ParseUUID(userID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	return convID, uID, nil
+}
+
+func parseUUIDs(ids []string) ([]gocql.UUID, error) {
+	uuids := make([]gocql.UUID, len(ids))
+	for i, id := range ids {
+		uuid, err := gocql.ParseUUID(id)
+		if err != nil {
+			return nil, err
+		}
+		uuids[i] = uuid
+	}
+	return uuids, nil
+}
+
+func mapConversationToProto(conv *models.Conversation) *pb.Conversation {
+	members := make([]*pb.ConversationMember, len(conv.Members))
+	for i, member := range conv.Members {
+		members[i] = mapMemberToProto(member)
+	}
+
+	return &pb.Conversation{
+		ConversationId:    conv.ConversationID.String(),
+		Type:              pb.ConversationType(conv.Type),
+		Title:             conv.Title,
+		CreatorId:         conv.CreatorID.String(),
+		CreatedAt:         conv.CreatedAt.Unix(),
+		Members:           members,
+		MessageTtlSeconds: int32(conv.MessageTTL / time.Second),
+	}
+}
+
+func mapMemberToProto(member *models.ConversationMember) *pb.ConversationMember {
+	return &pb.ConversationMember{
+		UserId:   member.UserID.String(),
+		Role:     pb.ConversationMemberRole(member.Role),
+		JoinedAt: member.JoinedAt.Unix(),
+	}
+}
+
+func mapConversationSummaryToProto(summary *models.ConversationSummary) *pb.ConversationSummary {
+	entry := summary.Entry
+	result := &pb.ConversationSummary{
+		Conversation:      mapConversationToProto(summary.Conversation),
+		UnreadCount:       int32(summary.UnreadCount),
+		LastActivityAt:    summary.LastActivityAt().Unix(),
+		LastReadMessageId: uuidOrEmpty(entry.LastReadMessageID),
+		Settings:          mapConversationSettingsToProto(entry),
+	}
+	if entry.HasMessages() {
+		result.LastMessage = &pb.MessagePreview{
+			MessageId: entry.LastMessageID.String(),
+			SenderId:  entry.LastSenderID.String(),
+			Text:      entry.LastMessagePreview,
+			Timestamp: entry.LastMessageID.Time().Unix(),
+		}
+	}
+	if summary.Peer != nil {
+		result.Peer = &pb.UserSummary{
+			UserId:    summary.Peer.UserID.String(),
+			Nickname:  summary.Peer.Nickname,
+			AvatarUrl: summary.Peer.AvatarURL,
+		}
+	}
+	return result
+}
+
+func mapConversationSettingsToProto(entry *models.InboxEntry) *pb.ConversationSettings {
+	settings := &pb.ConversationSettings{
+		Archived:     entry.Archived,
+		Muted:        entry.Muted(time.Now()),
+		MarkedUnread: entry.MarkedUnread,
+	}
+	if settings.Muted && !entry.MutedUntil.Equal(models.MutedForever) {
+		settings.MutedUntil = entry.MutedUntil.Unix()
+	}
+	return settings
+}
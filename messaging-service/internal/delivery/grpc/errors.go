@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Преобразование ошибок usecase в gRPC статусы
+func usecaseError(err error, msg string) error {
+	switch {
+	case errors.Is(err, conversation.ErrConversationNotFound),
+		errors.Is(err, conversation.ErrMemberNotFound),
+		errors.Is(err, message.ErrMessageNotFound),
+		errors.Is(err, message.ErrReplyToNotFound),
+		errors.Is(err, message.ErrScheduledNotFound),
+		errors.Is(err, keys.ErrDeviceNotFound),
+		errors.Is(err, attachment.ErrAttachmentNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, conversation.ErrNotConversationMember),
+		errors.Is(err, conversation.ErrNotConversationAdmin),
+		errors.Is(err, message.ErrNotMessageSender),
+		errors.Is(err, message.ErrBlockedByRecipient),
+		errors.Is(err, message.ErrRecipientFriendsOnly),
+		errors.Is(err, message.ErrRecipientNotAccepting),
+		errors.Is(err, message.ErrNotMessageRecipient),
+		errors.Is(err, message.ErrSystemContent),
+		errors.Is(err, attachment.ErrNotAttachmentUploader),
+		errors.Is(err, attachment.ErrInvalidDownloadToken):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, conversation.ErrDirectConversation),
+		errors.Is(err, conversation.ErrRemoveSelf),
+		errors.Is(err, conversation.ErrLastAdmin),
+		errors.Is(err, message.ErrMessageDeleted),
+		errors.Is(err, message.ErrMessageEncrypted),
+		errors.Is(err, message.ErrGroupEncryption),
+		errors.Is(err, message.ErrDeviceMismatch),
+		errors.Is(err, message.ErrInvalidStatus),
+		errors.Is(err, message.ErrScheduledSending),
+		errors.Is(err, message.ErrScheduledEncrypted),
+		errors.Is(err, message.ErrScheduledContentType),
+		errors.Is(err, message.ErrContentNotEditable),
+		errors.Is(err, attachment.ErrAttachmentAlreadySent):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, message.ErrInvalidPageToken),
+		errors.Is(err, message.ErrInvalidEmoji),
+		errors.Is(err, message.ErrReplyToForeign),
+		errors.Is(err, message.ErrInvalidSendAt),
+		errors.Is(err, message.ErrInvalidExportFormat),
+		errors.Is(err, message.ErrInvalidContent),
+		errors.Is(err, message.ErrInvalidEntities),
+		errors.Is(err, message.ErrContentAttachment),
+		errors.Is(err, message.ErrAttachmentNotImage),
+		errors.Is(err, message.ErrInvalidLocation),
+		errors.Is(err, message.ErrInvalidContact),
+		errors.Is(err, search.ErrInvalidPageToken),
+		errors.Is(err, search.ErrEmptyQuery),
+		errors.Is(err, conversation.ErrInvalidMessageTTL),
+		errors.Is(err, conversation.ErrInvalidMuteUntil),
+		errors.Is(err, keys.ErrIncompleteKeys),
+		errors.Is(err, attachment.ErrEmptyAttachment),
+		errors.Is(err, attachment.ErrAttachmentTypeNotAllowed):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, attachment.ErrAttachmentTooLarge),
+		errors.Is(err, keys.ErrTooManyPreKeys),
+		errors.Is(err, message.ErrTooManyPins),
+		errors.Is(err, message.ErrTooManyScheduled):
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	case errors.Is(err, message.ErrSendInProgress):
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"bufio"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/export"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Выгрузка истории беседы
+func (h *MessagingHandler) ExportConversation(req *pb.ExportConversationRequest, stream pb.MessagingService_ExportConversationServer) error {
+	if err := req.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, err := gocql.ParseUUID(req.ConversationId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid conversation_id: %v", err)
+	}
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	format := models.ExportFormat(req.Format)
+
+	// Сведения о файле отправляются с первой частью, поэтому ошибки доступа приходят до них
+	w := &exportWriter{
+		stream: stream,
+		info: &pb.ExportInfo{
+			FileName: export.FileName(conversationID, format),
+			MimeType: export.MimeType(format),
+		},
+	}
+	buf := bufio.NewWriterSize(w, downloadChunkSize)
+	if err := h.exportConversationUsecase.Execute(stream.Context(), conversationID, userID, format, buf); err != nil {
+		return usecaseError(err, "error exporting conversation")
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	return w.sendInfo()
+}
+
+// exportWriter отправляет выгрузку частями потока
+type exportWriter struct {
+	stream pb.MessagingService_ExportConversationServer
+	info   *pb.ExportInfo
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if err := w.sendInfo(); err != nil {
+		return 0, err
+	}
+	if err := w.stream.Send(&pb.ExportConversationResponse{
+		Data: &pb.ExportConversationResponse_Chunk{Chunk: p},
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// sendInfo отправляет сведения о файле, если они еще не отправлены
+func (w *exportWriter) sendInfo() error {
+	if w.info == nil {
+		return nil
+	}
+	info := w.info
+	w.info = nil
+	return w.stream.Send(&pb.ExportConversationResponse{
+		Data: &pb.ExportConversationResponse_Info{Info: info},
+	})
+}
@@ -2,13 +2,18 @@ package handlers
 
 import (
 	"context"
-	"crypto/sha1"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates"
 	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,25 +23,159 @@ type MessagingHandler struct {
 	sendMessageUsecase         message.SendMessageUsecase
 	getMessagesUsecase         message.GetMessagesUsecase
 	updateMessageStatusUsecase message.UpdateMessageStatusUsecase
+	markReadUsecase            message.MarkConversationReadUsecase
+	streamMessagesUsecase      message.StreamMessagesUsecase
+	editMessageUsecase         message.EditMessageUsecase
+	deleteMessageUsecase       message.DeleteMessageUsecase
+	getEditHistoryUsecase      message.GetMessageEditHistoryUsecase
+	getThreadUsecase           message.GetThreadUsecase
+	uploadAttachmentUsecase    attachment.UploadAttachmentUsecase
+	getAttachmentURLUsecase    attachment.GetAttachmentURLUsecase
+	downloadAttachmentUsecase  attachment.DownloadAttachmentUsecase
+	addReactionUsecase         message.AddReactionUsecase
+	removeReactionUsecase      message.RemoveReactionUsecase
+	listReactionsUsecase       message.ListReactionsUsecase
+	getDirectConvUsecase       conversation.GetDirectConversationUsecase
+	createConvUsecase          conversation.CreateConversationUsecase
+	getConvUsecase             conversation.GetConversationUsecase
+	listConvUsecase            conversation.ListConversationsUsecase
+	addMembersUsecase          conversation.AddMembersUsecase
+	removeMemberUsecase        conversation.RemoveMemberUsecase
+	updateMemberRoleUsecase    conversation.UpdateMemberRoleUsecase
+	leaveConvUsecase           conversation.LeaveConversationUsecase
+	setTypingUsecase           presence.SetTypingUsecase
+	updatePresenceUsecase      presence.UpdatePresenceUsecase
+	getPresenceUsecase         presence.GetPresenceUsecase
+	updPresenceSettingsUsecase presence.UpdatePresenceSettingsUsecase
+	searchMessagesUsecase      search.SearchMessagesUsecase
+	setMessageTTLUsecase       conversation.SetMessageTTLUsecase
+	updMsgSettingsUsecase      message.UpdateMessagingSettingsUsecase
+	getMsgSettingsUsecase      message.GetMessagingSettingsUsecase
+	pinMessageUsecase          message.PinMessageUsecase
+	unpinMessageUsecase        message.UnpinMessageUsecase
+	listPinnedUsecase          message.ListPinnedMessagesUsecase
+	scheduleMessageUsecase     message.ScheduleMessageUsecase
+	listScheduledUsecase       message.ListScheduledMessagesUsecase
+	cancelScheduledUsecase     message.CancelScheduledMessageUsecase
+	rescheduleMessageUsecase   message.RescheduleMessageUsecase
+	exportConversationUsecase  message.ExportConversationUsecase
+	setArchivedUsecase         conversation.SetArchivedUsecase
+	setMutedUsecase            conversation.SetMutedUsecase
+	markUnreadUsecase          message.MarkConversationUnreadUsecase
+	getUpdatesUsecase          updates.GetUpdatesUsecase
 }
 
 func NewMessagingHandler(
 	sendMsgUc message.SendMessageUsecase,
 	getMsgUc message.GetMessagesUsecase,
 	updStatusUc message.UpdateMessageStatusUsecase,
+	markReadUc message.MarkConversationReadUsecase,
+	streamMsgUc message.StreamMessagesUsecase,
+	editMsgUc message.EditMessageUsecase,
+	deleteMsgUc message.DeleteMessageUsecase,
+	getEditHistoryUc message.GetMessageEditHistoryUsecase,
+	getThreadUc message.GetThreadUsecase,
+	uploadAttachmentUc attachment.UploadAttachmentUsecase,
+	getAttachmentURLUc attachment.GetAttachmentURLUsecase,
+	downloadAttachmentUc attachment.DownloadAttachmentUsecase,
+	addReactionUc message.AddReactionUsecase,
+	removeReactionUc message.RemoveReactionUsecase,
+	listReactionsUc message.ListReactionsUsecase,
+	getDirectConvUc conversation.GetDirectConversationUsecase,
+	createConvUc conversation.CreateConversationUsecase,
+	getConvUc conversation.GetConversationUsecase,
+	listConvUc conversation.ListConversationsUsecase,
+	addMembersUc conversation.AddMembersUsecase,
+	removeMemberUc conversation.RemoveMemberUsecase,
+	updMemberRoleUc conversation.UpdateMemberRoleUsecase,
+	leaveConvUc conversation.LeaveConversationUsecase,
+	setTypingUc presence.SetTypingUsecase,
+	updPresenceUc presence.UpdatePresenceUsecase,
+	getPresenceUc presence.GetPresenceUsecase,
+	updPresenceSettingsUc presence.UpdatePresenceSettingsUsecase,
+	searchMessagesUc search.SearchMessagesUsecase,
+	setMessageTTLUc conversation.SetMessageTTLUsecase,
+	updMsgSettingsUc message.UpdateMessagingSettingsUsecase,
+	getMsgSettingsUc message.GetMessagingSettingsUsecase,
+	pinMsgUc message.PinMessageUsecase,
+	unpinMsgUc message.UnpinMessageUsecase,
+	listPinnedUc message.ListPinnedMessagesUsecase,
+	scheduleMsgUc message.ScheduleMessageUsecase,
+	listScheduledUc message.ListScheduledMessagesUsecase,
+	cancelScheduledUc message.CancelScheduledMessageUsecase,
+	rescheduleMsgUc message.RescheduleMessageUsecase,
+	exportConvUc message.ExportConversationUsecase,
+	setArchivedUc conversation.SetArchivedUsecase,
+	setMutedUc conversation.SetMutedUsecase,
+	markUnreadUc message.MarkConversationUnreadUsecase,
+	getUpdatesUc updates.GetUpdatesUsecase,
 ) *MessagingHandler {
 	return &MessagingHandler{
 		sendMessageUsecase:         sendMsgUc,
 		getMessagesUsecase:         getMsgUc,
 		updateMessageStatusUsecase: updStatusUc,
+		markReadUsecase:            markReadUc,
+		streamMessagesUsecase:      streamMsgUc,
+		editMessageUsecase:         editMsgUc,
+		deleteMessageUsecase:       deleteMsgUc,
+		getEditHistoryUsecase:      getEditHistoryUc,
+		getThreadUsecase:           getThreadUc,
+		uploadAttachmentUsecase:    uploadAttachmentUc,
+		getAttachmentURLUsecase:    getAttachmentURLUc,
+		downloadAttachmentUsecase:  downloadAttachmentUc,
+		addReactionUsecase:         addReactionUc,
+		removeReactionUsecase:      removeReactionUc,
+		listReactionsUsecase:       listReactionsUc,
+		getDirectConvUsecase:       getDirectConvUc,
+		createConvUsecase:          createConvUc,
+		getConvUsecase:             getConvUc,
+		listConvUsecase:            listConvUc,
+		addMembersUsecase:          addMembersUc,
+		removeMemberUsecase:        removeMemberUc,
+		updateMemberRoleUsecase:    updMemberRoleUc,
+		leaveConvUsecase:           leaveConvUc,
+		setTypingUsecase:           setTypingUc,
+		updatePresenceUsecase:      updPresenceUc,
+		getPresenceUsecase:         getPresenceUc,
+		updPresenceSettingsUsecase: updPresenceSettingsUc,
+		searchMessagesUsecase:      searchMessagesUc,
+		setMessageTTLUsecase:       setMessageTTLUc,
+		updMsgSettingsUsecase:      updMsgSettingsUc,
+		getMsgSettingsUsecase:      getMsgSettingsUc,
+		pinMessageUsecase:          pinMsgUc,
+		unpinMessageUsecase:        unpinMsgUc,
+		listPinnedUsecase:          listPinnedUc,
+		scheduleMessageUsecase:     scheduleMsgUc,
+		listScheduledUsecase:       listScheduledUc,
+		cancelScheduledUsecase:     cancelScheduledUc,
+		rescheduleMessageUsecase:   rescheduleMsgUc,
+		exportConversationUsecase:  exportConvUc,
+		setArchivedUsecase:         setArchivedUc,
+		setMutedUsecase:            setMutedUc,
+		markUnreadUsecase:          markUnreadUc,
+		getUpdatesUsecase:          getUpdatesUc,
 	}
 }
 
 // Отправка сообщения
 func (h *MessagingHandler) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
 	// Валидация запроса
-	if req.SenderId == "" || req.RecipientId == "" || req.Content == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "sender_id, recipient_id and content must not be empty")
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+	encrypted := len(req.EncryptedPayloads) > 0
+	hasPayload := req.Payload != nil
+	if req.SenderId == "" || (req.Content == "" && !hasPayload && len(req.AttachmentIds) == 0 && !encrypted) {
+		return nil, status.Errorf(codes.InvalidArgument, "sender_id and content, payload, attachment_ids or encrypted_payloads must not be empty")
+	}
+	if hasPayload && req.Content != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content must be empty when payload is set")
+	}
+	if encrypted && (req.Content != "" || hasPayload || req.SenderDeviceId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "encrypted message requires sender_device_id and empty content and payload")
+	}
+	if (req.RecipientId == "") == (req.ConversationId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of recipient_id and conversation_id must be set")
 	}
 
 	// Преобразование ID в UUID
@@ -45,15 +184,10 @@ func (h *MessagingHandler) SendMessage(ctx context.Context, req *pb.SendMessageR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid sender_id: %v", err)
 	}
 
-	recipientID, err := gocql.ParseUUID(req.RecipientId)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient_id: %v", err)
-	}
-
 	// Определение ID беседы
-	conversationID, err := generateConversationID(senderID, recipientID)
+	conversationID, recipientID, err := h.resolveConversation(ctx, senderID, req.RecipientId, req.ConversationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error generating conversationID: %v", err)
+		return nil, err
 	}
 
 	// Создание сущности сообщения
@@ -62,15 +196,57 @@ func (h *MessagingHandler) SendMessage(ctx context.Context, req *pb.SendMessageR
 		SenderID:       senderID,
 		RecipientID:    recipientID,
 		ConversationID: conversationID,
-		Content:        req.Content,
 		Timestamp:      time.Now(),
 		Status:         models.StatusSent,
 	}
+	if err := parseSendPayload(msg, req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payload: %v", err)
+	}
+	for _, id := range req.AttachmentIds {
+		attachmentID, err := gocql.ParseUUID(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attachment_ids: %v", err)
+		}
+		msg.Attachments = append(msg.Attachments, &models.Attachment{AttachmentID: attachmentID})
+	}
+	if req.ReplyToMessageId != "" {
+		msg.ReplyToMessageID, err = gocql.ParseUUID(req.ReplyToMessageId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reply_to_message_id: %v", err)
+		}
+	}
+	if req.ClientMessageId != "" {
+		msg.ClientMessageID, err = gocql.ParseUUID(req.ClientMessageId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid client_message_id: %v", err)
+		}
+	}
+	if encrypted {
+		msg.SenderDeviceID, err = gocql.ParseUUID(req.SenderDeviceId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender_device_id: %v", err)
+		}
+		msg.EncryptedPayloads, err = parseEncryptedPayloads(req.EncryptedPayloads)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid encrypted_payloads: %v", err)
+		}
+	}
+
+	// Сообщение с будущим временем отправки откладывается, прошедшее время отправляется сразу
+	if sendAt := time.Unix(req.SendAt, 0); req.SendAt > 0 && sendAt.After(msg.Timestamp) {
+		scheduled, err := h.scheduleMessageUsecase.Execute(ctx, msg, sendAt)
+		if err != nil {
+			return nil, usecaseError(err, "error scheduling message")
+		}
+		return &pb.SendMessageResponse{
+			ScheduledMessage: mapScheduledMessageToProto(scheduled),
+		}, nil
+	}
 
 	// Запуск usecase отправки сообщения
 	err = h.sendMessageUsecase.Execute(ctx, msg)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error sending message: %v", err)
+		return nil, usecaseError(err, "error sending message")
 	}
 
 	// Формирование ответа
@@ -82,8 +258,11 @@ func (h *MessagingHandler) SendMessage(ctx context.Context, req *pb.SendMessageR
 // Получение сообщений
 func (h *MessagingHandler) GetMessages(ctx context.Context, req *pb.GetMessagesRequest) (*pb.GetMessagesResponse, error) {
 	// Валидация запроса
-	if req.UserId == "" || req.ConversationUserId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "user_id and conversation_user_id must not be empty")
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id must not be empty")
+	}
+	if (req.ConversationUserId == "") == (req.ConversationId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of conversation_user_id and conversation_id must be set")
 	}
 
 	// Преобразование ID в UUID
@@ -92,47 +271,46 @@ func (h *MessagingHandler) GetMessages(ctx context.Context, req *pb.GetMessagesR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
 	}
 
-	conversationUserID, err := gocql.ParseUUID(req.ConversationUserId)
+	// Определение ID беседы
+	conversationID, _, err := h.resolveConversation(ctx, userID, req.ConversationUserId, req.ConversationId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid conversation_user_id: %v", err)
+		return nil, err
 	}
 
-	// Определение ID беседы
-	conversationID, err := generateConversationID(userID, conversationUserID)
+	// Курсор страницы
+	cursor, err := message.DecodePageToken(req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error generating conversationID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
 	}
 
 	// Запуск usecase получения сообщений
-	messages, err := h.getMessagesUsecase.Execute(ctx, conversationID, int(req.Limit))
+	page, err := h.getMessagesUsecase.Execute(ctx, conversationID, userID, models.PageQuery{
+		Cursor:    cursor,
+		Direction: models.PageDirection(req.Direction),
+		Limit:     int(req.Limit),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error getting messages: %v", err)
+		return nil, usecaseError(err, "error getting messages")
 	}
 
 	// Преобразование сообщений в формат proto
-	pbMessages := make([]*pb.Message, len(messages))
-	for i, msg := range messages {
-		pbMessages[i] = &pb.Message{
-			MessageId:   msg.MessageID.String(),
-			SenderId:    msg.SenderID.String(),
-			RecipientId: msg.RecipientID.String(),
-			Content:     msg.Content,
-			Timestamp:   msg.Timestamp.Unix(),
-			Status:      pb.MessageStatus(msg.Status),
-		}
+	pbMessages := make([]*pb.Message, len(page.Messages))
+	for i, msg := range page.Messages {
+		pbMessages[i] = mapMessageToProto(msg)
 	}
 
 	// Формирование ответа
 	return &pb.GetMessagesResponse{
-		Messages: pbMessages,
+		Messages:      pbMessages,
+		NextPageToken: message.EncodePageToken(page.NextCursor),
+		HasMore:       page.HasMore,
 	}, nil
 }
 
 // Обновление статуса сообщения
 func (h *MessagingHandler) UpdateMessageStatus(ctx context.Context, req *pb.UpdateMessageStatusRequest) (*pb.UpdateMessageStatusResponse, error) {
-	// Валидация запроса
-	if req.MessageId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "message_id must not be empty")
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
 	}
 
 	// Преобразование ID в UUID
@@ -140,14 +318,15 @@ func (h *MessagingHandler) UpdateMessageStatus(ctx context.Context, req *pb.Upda
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid message_id: %v", err)
 	}
-
-	// Преобразование статуса
-	statusMsg := models.MessageStatus(req.Status)
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
 
 	// Обновление статуса сообщения
-	err = h.updateMessageStatusUsecase.Execute(ctx, messageID, statusMsg)
+	err = h.updateMessageStatusUsecase.Execute(ctx, messageID, userID, models.MessageStatus(req.Status))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error updating message status: %v", err)
+		return nil, usecaseError(err, "error updating message status")
 	}
 
 	// Формирование ответа
@@ -156,25 +335,389 @@ func (h *MessagingHandler) UpdateMessageStatus(ctx context.Context, req *pb.Upda
 	}, nil
 }
 
-// Генерация ID беседы на основе ID пользователей
-func generateConversationID(user1ID, user2ID gocql.UUID) (gocql.UUID, error) {
-	var (
-		minID, maxID string
-	)
-	if user1ID.String() < user2ID.String() {
-		minID = user1ID.String()
-		maxID = user2ID.String()
-	} else {
-		minID = user2ID.String()
-		maxID = user1ID.String()
+// Отметка беседы прочитанной
+func (h *MessagingHandler) MarkConversationRead(ctx context.Context, req *pb.MarkConversationReadRequest) (*pb.MarkConversationReadResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// Пустой идентификатор сообщения отмечает прочитанной всю беседу
+	var messageID gocql.UUID
+	if req.MessageId != "" {
+		if messageID, err = gocql.ParseUUID(req.MessageId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid message_id: %v", err)
+		}
+	}
+
+	state, err := h.markReadUsecase.Execute(ctx, conversationID, userID, messageID)
+	if err != nil {
+		return nil, usecaseError(err, "error marking conversation read")
+	}
+
+	return &pb.MarkConversationReadResponse{
+		LastReadMessageId:   uuidOrEmpty(state.LastReadMessageID),
+		UnreadCount:         int32(state.UnreadCount),
+		UnreadConversations: int32(state.UnreadConversations),
+	}, nil
+}
+
+// Редактирование сообщения
+func (h *MessagingHandler) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.EditMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := h.editMessageUsecase.Execute(ctx, messageID, userID, req.Content, parseTextEntities(req.Entities))
+	if err != nil {
+		return nil, usecaseError(err, "error editing message")
+	}
+
+	return &pb.EditMessageResponse{
+		Message: mapMessageToProto(msg),
+	}, nil
+}
+
+// Удаление сообщения
+func (h *MessagingHandler) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.DeleteMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.deleteMessageUsecase.Execute(ctx, messageID, userID, models.DeleteMode(req.Mode)); err != nil {
+		return nil, usecaseError(err, "error deleting message")
+	}
+
+	return &pb.DeleteMessageResponse{
+		Success: true,
+	}, nil
+}
+
+// История правок сообщения
+func (h *MessagingHandler) GetMessageEditHistory(ctx context.Context, req *pb.GetMessageEditHistoryRequest) (*pb.GetMessageEditHistoryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	edits, err := h.getEditHistoryUsecase.Execute(ctx, messageID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting edit history")
+	}
+
+	pbEdits := make([]*pb.MessageEdit, len(edits))
+	for i, edit := range edits {
+		pbEdits[i] = &pb.MessageEdit{
+			Content:  edit.Content,
+			EditedAt: edit.EditedAt.Unix(),
+		}
+	}
+
+	return &pb.GetMessageEditHistoryResponse{
+		Edits: pbEdits,
+	}, nil
+}
+
+// Ветка ответов на сообщение
+func (h *MessagingHandler) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.GetThreadResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	h := sha1.New()
-	h.Write([]byte(minID + maxID))
-	hashed := h.Sum(nil)
-	conversationID, err := gocql.UUIDFromBytes(hashed[:16])
+	cursor, err := message.DecodePageToken(req.PageToken)
 	if err != nil {
-		return gocql.UUID{}, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+	}
+
+	thread, err := h.getThreadUsecase.Execute(ctx, messageID, userID, models.PageQuery{
+		Cursor: cursor,
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		return nil, usecaseError(err, "error getting thread")
+	}
+
+	pbReplies := make([]*pb.Message, len(thread.Replies))
+	for i, reply := range thread.Replies {
+		pbReplies[i] = mapMessageToProto(reply)
+	}
+
+	resp := &pb.GetThreadResponse{
+		Replies:       pbReplies,
+		NextPageToken: message.EncodePageToken(thread.NextCursor),
+		HasMore:       thread.HasMore,
+	}
+	if thread.Root != nil {
+		resp.Root = mapMessageToProto(thread.Root)
+	}
+	return resp, nil
+}
+
+// Поток событий сообщений пользователя
+func (h *MessagingHandler) StreamMessages(req *pb.StreamMessagesRequest, stream pb.MessagingService_StreamMessagesServer) error {
+	// Валидация запроса
+	if req.UserId == "" {
+		return status.Errorf(codes.InvalidArgument, "user_id must not be empty")
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	ctx := stream.Context()
+
+	// События содержат переписку пользователя, поэтому поток отдается только владельцу JWT
+	authenticatedID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	if authenticatedID != req.UserId {
+		return status.Error(codes.PermissionDenied, "user_id does not match the authenticated user")
+	}
+
+	// Подписка на события пользователя
+	events, err := h.streamMessagesUsecase.Execute(ctx, userID, req.LastEventId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error subscribing to messages: %v", err)
+	}
+
+	for event := range events {
+		if err := stream.Send(mapEventToProto(event)); err != nil {
+			return err
+		}
+	}
+
+	// Канал событий закрывается только при завершении контекста
+	return status.FromContextError(ctx.Err()).Err()
+}
+
+// Определение беседы по собеседнику личной переписки или по идентификатору беседы.
+// Для личной переписки также возвращается идентификатор собеседника.
+func (h *MessagingHandler) resolveConversation(ctx context.Context, userID gocql.UUID, peerID, conversationID string) (gocql.UUID, gocql.UUID, error) {
+	if conversationID != "" {
+		id, err := gocql.ParseUUID(conversationID)
+		if err != nil {
+			return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid conversation_id: %v", err)
+		}
+		return id, gocql.UUID{}, nil
+	}
+
+	peer, err := gocql.ParseUUID(peerID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid peer id: %v", err)
+	}
+	if peer == userID {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "direct conversation with yourself is not supported")
+	}
+
+	conv, err := h.getDirectConvUsecase.Execute(ctx, userID, peer)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.Internal, "error getting direct conversation: %v", err)
+	}
+	return conv.ConversationID, peer, nil
+}
+
+func parseMessageAndUser(messageID, userID string) (gocql.UUID, gocql.UUID, error) {
+	msgID, err := gocql.ParseUUID(messageID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid message_id: %v", err)
+	}
+	uID, err := gocql.ParseUUID(userID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	return msgID, uID, nil
+}
+
+func mapMessageToProto(msg *models.Message) *pb.Message {
+	// У сообщений групповой беседы нет отдельного получателя
+	result := &pb.Message{
+		MessageId:         msg.MessageID.String(),
+		SenderId:          msg.SenderID.String(),
+		RecipientId:       uuidOrEmpty(msg.RecipientID),
+		ConversationId:    msg.ConversationID.String(),
+		Content:           msg.Content,
+		Timestamp:         msg.Timestamp.Unix(),
+		Status:            pb.MessageStatus(msg.Status),
+		Edited:            msg.Edited(),
+		EditedAt:          unixOrZero(msg.EditedAt),
+		Deleted:           msg.Deleted,
+		Reactions:         mapReactionSummariesToProto(msg.Reactions),
+		ReplyToMessageId:  uuidOrEmpty(msg.ReplyToMessageID),
+		ThreadRootId:      uuidOrEmpty(msg.ThreadRootID),
+		ReplyCount:        int32(msg.ReplyCount),
+		Attachments:       mapAttachmentsToProto(msg.Attachments),
+		ExpiresAt:         unixOrZero(msg.ExpiresAt),
+		SenderDeviceId:    uuidOrEmpty(msg.SenderDeviceID),
+		EncryptedPayloads: mapEncryptedPayloadsToProto(msg.EncryptedPayloads),
+		DeliveredAt:       unixOrZero(msg.DeliveredAt),
+		ReadAt:            unixOrZero(msg.ReadAt),
+		Mentions:          mapMentionsToProto(msg.Mentions),
+	}
+	setMessagePayload(result, msg)
+	return result
+}
+
+func parseEncryptedPayloads(payloads []*pb.EncryptedPayload) ([]*models.EncryptedPayload, error) {
+	result := make([]*models.EncryptedPayload, 0, len(payloads))
+	for _, payload := range payloads {
+		userID, err := gocql.ParseUUID(payload.UserId)
+		if err != nil {
+			return nil, err
+		}
+		deviceID, err := gocql.ParseUUID(payload.DeviceId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &models.EncryptedPayload{
+			UserID:     userID,
+			DeviceID:   deviceID,
+			Type:       models.EncryptedPayloadType(payload.Type),
+			Ciphertext: payload.Ciphertext,
+		})
+	}
+	return result, nil
+}
+
+func mapMentionsToProto(mentions []*models.Mention) []*pb.Mention {
+	if len(mentions) == 0 {
+		return nil
+	}
+	result := make([]*pb.Mention, 0, len(mentions))
+	for _, mention := range mentions {
+		result = append(result, &pb.Mention{
+			UserId: mention.UserID.String(),
+			Offset: int32(mention.Offset),
+			Length: int32(mention.Length),
+		})
+	}
+	return result
+}
+
+// Шифротексты всех устройств отдаются обоим собеседникам, устройство выбирает свой
+func mapEncryptedPayloadsToProto(payloads []*models.EncryptedPayload) []*pb.EncryptedPayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+	result := make([]*pb.EncryptedPayload, 0, len(payloads))
+	for _, payload := range payloads {
+		result = append(result, &pb.EncryptedPayload{
+			UserId:     payload.UserID.String(),
+			DeviceId:   payload.DeviceID.String(),
+			Type:       pb.EncryptedPayloadType(payload.Type),
+			Ciphertext: payload.Ciphertext,
+		})
+	}
+	return result
+}
+
+// Пустой идентификатор передается клиенту как пустая строка
+func uuidOrEmpty(id gocql.UUID) string {
+	if id == (gocql.UUID{}) {
+		return ""
+	}
+	return id.String()
+}
+
+// Пустая временная метка передается клиенту как 0
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func mapEventToProto(event *models.MessageEvent) *pb.MessageEvent {
+	eventType := pb.MessageEventType_MESSAGE_EVENT_TYPE_UNSPECIFIED
+	switch event.Type {
+	case models.EventMessageCreated:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_CREATED
+	case models.EventMessageStatusChanged:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_STATUS_CHANGED
+	case models.EventMessageEdited:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_EDITED
+	case models.EventMessageDeleted:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_DELETED
+	case models.EventReactionAdded:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_REACTION_ADDED
+	case models.EventReactionRemoved:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_REACTION_REMOVED
+	case models.EventConversationRead:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_READ
+	case models.EventTyping:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_TYPING
+	case models.EventPresenceChanged:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_PRESENCE_CHANGED
+	case models.EventPreKeysLow:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_PRE_KEYS_LOW
+	case models.EventMessagePinned:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_MESSAGE_PINNED
+	case models.EventMessageUnpinned:
+		eventType = pb.MessageEventType_MESSAGE_EVENT_TYPE_MESSAGE_UNPINNED
+	}
+
+	pbEvent := &pb.MessageEvent{
+		EventId:   event.EventID,
+		Type:      eventType,
+		Timestamp: event.Timestamp.Unix(),
+	}
+	// Событие прочтения относится к беседе, а не к отдельному сообщению
+	if event.Message != nil {
+		pbEvent.Message = mapMessageToProto(event.Message)
+	}
+	if event.Reaction != nil {
+		pbEvent.Reaction = mapReactionToProto(event.Reaction)
+	}
+	if event.Receipt != nil {
+		pbEvent.ReadReceipt = &pb.ReadReceipt{
+			ConversationId:    event.Receipt.ConversationID.String(),
+			UserId:            event.Receipt.UserID.String(),
+			LastReadMessageId: event.Receipt.LastReadMessageID.String(),
+			ReadAt:            event.Receipt.ReadAt.Unix(),
+		}
+	}
+	if event.Typing != nil {
+		pbEvent.Typing = &pb.TypingIndicator{
+			ConversationId: event.Typing.ConversationID.String(),
+			UserId:         event.Typing.UserID.String(),
+			Typing:         event.Typing.Typing,
+		}
+	}
+	if event.Presence != nil {
+		pbEvent.Presence = mapPresenceToProto(event.Presence)
+	}
+	if event.PreKeys != nil {
+		pbEvent.PreKeyStatus = mapPreKeyStatusToProto(event.PreKeys)
+	}
+	// Закрепленное сообщение уже передано в самом событии
+	if event.Pin != nil {
+		pbEvent.Pin = &pb.PinnedMessage{
+			PinnedBy: event.Pin.PinnedBy.String(),
+			PinnedAt: unixOrZero(event.Pin.PinnedAt),
+		}
 	}
-	return conversationID, nil
+	return pbEvent
 }
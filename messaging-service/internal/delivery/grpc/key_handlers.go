@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type KeyDirectoryHandler struct {
+	pb.UnimplementedKeyDirectoryServiceServer
+	uploadKeysUsecase       keys.UploadKeysUsecase
+	getPreKeyBundlesUsecase keys.GetPreKeyBundlesUsecase
+	getPreKeyStatusUsecase  keys.GetPreKeyStatusUsecase
+}
+
+func NewKeyDirectoryHandler(
+	uploadKeysUsecase keys.UploadKeysUsecase,
+	getPreKeyBundlesUsecase keys.GetPreKeyBundlesUsecase,
+	getPreKeyStatusUsecase keys.GetPreKeyStatusUsecase,
+) *KeyDirectoryHandler {
+	return &KeyDirectoryHandler{
+		uploadKeysUsecase:       uploadKeysUsecase,
+		getPreKeyBundlesUsecase: getPreKeyBundlesUsecase,
+		getPreKeyStatusUsecase:  getPreKeyStatusUsecase,
+	}
+}
+
+// Загрузка ключей устройства
+func (h *KeyDirectoryHandler) UploadKeys(ctx context.Context, req *pb.UploadKeysRequest) (*pb.UploadKeysResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	deviceID, err := gocql.ParseUUID(req.DeviceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid device_id: %v", err)
+	}
+
+	upload := &models.KeyUpload{
+		UserID:      userID,
+		DeviceID:    deviceID,
+		IdentityKey: req.IdentityKey,
+	}
+	if req.SignedPreKey != nil {
+		upload.SignedPreKey = &models.SignedPreKey{
+			KeyID:     req.SignedPreKey.KeyId,
+			PublicKey: req.SignedPreKey.PublicKey,
+			Signature: req.SignedPreKey.Signature,
+		}
+	}
+	for _, key := range req.OneTimePreKeys {
+		upload.OneTimePreKeys = append(upload.OneTimePreKeys, &models.OneTimePreKey{
+			KeyID:     key.KeyId,
+			PublicKey: key.PublicKey,
+		})
+	}
+
+	preKeyStatus, err := h.uploadKeysUsecase.Execute(ctx, upload)
+	if err != nil {
+		return nil, usecaseError(err, "error uploading keys")
+	}
+
+	return &pb.UploadKeysResponse{
+		Status: mapPreKeyStatusToProto(preKeyStatus),
+	}, nil
+}
+
+// Наборы ключей устройств пользователя
+func (h *KeyDirectoryHandler) GetPreKeyBundles(ctx context.Context, req *pb.GetPreKeyBundlesRequest) (*pb.GetPreKeyBundlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	targetUserID, err := gocql.ParseUUID(req.TargetUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target_user_id: %v", err)
+	}
+
+	bundles, err := h.getPreKeyBundlesUsecase.Execute(ctx, targetUserID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting pre-key bundles")
+	}
+
+	resp := &pb.GetPreKeyBundlesResponse{
+		Bundles: make([]*pb.PreKeyBundle, len(bundles)),
+	}
+	for i, bundle := range bundles {
+		resp.Bundles[i] = mapPreKeyBundleToProto(bundle)
+	}
+	return resp, nil
+}
+
+// Остаток одноразовых ключей устройства
+func (h *KeyDirectoryHandler) GetPreKeyStatus(ctx context.Context, req *pb.GetPreKeyStatusRequest) (*pb.GetPreKeyStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	deviceID, err := gocql.ParseUUID(req.DeviceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid device_id: %v", err)
+	}
+
+	preKeyStatus, err := h.getPreKeyStatusUsecase.Execute(ctx, userID, deviceID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting pre-key status")
+	}
+
+	return &pb.GetPreKeyStatusResponse{
+		Status: mapPreKeyStatusToProto(preKeyStatus),
+	}, nil
+}
+
+func mapPreKeyBundleToProto(bundle *models.PreKeyBundle) *pb.PreKeyBundle {
+	pbBundle := &pb.PreKeyBundle{
+		UserId:      bundle.Device.UserID.String(),
+		DeviceId:    bundle.Device.DeviceID.String(),
+		IdentityKey: bundle.Device.IdentityKey,
+	}
+	if bundle.Device.SignedPreKey != nil {
+		pbBundle.SignedPreKey = &pb.SignedPreKey{
+			KeyId:     bundle.Device.SignedPreKey.KeyID,
+			PublicKey: bundle.Device.SignedPreKey.PublicKey,
+			Signature: bundle.Device.SignedPreKey.Signature,
+		}
+	}
+	if bundle.OneTimePreKey != nil {
+		pbBundle.OneTimePreKey = &pb.OneTimePreKey{
+			KeyId:     bundle.OneTimePreKey.KeyID,
+			PublicKey: bundle.OneTimePreKey.PublicKey,
+		}
+	}
+	return pbBundle
+}
+
+func mapPreKeyStatusToProto(preKeyStatus *models.PreKeyStatus) *pb.PreKeyStatus {
+	return &pb.PreKeyStatus{
+		DeviceId:  preKeyStatus.DeviceID.String(),
+		Remaining: int32(preKeyStatus.Remaining),
+		Replenish: preKeyStatus.Replenish,
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Закрепление сообщения
+func (h *MessagingHandler) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.PinMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := h.pinMessageUsecase.Execute(ctx, messageID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error pinning message")
+	}
+
+	return &pb.PinMessageResponse{
+		PinnedMessage: mapPinnedMessageToProto(pin),
+	}, nil
+}
+
+// Открепление сообщения
+func (h *MessagingHandler) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*pb.UnpinMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := h.unpinMessageUsecase.Execute(ctx, messageID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error unpinning message")
+	}
+
+	return &pb.UnpinMessageResponse{
+		Success: removed,
+	}, nil
+}
+
+// Закрепленные сообщения беседы
+func (h *MessagingHandler) ListPinnedMessages(ctx context.Context, req *pb.ListPinnedMessagesRequest) (*pb.ListPinnedMessagesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := h.listPinnedUsecase.Execute(ctx, conversationID, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error listing pinned messages")
+	}
+
+	resp := &pb.ListPinnedMessagesResponse{
+		PinnedMessages: make([]*pb.PinnedMessage, len(pins)),
+	}
+	for i, pin := range pins {
+		resp.PinnedMessages[i] = mapPinnedMessageToProto(pin)
+	}
+	return resp, nil
+}
+
+func mapPinnedMessageToProto(pin *models.PinnedMessage) *pb.PinnedMessage {
+	pbPin := &pb.PinnedMessage{
+		PinnedBy: pin.PinnedBy.String(),
+		PinnedAt: unixOrZero(pin.PinnedAt),
+	}
+	if pin.Message != nil {
+		pbPin.Message = mapMessageToProto(pin.Message)
+	}
+	return pbPin
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Индикатор набора сообщения в беседе
+func (h *MessagingHandler) SetTyping(ctx context.Context, req *pb.SetTypingRequest) (*pb.SetTypingResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	conversationID, userID, err := parseConversationAndUser(req.ConversationId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.setTypingUsecase.Execute(ctx, conversationID, userID, req.Typing); err != nil {
+		return nil, usecaseError(err, "error setting typing")
+	}
+
+	return &pb.SetTypingResponse{
+		Success: true,
+	}, nil
+}
+
+// Сигнал присутствия пользователя в сети
+func (h *MessagingHandler) UpdatePresence(ctx context.Context, req *pb.UpdatePresenceRequest) (*pb.UpdatePresenceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	interval, err := h.updatePresenceUsecase.Execute(ctx, userID, req.Online)
+	if err != nil {
+		return nil, usecaseError(err, "error updating presence")
+	}
+
+	return &pb.UpdatePresenceResponse{
+		HeartbeatIntervalSeconds: int32(interval.Seconds()),
+	}, nil
+}
+
+// Присутствие в сети для списка пользователей
+func (h *MessagingHandler) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	requesterID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	userIDs, err := parseUUIDs(req.UserIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_ids: %v", err)
+	}
+
+	presences, err := h.getPresenceUsecase.Execute(ctx, requesterID, userIDs)
+	if err != nil {
+		return nil, usecaseError(err, "error getting presence")
+	}
+
+	resp := &pb.GetPresenceResponse{
+		Presences: make([]*pb.UserPresence, len(presences)),
+	}
+	for i, presence := range presences {
+		resp.Presences[i] = mapPresenceToProto(presence)
+	}
+	return resp, nil
+}
+
+// Настройки видимости присутствия пользователя
+func (h *MessagingHandler) UpdatePresenceSettings(ctx context.Context, req *pb.UpdatePresenceSettingsRequest) (*pb.UpdatePresenceSettingsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	if err := h.updPresenceSettingsUsecase.Execute(ctx, userID, req.HideLastSeen); err != nil {
+		return nil, usecaseError(err, "error updating presence settings")
+	}
+
+	return &pb.UpdatePresenceSettingsResponse{
+		Success: true,
+	}, nil
+}
+
+func mapPresenceToProto(presence *models.Presence) *pb.UserPresence {
+	return &pb.UserPresence{
+		UserId:         presence.UserID.String(),
+		Online:         presence.Online,
+		LastSeenAt:     unixOrZero(presence.LastSeenAt),
+		LastSeenHidden: presence.LastSeenHidden,
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Добавление реакции на сообщение
+func (h *MessagingHandler) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := h.addReactionUsecase.Execute(ctx, messageID, userID, req.Emoji)
+	if err != nil {
+		return nil, usecaseError(err, "error adding reaction")
+	}
+
+	return &pb.AddReactionResponse{
+		Reactions: mapReactionSummariesToProto(summaries),
+	}, nil
+}
+
+// Удаление реакции с сообщения
+func (h *MessagingHandler) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := h.removeReactionUsecase.Execute(ctx, messageID, userID, req.Emoji)
+	if err != nil {
+		return nil, usecaseError(err, "error removing reaction")
+	}
+
+	return &pb.RemoveReactionResponse{
+		Reactions: mapReactionSummariesToProto(summaries),
+	}, nil
+}
+
+// Список пользователей, поставивших реакции
+func (h *MessagingHandler) ListReactions(ctx context.Context, req *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	messageID, userID, err := parseMessageAndUser(req.MessageId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, nextPageToken, err := h.listReactionsUsecase.Execute(ctx, messageID, userID, req.Emoji, req.PageToken, int(req.Limit))
+	if err != nil {
+		return nil, usecaseError(err, "error listing reactions")
+	}
+
+	pbReactions := make([]*pb.Reaction, len(reactions))
+	for i, reaction := range reactions {
+		pbReactions[i] = mapReactionToProto(reaction)
+	}
+
+	return &pb.ListReactionsResponse{
+		Reactions:     pbReactions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func mapReactionToProto(reaction *models.Reaction) *pb.Reaction {
+	return &pb.Reaction{
+		UserId:    reaction.UserID.String(),
+		Emoji:     reaction.Emoji,
+		CreatedAt: reaction.CreatedAt.Unix(),
+	}
+}
+
+func mapReactionSummariesToProto(summaries []*models.ReactionSummary) []*pb.ReactionSummary {
+	pbSummaries := make([]*pb.ReactionSummary, len(summaries))
+	for i, summary := range summaries {
+		pbSummaries[i] = &pb.ReactionSummary{
+			Emoji:       summary.Emoji,
+			Count:       int32(summary.Count),
+			ReactedByMe: summary.ReactedByMe,
+		}
+	}
+	return pbSummaries
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Запланированные сообщения пользователя
+func (h *MessagingHandler) ListScheduledMessages(ctx context.Context, req *pb.ListScheduledMessagesRequest) (*pb.ListScheduledMessagesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	scheduled, err := h.listScheduledUsecase.Execute(ctx, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error listing scheduled messages")
+	}
+
+	resp := &pb.ListScheduledMessagesResponse{
+		ScheduledMessages: make([]*pb.ScheduledMessage, len(scheduled)),
+	}
+	for i, item := range scheduled {
+		resp.ScheduledMessages[i] = mapScheduledMessageToProto(item)
+	}
+	return resp, nil
+}
+
+// Отмена запланированного сообщения
+func (h *MessagingHandler) CancelScheduledMessage(ctx context.Context, req *pb.CancelScheduledMessageRequest) (*pb.CancelScheduledMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, scheduledID, err := parseUserAndScheduled(req.UserId, req.ScheduledMessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.cancelScheduledUsecase.Execute(ctx, userID, scheduledID); err != nil {
+		return nil, usecaseError(err, "error cancelling scheduled message")
+	}
+
+	return &pb.CancelScheduledMessageResponse{
+		Success: true,
+	}, nil
+}
+
+// Перенос запланированного сообщения
+func (h *MessagingHandler) RescheduleMessage(ctx context.Context, req *pb.RescheduleMessageRequest) (*pb.RescheduleMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, scheduledID, err := parseUserAndScheduled(req.UserId, req.ScheduledMessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := h.rescheduleMessageUsecase.Execute(ctx, userID, scheduledID, time.Unix(req.SendAt, 0))
+	if err != nil {
+		return nil, usecaseError(err, "error rescheduling message")
+	}
+
+	return &pb.RescheduleMessageResponse{
+		ScheduledMessage: mapScheduledMessageToProto(scheduled),
+	}, nil
+}
+
+func parseUserAndScheduled(userID, scheduledID string) (gocql.UUID, gocql.UUID, error) {
+	uID, err := gocql.ParseUUID(userID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	sID, err := gocql.ParseUUID(scheduledID)
+	if err != nil {
+		return gocql.UUID{}, gocql.UUID{}, status.Errorf(codes.InvalidArgument, "invalid scheduled_message_id: %v", err)
+	}
+	return uID, sID, nil
+}
+
+func mapScheduledMessageToProto(scheduled *models.ScheduledMessage) *pb.ScheduledMessage {
+	attachmentIDs := make([]string, len(scheduled.AttachmentIDs))
+	for i, id := range scheduled.AttachmentIDs {
+		attachmentIDs[i] = id.String()
+	}
+	return &pb.ScheduledMessage{
+		ScheduledMessageId: scheduled.ScheduledID.String(),
+		SenderId:           scheduled.SenderID.String(),
+		ConversationId:     scheduled.ConversationID.String(),
+		RecipientId:        uuidOrEmpty(scheduled.RecipientID),
+		Content:            scheduled.Content,
+		ReplyToMessageId:   uuidOrEmpty(scheduled.ReplyToMessageID),
+		AttachmentIds:      attachmentIDs,
+		SendAt:             scheduled.SendAt.Unix(),
+		State:              pb.ScheduledMessageState(scheduled.State),
+		FailureReason:      scheduled.FailureReason,
+		Entities:           mapTextEntitiesToProto(scheduled.Entities),
+	}
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Поиск по сообщениям бесед пользователя
+func (h *MessagingHandler) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+	if req.To != 0 && req.To <= req.From {
+		return nil, status.Errorf(codes.InvalidArgument, "to must be greater than from")
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	var conversationID, senderID gocql.UUID
+	if req.ConversationId != "" {
+		if conversationID, err = gocql.ParseUUID(req.ConversationId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid conversation_id: %v", err)
+		}
+	}
+	if req.SenderId != "" {
+		if senderID, err = gocql.ParseUUID(req.SenderId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender_id: %v", err)
+		}
+	}
+
+	query := &models.SearchQuery{
+		Text:     req.Query,
+		SenderID: senderID,
+		Limit:    int(req.Limit),
+	}
+	if req.From > 0 {
+		query.From = time.Unix(req.From, 0)
+	}
+	if req.To > 0 {
+		query.To = time.Unix(req.To, 0)
+	}
+
+	page, err := h.searchMessagesUsecase.Execute(ctx, userID, conversationID, query, req.PageToken)
+	if err != nil {
+		return nil, usecaseError(err, "error searching messages")
+	}
+
+	hits := make([]*pb.SearchHit, len(page.Matches))
+	for i, match := range page.Matches {
+		hits[i] = &pb.SearchHit{
+			Message:    mapMessageToProto(match.Message),
+			Highlights: match.Highlights,
+		}
+	}
+	return &pb.SearchMessagesResponse{
+		Hits:          hits,
+		NextPageToken: page.NextToken,
+		HasMore:       page.HasMore,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Изменение настроек личных сообщений
+func (h *MessagingHandler) UpdateMessagingSettings(ctx context.Context, req *pb.UpdateMessagingSettingsRequest) (*pb.UpdateMessagingSettingsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	if err := h.updMsgSettingsUsecase.Execute(ctx, userID, models.MessagingPolicy(req.MessagingPolicy)); err != nil {
+		return nil, usecaseError(err, "error updating messaging settings")
+	}
+
+	return &pb.UpdateMessagingSettingsResponse{
+		Success: true,
+	}, nil
+}
+
+// Получение настроек личных сообщений
+func (h *MessagingHandler) GetMessagingSettings(ctx context.Context, req *pb.GetMessagingSettingsRequest) (*pb.GetMessagingSettingsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	policy, err := h.getMsgSettingsUsecase.Execute(ctx, userID)
+	if err != nil {
+		return nil, usecaseError(err, "error getting messaging settings")
+	}
+
+	return &pb.GetMessagingSettingsResponse{
+		MessagingPolicy: pb.MessagingPolicy(policy),
+	}, nil
+}
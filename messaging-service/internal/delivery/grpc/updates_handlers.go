@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Изменения пользователя после известного клиенту номера журнала синхронизации
+func (h *MessagingHandler) GetUpdates(ctx context.Context, req *pb.GetUpdatesRequest) (*pb.GetUpdatesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+	userID, err := gocql.ParseUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	result, err := h.getUpdatesUsecase.Execute(ctx, userID, req.SinceSeq, int(req.Limit))
+	if err != nil {
+		return nil, usecaseError(err, "error getting updates")
+	}
+
+	updates := make([]*pb.SyncUpdate, len(result.Changes))
+	for i, change := range result.Changes {
+		updates[i] = mapSyncChangeToProto(change)
+	}
+	return &pb.GetUpdatesResponse{
+		Updates:        updates,
+		LatestSeq:      result.LatestSeq,
+		HasMore:        result.HasMore,
+		ResyncRequired: result.ResyncRequired,
+	}, nil
+}
+
+func mapSyncChangeToProto(change *models.SyncChange) *pb.SyncUpdate {
+	update := &pb.SyncUpdate{
+		Seq:            change.Seq,
+		Type:           pb.SyncUpdateType(change.Type),
+		ConversationId: change.ConversationID.String(),
+		MessageId:      uuidOrEmpty(change.MessageID),
+		Status:         pb.MessageStatus(change.Status),
+		MemberId:       uuidOrEmpty(change.MemberID),
+		Role:           pb.ConversationMemberRole(change.Role),
+		CreatedAt:      unixOrZero(change.CreatedAt),
+	}
+	// Без сообщения клиент считает новое или измененное сообщение недоступным
+	if change.Message != nil {
+		update.Message = mapMessageToProto(change.Message)
+	}
+	return update
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
+)
+
+// Префиксы типов событий сообщений и присутствия в персональном топике пользователя
+const (
+	messageEventPrefix  = "message."
+	presenceEventPrefix = "presence."
+)
+
+// Hub рассылает события сообщений участникам беседы через общий брокер,
+// поэтому подписчик получает события независимо от того, какая реплика их опубликовала
+type Hub interface {
+	Publish(ctx context.Context, event *models.MessageEvent, userIDs ...gocql.UUID) error
+	Subscribe(ctx context.Context, userID gocql.UUID, lastEventID string) (<-chan *models.MessageEvent, error)
+}
+
+type hub struct {
+	broker pubsub.Broker
+}
+
+func NewHub(broker pubsub.Broker) Hub {
+	return &hub{
+		broker: broker,
+	}
+}
+
+func (h *hub) Publish(ctx context.Context, event *models.MessageEvent, userIDs ...gocql.UUID) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	published := make(map[gocql.UUID]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if published[userID] {
+			continue
+		}
+		published[userID] = true
+
+		if _, err := h.broker.Publish(ctx, pubsub.UserTopic(userID.String()), string(event.Type), payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *hub) Subscribe(ctx context.Context, userID gocql.UUID, lastEventID string) (<-chan *models.MessageEvent, error) {
+	sub, err := h.broker.Subscribe(ctx, pubsub.UserTopic(userID.String()), lastEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *models.MessageEvent)
+	go func() {
+		defer close(events)
+		for msg := range sub.Messages() {
+			// В топике пользователя публикуются и события других сервисов
+			if !strings.HasPrefix(msg.Type, messageEventPrefix) && !strings.HasPrefix(msg.Type, presenceEventPrefix) {
+				continue
+			}
+
+			var event models.MessageEvent
+			if err := json.Unmarshal(msg.Payload, &event); err != nil {
+				log.Printf("error decoding event %s: %v", msg.ID, err)
+				continue
+			}
+			event.EventID = msg.ID
+			event.Type = models.MessageEventType(msg.Type)
+
+			select {
+			case events <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,201 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Топики Kafka с событиями сообщений, версия схемы входит в имя топика
+const (
+	TopicMessageSent          = "messaging.message.sent.v1"
+	TopicMessageMentioned     = "messaging.message.mentioned.v1"
+	TopicMessageStatusChanged = "messaging.message.status_changed.v1"
+	TopicMessageEdited        = "messaging.message.edited.v1"
+	TopicMessageDeleted       = "messaging.message.deleted.v1"
+
+	TopicConversationMembersChanged = "messaging.conversation.members_changed.v1"
+)
+
+// Типы событий для заголовка event_type
+const (
+	EventTypeMessageSent          = "message.sent"
+	EventTypeMessageMentioned     = "message.mentioned"
+	EventTypeMessageStatusChanged = "message.status_changed"
+	EventTypeMessageEdited        = "message.edited"
+	EventTypeMessageDeleted       = "message.deleted"
+
+	EventTypeConversationMembersChanged = "conversation.members_changed"
+)
+
+// Текущая версия схемы событий сообщений
+const messageEventsVersion = 1
+
+// Publisher отправляет события outbox во внешнюю шину
+type Publisher interface {
+	Publish(ctx context.Context, event *models.OutboxEvent) error
+}
+
+// NewMessageSentEvent готовит событие об отправке сообщения для записи в outbox.
+// mutedIDs — получатели, которым не нужно уведомление о сообщении
+func NewMessageSentEvent(message *models.Message, memberIDs, mutedIDs []gocql.UUID) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(message.ConversationID, TopicMessageSent, EventTypeMessageSent)
+
+	recipientIDs := make([]string, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID != message.SenderID {
+			recipientIDs = append(recipientIDs, memberID.String())
+		}
+	}
+
+	payload := &pb.MessageSent{
+		Metadata:          eventMetadata(event),
+		MessageId:         message.MessageID.String(),
+		ConversationId:    message.ConversationID.String(),
+		SenderId:          message.SenderID.String(),
+		RecipientId:       uuidOrEmpty(message.RecipientID),
+		RecipientIds:      recipientIDs,
+		Content:           message.PlainText(),
+		ReplyToMessageId:  uuidOrEmpty(message.ReplyToMessageID),
+		ThreadRootId:      uuidOrEmpty(message.ThreadRootID),
+		AttachmentCount:   int32(len(message.Attachments)),
+		SentAt:            message.Timestamp.Unix(),
+		MentionedUserIds:  uuidStrings(message.MentionedUserIDs()),
+		MutedRecipientIds: uuidStrings(mutedIDs),
+		System:            message.ContentType == models.ContentSystemEvent,
+	}
+	return withPayload(event, payload)
+}
+
+// NewMessageMentionedEvent готовит событие об упоминании участников для записи в outbox.
+// Событие отдельное, чтобы уведомление об упоминании не зависело от настроек уведомлений беседы
+func NewMessageMentionedEvent(message *models.Message, mentionedIDs []gocql.UUID) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(message.ConversationID, TopicMessageMentioned, EventTypeMessageMentioned)
+
+	payload := &pb.MessageMentioned{
+		Metadata:         eventMetadata(event),
+		MessageId:        message.MessageID.String(),
+		ConversationId:   message.ConversationID.String(),
+		SenderId:         message.SenderID.String(),
+		MentionedUserIds: uuidStrings(mentionedIDs),
+		Content:          message.PlainText(),
+		SentAt:           message.Timestamp.Unix(),
+	}
+	return withPayload(event, payload)
+}
+
+// NewMessageStatusChangedEvent готовит событие о смене статуса сообщения для записи в outbox
+func NewMessageStatusChangedEvent(message *models.Message) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(message.ConversationID, TopicMessageStatusChanged, EventTypeMessageStatusChanged)
+
+	payload := &pb.MessageStatusChanged{
+		Metadata:       eventMetadata(event),
+		MessageId:      message.MessageID.String(),
+		ConversationId: message.ConversationID.String(),
+		SenderId:       message.SenderID.String(),
+		Status:         pb.MessageStatus(message.Status),
+	}
+	return withPayload(event, payload)
+}
+
+// NewMessageEditedEvent готовит событие о правке текста сообщения для записи в outbox
+func NewMessageEditedEvent(message *models.Message) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(message.ConversationID, TopicMessageEdited, EventTypeMessageEdited)
+
+	payload := &pb.MessageEdited{
+		Metadata:       eventMetadata(event),
+		MessageId:      message.MessageID.String(),
+		ConversationId: message.ConversationID.String(),
+		SenderId:       message.SenderID.String(),
+		Content:        message.Content,
+		SentAt:         message.Timestamp.Unix(),
+		EditedAt:       message.EditedAt.Unix(),
+	}
+	return withPayload(event, payload)
+}
+
+// NewMessageDeletedEvent готовит событие об удалении сообщения у всех участников для записи в outbox
+func NewMessageDeletedEvent(message *models.Message) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(message.ConversationID, TopicMessageDeleted, EventTypeMessageDeleted)
+
+	payload := &pb.MessageDeleted{
+		Metadata:       eventMetadata(event),
+		MessageId:      message.MessageID.String(),
+		ConversationId: message.ConversationID.String(),
+		SenderId:       message.SenderID.String(),
+	}
+	return withPayload(event, payload)
+}
+
+// NewConversationMembersChangedEvent готовит событие об изменении состава беседы для записи в outbox.
+// members — затронутые участники, actorID — пользователь, изменивший состав
+func NewConversationMembersChangedEvent(conversationID, actorID gocql.UUID, change models.MembershipChange, members []*models.ConversationMember) (*models.OutboxEvent, error) {
+	event := newOutboxEvent(conversationID, TopicConversationMembersChanged, EventTypeConversationMembersChanged)
+
+	payload := &pb.ConversationMembersChanged{
+		Metadata:       eventMetadata(event),
+		ConversationId: conversationID.String(),
+		ActorId:        actorID.String(),
+		Change:         pb.MembershipChange(change),
+		Members:        make([]*pb.ConversationMember, len(members)),
+	}
+	for i, member := range members {
+		payload.Members[i] = &pb.ConversationMember{
+			UserId:   member.UserID.String(),
+			Role:     pb.ConversationMemberRole(member.Role),
+			JoinedAt: member.JoinedAt.Unix(),
+		}
+	}
+	return withPayload(event, payload)
+}
+
+// Ключ сообщения Kafka — беседа, так события одной беседы читаются по порядку
+func newOutboxEvent(conversationID gocql.UUID, topic, eventType string) *models.OutboxEvent {
+	return &models.OutboxEvent{
+		Shard:     models.OutboxShard(conversationID),
+		EventID:   gocql.TimeUUID(),
+		Topic:     topic,
+		Key:       conversationID.String(),
+		Type:      eventType,
+		Version:   messageEventsVersion,
+		CreatedAt: time.Now(),
+	}
+}
+
+func eventMetadata(event *models.OutboxEvent) *pb.EventMetadata {
+	return &pb.EventMetadata{
+		EventId:      event.EventID.String(),
+		EventType:    event.Type,
+		EventVersion: int32(event.Version),
+		OccurredAt:   event.CreatedAt.Unix(),
+	}
+}
+
+func withPayload(event *models.OutboxEvent, payload proto.Message) (*models.OutboxEvent, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", event.Type, err)
+	}
+	event.Payload = data
+	return event, nil
+}
+
+func uuidOrEmpty(id gocql.UUID) string {
+	if id == (gocql.UUID{}) {
+		return ""
+	}
+	return id.String()
+}
+
+func uuidStrings(ids []gocql.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
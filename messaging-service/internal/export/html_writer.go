@@ -0,0 +1,147 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Шаблоны выполняются по частям: заголовок, каждое сообщение и окончание страницы
+var htmlTemplates = template.Must(template.New("export").Funcs(template.FuncMap{
+	"datetime": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04:05 UTC")
+	},
+	"systemEvent": describeSystemEvent,
+}).Parse(`
+{{- define "header" -}}
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48em; margin: 2em auto; color: #222; }
+.message { border-bottom: 1px solid #eee; padding: 0.5em 0; }
+.meta { color: #777; font-size: 0.85em; }
+.content { white-space: pre-wrap; }
+.deleted .content, .encrypted .content { color: #999; font-style: italic; }
+.attachments, .edits { font-size: 0.85em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="meta">Conversation {{.ConversationID}}, exported {{datetime .ExportedAt}}</p>
+{{end}}
+
+{{- define "message" -}}
+<div class="message{{if .Deleted}} deleted{{else if .Encrypted}} encrypted{{end}}" id="m-{{.MessageID}}">
+<div class="meta">{{.SenderID}} · <time datetime="{{.Timestamp.Format "2006-01-02T15:04:05Z07:00"}}">{{datetime .Timestamp}}</time>{{if .EditedAt}} · edited{{end}}</div>
+{{- if .ReplyToMessageID}}
+<div class="meta">In reply to <a href="#m-{{.ReplyToMessageID}}">{{.ReplyToMessageID}}</a></div>
+{{- end}}
+{{- if .Deleted}}
+<div class="content">Message deleted</div>
+{{- else if .Encrypted}}
+<div class="content">Encrypted message</div>
+{{- else if .Location}}
+<div class="content">Location: {{with .Location.Title}}{{.}}, {{end}}{{with .Location.Address}}{{.}}, {{end}}{{.Location.Latitude}}, {{.Location.Longitude}}</div>
+{{- else if .Contact}}
+<div class="content">Contact: {{.Contact.Name}}{{with .Contact.PhoneNumber}} · {{.}}{{end}}{{with .Contact.Email}} · {{.}}{{end}}</div>
+{{- else if .SystemEvent}}
+<div class="content">{{systemEvent .SystemEvent}}</div>
+{{- else}}
+<div class="content">{{.Content}}</div>
+{{- end}}
+{{- if .Attachments}}
+<ul class="attachments">
+{{- range .Attachments}}
+<li>{{.FileName}} ({{.MimeType}}, {{.Size}} bytes)</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .Edits}}
+<details class="edits"><summary>Edit history</summary>
+<ol>
+{{- range .Edits}}
+<li>{{datetime .EditedAt}}: {{.Content}}</li>
+{{- end}}
+</ol>
+</details>
+{{- end}}
+</div>
+{{end}}
+
+{{- define "footer" -}}
+</body>
+</html>
+{{end}}
+`))
+
+// htmlWriter пишет читаемую стенограмму беседы
+type htmlWriter struct {
+	w io.Writer
+}
+
+// htmlHeader — данные заголовка стенограммы
+type htmlHeader struct {
+	Title          string
+	ConversationID string
+	ExportedAt     time.Time
+}
+
+func newHTMLWriter(w io.Writer, conversation *models.Conversation, exportedAt time.Time) (*htmlWriter, error) {
+	header := htmlHeader{
+		Title:          conversation.Title,
+		ConversationID: conversation.ConversationID.String(),
+		ExportedAt:     exportedAt,
+	}
+	if header.Title == "" {
+		header.Title = "Direct conversation"
+	}
+	if err := htmlTemplates.ExecuteTemplate(w, "header", header); err != nil {
+		return nil, err
+	}
+	return &htmlWriter{
+		w: w,
+	}, nil
+}
+
+func (w *htmlWriter) WriteMessage(msg *models.Message, edits []*models.MessageEdit) error {
+	return htmlTemplates.ExecuteTemplate(w.w, "message", newRecord(msg, edits))
+}
+
+func (w *htmlWriter) Close() error {
+	return htmlTemplates.ExecuteTemplate(w.w, "footer", nil)
+}
+
+// describeSystemEvent возвращает текст системного события для стенограммы
+func describeSystemEvent(event *models.SystemEvent) string {
+	memberIDs := make([]string, len(event.MemberIDs))
+	for i, memberID := range event.MemberIDs {
+		memberIDs[i] = memberID.String()
+	}
+	members := strings.Join(memberIDs, ", ")
+
+	switch event.Type {
+	case models.SystemEventConversationCreated:
+		return "Conversation created"
+	case models.SystemEventMembersAdded:
+		return "Members added: " + members
+	case models.SystemEventMemberRemoved:
+		return "Member removed: " + members
+	case models.SystemEventMemberLeft:
+		return "Member left"
+	case models.SystemEventMessageTTLChanged:
+		if event.MessageTTL == 0 {
+			return "Disappearing messages turned off"
+		}
+		return fmt.Sprintf("Disappearing messages timer set to %s", time.Duration(event.MessageTTL)*time.Second)
+	case models.SystemEventMessagePinned:
+		return "Message pinned: " + event.MessageID.String()
+	}
+	return "System event"
+}
@@ -0,0 +1,29 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// jsonlWriter пишет каждое сообщение отдельной строкой JSON
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return &jsonlWriter{
+		encoder: encoder,
+	}
+}
+
+func (w *jsonlWriter) WriteMessage(msg *models.Message, edits []*models.MessageEdit) error {
+	return w.encoder.Encode(newRecord(msg, edits))
+}
+
+func (w *jsonlWriter) Close() error {
+	return nil
+}
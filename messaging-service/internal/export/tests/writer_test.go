@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/export"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportFixture — беседа с отредактированным сообщением с вложением и удаленным сообщением
+type exportFixture struct {
+	conversation *models.Conversation
+	edited       *models.Message
+	edits        []*models.MessageEdit
+	deleted      *models.Message
+	exportedAt   time.Time
+}
+
+func newExportFixture() *exportFixture {
+	baseTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	conversation := &models.Conversation{
+		ConversationID: gocql.TimeUUID(),
+		Type:           models.ConversationTypeGroup,
+		Title:          "Release <team>",
+	}
+	edited := &models.Message{
+		MessageID:      gocql.TimeUUID(),
+		SenderID:       gocql.TimeUUID(),
+		ConversationID: conversation.ConversationID,
+		Content:        "Final <b>notes</b>",
+		Status:         models.StatusRead,
+		Timestamp:      baseTime,
+		EditedAt:       baseTime.Add(2 * time.Minute),
+		Attachments: []*models.Attachment{
+			{AttachmentID: gocql.TimeUUID(), FileName: "notes.pdf", MimeType: "application/pdf", Size: 2048},
+		},
+	}
+	return &exportFixture{
+		conversation: conversation,
+		edited:       edited,
+		// История правок хранится от новых версий к старым
+		edits: []*models.MessageEdit{
+			{MessageID: edited.MessageID, Content: "Draft notes v2", EditedAt: baseTime.Add(2 * time.Minute)},
+			{MessageID: edited.MessageID, Content: "Draft notes", EditedAt: baseTime.Add(time.Minute)},
+		},
+		deleted: &models.Message{
+			MessageID:        gocql.TimeUUID(),
+			SenderID:         gocql.TimeUUID(),
+			ConversationID:   conversation.ConversationID,
+			Status:           models.StatusSent,
+			Timestamp:        baseTime.Add(time.Hour),
+			Deleted:          true,
+			ReplyToMessageID: edited.MessageID,
+		},
+		exportedAt: baseTime.Add(24 * time.Hour),
+	}
+}
+
+func (f *exportFixture) write(t *testing.T, format models.ExportFormat) string {
+	var buf bytes.Buffer
+	writer, err := export.NewWriter(format, &buf, f.conversation, f.exportedAt)
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteMessage(f.edited, f.edits))
+	require.NoError(t, writer.WriteMessage(f.deleted, nil))
+	require.NoError(t, writer.Close())
+	return buf.String()
+}
+
+func TestJSONLWriter(t *testing.T) {
+	f := newExportFixture()
+	output := f.write(t, models.ExportFormatJSONL)
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.Len(t, lines, 2)
+
+	edited := lines[0]
+	assert.Equal(t, f.edited.MessageID.String(), edited["message_id"])
+	assert.Equal(t, "Final <b>notes</b>", edited["content"])
+	assert.Equal(t, "read", edited["status"])
+	assert.Equal(t, "2024-05-01T12:00:00Z", edited["timestamp"])
+	assert.Equal(t, "2024-05-01T12:02:00Z", edited["edited_at"])
+	assert.NotContains(t, edited, "recipient_id")
+	assert.NotContains(t, edited, "deleted")
+
+	attachments := edited["attachments"].([]interface{})
+	require.Len(t, attachments, 1)
+	attachment := attachments[0].(map[string]interface{})
+	assert.Equal(t, "notes.pdf", attachment["file_name"])
+	assert.Equal(t, "application/pdf", attachment["mime_type"])
+	assert.EqualValues(t, 2048, attachment["size"])
+
+	// Правки перечислены от старых к новым
+	edits := edited["edits"].([]interface{})
+	require.Len(t, edits, 2)
+	assert.Equal(t, "Draft notes", edits[0].(map[string]interface{})["content"])
+	assert.Equal(t, "Draft notes v2", edits[1].(map[string]interface{})["content"])
+
+	deleted := lines[1]
+	assert.Equal(t, true, deleted["deleted"])
+	assert.Equal(t, f.edited.MessageID.String(), deleted["reply_to_message_id"])
+	assert.NotContains(t, deleted, "edits")
+}
+
+func TestHTMLWriter(t *testing.T) {
+	f := newExportFixture()
+	output := f.write(t, models.ExportFormatHTML)
+
+	assert.True(t, strings.HasPrefix(output, "<!DOCTYPE html>"))
+	assert.True(t, strings.HasSuffix(output, "</html>\n"))
+	// Текст сообщений и название беседы экранируются
+	assert.Contains(t, output, "<title>Release &lt;team&gt;</title>")
+	assert.Contains(t, output, "Final &lt;b&gt;notes&lt;/b&gt;")
+	assert.NotContains(t, output, "<b>notes</b>")
+	assert.Contains(t, output, "notes.pdf (application/pdf, 2048 bytes)")
+	assert.Contains(t, output, "Edit history")
+	assert.Less(t, strings.Index(output, "Draft notes</li>"), strings.Index(output, "Draft notes v2</li>"))
+	assert.Contains(t, output, "Message deleted")
+	assert.Contains(t, output, `href="#m-`+f.edited.MessageID.String()+`"`)
+	assert.Contains(t, output, "exported 2024-05-02 12:00:00 UTC")
+}
+
+func TestHTMLWriterSystemEvent(t *testing.T) {
+	f := newExportFixture()
+	var buf bytes.Buffer
+	writer, err := export.NewWriter(models.ExportFormatHTML, &buf, f.conversation, f.exportedAt)
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteMessage(&models.Message{
+		MessageID:      gocql.TimeUUID(),
+		SenderID:       gocql.TimeUUID(),
+		ConversationID: f.conversation.ConversationID,
+		Timestamp:      f.exportedAt,
+		ContentType:    models.ContentSystemEvent,
+		SystemEvent:    &models.SystemEvent{Type: models.SystemEventMessageTTLChanged, MessageTTL: 3600},
+	}, nil))
+	require.NoError(t, writer.Close())
+
+	assert.Contains(t, buf.String(), "Disappearing messages timer set to 1h0m0s")
+}
+
+func TestNewWriterUnsupportedFormat(t *testing.T) {
+	f := newExportFixture()
+	_, err := export.NewWriter(models.ExportFormatUnspecified, &bytes.Buffer{}, f.conversation, f.exportedAt)
+
+	assert.Error(t, err)
+}
+
+func TestFileName(t *testing.T) {
+	conversationID := gocql.TimeUUID()
+
+	assert.Equal(t, "conversation-"+conversationID.String()+".jsonl", export.FileName(conversationID, models.ExportFormatJSONL))
+	assert.Equal(t, "conversation-"+conversationID.String()+".html", export.FileName(conversationID, models.ExportFormatHTML))
+}
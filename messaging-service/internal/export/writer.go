@@ -0,0 +1,146 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Writer записывает выгрузку беседы по одному сообщению, не накапливая историю в памяти
+type Writer interface {
+	// WriteMessage записывает сообщение вместе с предыдущими версиями текста
+	WriteMessage(msg *models.Message, edits []*models.MessageEdit) error
+	// Close дописывает окончание выгрузки
+	Close() error
+}
+
+// NewWriter начинает выгрузку беседы в выбранном формате
+func NewWriter(format models.ExportFormat, w io.Writer, conversation *models.Conversation, exportedAt time.Time) (Writer, error) {
+	switch format {
+	case models.ExportFormatJSONL:
+		return newJSONLWriter(w), nil
+	case models.ExportFormatHTML:
+		return newHTMLWriter(w, conversation, exportedAt)
+	default:
+		return nil, fmt.Errorf("unsupported export format %d", format)
+	}
+}
+
+// FileName возвращает имя файла выгрузки
+func FileName(conversationID gocql.UUID, format models.ExportFormat) string {
+	extension := "jsonl"
+	if format == models.ExportFormatHTML {
+		extension = "html"
+	}
+	return fmt.Sprintf("conversation-%s.%s", conversationID, extension)
+}
+
+// MimeType возвращает MIME-тип файла выгрузки
+func MimeType(format models.ExportFormat) string {
+	if format == models.ExportFormatHTML {
+		return "text/html; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+// record — сообщение в виде, общем для всех форматов выгрузки
+type record struct {
+	MessageID        string               `json:"message_id"`
+	ConversationID   string               `json:"conversation_id"`
+	SenderID         string               `json:"sender_id"`
+	RecipientID      string               `json:"recipient_id,omitempty"`
+	Timestamp        time.Time            `json:"timestamp"`
+	Status           string               `json:"status"`
+	ContentType      string               `json:"content_type"`
+	Content          string               `json:"content"`
+	Entities         []*models.TextEntity `json:"entities,omitempty"`
+	Location         *models.Location     `json:"location,omitempty"`
+	Contact          *models.ContactCard  `json:"contact,omitempty"`
+	SystemEvent      *models.SystemEvent  `json:"system_event,omitempty"`
+	Encrypted        bool                 `json:"encrypted,omitempty"`
+	Deleted          bool                 `json:"deleted,omitempty"`
+	EditedAt         *time.Time           `json:"edited_at,omitempty"`
+	ReplyToMessageID string               `json:"reply_to_message_id,omitempty"`
+	ThreadRootID     string               `json:"thread_root_id,omitempty"`
+	ExpiresAt        *time.Time           `json:"expires_at,omitempty"`
+	Attachments      []recordAttachment   `json:"attachments,omitempty"`
+	Edits            []recordEdit         `json:"edits,omitempty"`
+}
+
+// recordAttachment — сведения о вложении без его содержимого
+type recordAttachment struct {
+	AttachmentID string `json:"attachment_id"`
+	FileName     string `json:"file_name"`
+	MimeType     string `json:"mime_type"`
+	Size         int64  `json:"size"`
+	Checksum     string `json:"checksum,omitempty"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+}
+
+// recordEdit — предыдущая версия текста сообщения
+type recordEdit struct {
+	Content  string    `json:"content"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// newRecord переводит сообщение в запись выгрузки. Текст зашифрованного сообщения сервису недоступен,
+// поэтому выгрузка только отмечает шифрование. Правки перечисляются от старых к новым
+func newRecord(msg *models.Message, edits []*models.MessageEdit) *record {
+	rec := &record{
+		MessageID:        msg.MessageID.String(),
+		ConversationID:   msg.ConversationID.String(),
+		SenderID:         msg.SenderID.String(),
+		RecipientID:      optionalID(msg.RecipientID),
+		Timestamp:        msg.Timestamp.UTC(),
+		Status:           msg.Status.String(),
+		ContentType:      msg.ContentType.String(),
+		Content:          msg.Content,
+		Entities:         msg.Entities,
+		Location:         msg.Location,
+		Contact:          msg.Contact,
+		SystemEvent:      msg.SystemEvent,
+		Encrypted:        msg.Encrypted(),
+		Deleted:          msg.Deleted,
+		EditedAt:         optionalTime(msg.EditedAt),
+		ReplyToMessageID: optionalID(msg.ReplyToMessageID),
+		ThreadRootID:     optionalID(msg.ThreadRootID),
+		ExpiresAt:        optionalTime(msg.ExpiresAt),
+	}
+	for _, attachment := range msg.Attachments {
+		rec.Attachments = append(rec.Attachments, recordAttachment{
+			AttachmentID: attachment.AttachmentID.String(),
+			FileName:     attachment.FileName,
+			MimeType:     attachment.MimeType,
+			Size:         attachment.Size,
+			Checksum:     attachment.Checksum,
+			Width:        attachment.Width,
+			Height:       attachment.Height,
+		})
+	}
+	for i := len(edits) - 1; i >= 0; i-- {
+		rec.Edits = append(rec.Edits, recordEdit{
+			Content:  edits[i].Content,
+			EditedAt: edits[i].EditedAt.UTC(),
+		})
+	}
+	return rec
+}
+
+func optionalID(id gocql.UUID) string {
+	if id == (gocql.UUID{}) {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Attachment — файл, загруженный для отправки в сообщении.
+// Поля с тегом cql также хранятся в строке сообщения.
+type Attachment struct {
+	AttachmentID   gocql.UUID `json:"attachment_id" cql:"attachment_id"`
+	UploaderID     gocql.UUID `json:"uploader_id"`
+	ConversationID gocql.UUID `json:"conversation_id"`
+	MessageID      gocql.UUID `json:"message_id"`
+	FileName       string     `json:"file_name" cql:"file_name"`
+	MimeType       string     `json:"mime_type" cql:"mime_type"`
+	Size           int64      `json:"size" cql:"size"`
+	Checksum       string     `json:"checksum" cql:"checksum"`
+	Width          int        `json:"width,omitempty" cql:"width"`
+	Height         int        `json:"height,omitempty" cql:"height"`
+	StorageKey     string     `json:"-"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Attached сообщает, отправлен ли файл в сообщении
+func (a *Attachment) Attached() bool {
+	return a.MessageID != (gocql.UUID{})
+}
+
+// AttachmentExpiration — запланированное удаление файла исчезающего сообщения.
+// Записи группируются по часу истечения, чтобы очистка читала их без обхода всей таблицы
+type AttachmentExpiration struct {
+	Bucket       time.Time
+	ExpiresAt    time.Time
+	AttachmentID gocql.UUID
+}
+
+// ExpirationBucket возвращает час, к которому относится удаление файла
+func ExpirationBucket(expiresAt time.Time) time.Time {
+	return expiresAt.UTC().Truncate(time.Hour)
+}
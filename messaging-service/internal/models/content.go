@@ -0,0 +1,143 @@
+package models
+
+import (
+	"github.com/gocql/gocql"
+)
+
+type ContentType int32
+
+const (
+	ContentTypeUnspecified ContentType = 0
+	ContentText            ContentType = 1
+	ContentImage           ContentType = 2
+	ContentFile            ContentType = 3
+	ContentLocation        ContentType = 4
+	ContentContact         ContentType = 5
+	ContentSystemEvent     ContentType = 6
+)
+
+// Текстовые значения типов содержимого в колонке messages.content_type
+var contentTypeNames = map[ContentType]string{
+	ContentText:        "text",
+	ContentImage:       "image",
+	ContentFile:        "file",
+	ContentLocation:    "location",
+	ContentContact:     "contact",
+	ContentSystemEvent: "system_event",
+}
+
+func (t ContentType) String() string {
+	if name, ok := contentTypeNames[t]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+// ParseContentType разбирает тип содержимого. Строки, сохраненные до появления типов, текстовые
+func ParseContentType(name string) ContentType {
+	if name == "" {
+		return ContentText
+	}
+	for contentType, typeName := range contentTypeNames {
+		if typeName == name {
+			return contentType
+		}
+	}
+	return ContentTypeUnspecified
+}
+
+// HasCaption сообщает, хранит ли Content подпись к вложению, а не текст сообщения
+func (t ContentType) HasCaption() bool {
+	return t == ContentImage || t == ContentFile
+}
+
+type TextEntityType int32
+
+const (
+	TextEntityUnspecified   TextEntityType = 0
+	TextEntityBold          TextEntityType = 1
+	TextEntityItalic        TextEntityType = 2
+	TextEntityUnderline     TextEntityType = 3
+	TextEntityStrikethrough TextEntityType = 4
+	TextEntityCode          TextEntityType = 5
+	TextEntityPre           TextEntityType = 6
+	TextEntityTextLink      TextEntityType = 7
+	TextEntitySpoiler       TextEntityType = 8
+)
+
+// TextEntity — оформленный фрагмент текста, хранится в строке сообщения.
+// Offset и Length считаются в символах Unicode, как у упоминаний
+type TextEntity struct {
+	Type     TextEntityType `json:"type" cql:"type"`
+	Offset   int            `json:"offset" cql:"offset"`
+	Length   int            `json:"length" cql:"length"`
+	URL      string         `json:"url,omitempty" cql:"url"`
+	Language string         `json:"language,omitempty" cql:"language"`
+}
+
+// Location — геопозиция, отправленная в сообщении
+type Location struct {
+	Latitude  float64 `json:"latitude" cql:"latitude"`
+	Longitude float64 `json:"longitude" cql:"longitude"`
+	Title     string  `json:"title,omitempty" cql:"title"`
+	Address   string  `json:"address,omitempty" cql:"address"`
+}
+
+// ContactCard — карточка контакта, отправленная в сообщении
+type ContactCard struct {
+	Name        string     `json:"name" cql:"name"`
+	PhoneNumber string     `json:"phone_number,omitempty" cql:"phone_number"`
+	Email       string     `json:"email,omitempty" cql:"email"`
+	UserID      gocql.UUID `json:"user_id" cql:"user_id"`
+}
+
+type SystemEventType int32
+
+const (
+	SystemEventUnspecified         SystemEventType = 0
+	SystemEventConversationCreated SystemEventType = 1
+	SystemEventMembersAdded        SystemEventType = 2
+	SystemEventMemberRemoved       SystemEventType = 3
+	SystemEventMemberLeft          SystemEventType = 4
+	SystemEventMessageTTLChanged   SystemEventType = 5
+	SystemEventMessagePinned       SystemEventType = 6
+)
+
+// SystemEvent — событие беседы, показываемое в истории сообщений
+type SystemEvent struct {
+	Type      SystemEventType `json:"type" cql:"type"`
+	ActorID   gocql.UUID      `json:"actor_id" cql:"actor_id"`
+	MemberIDs []gocql.UUID    `json:"member_ids,omitempty" cql:"member_ids"`
+	// MessageTTL — новый срок жизни сообщений в секундах, ноль отключает исчезновение
+	MessageTTL int `json:"message_ttl,omitempty" cql:"message_ttl"`
+	// MessageID — закрепленное сообщение
+	MessageID gocql.UUID `json:"message_id" cql:"message_id"`
+}
+
+// PlainText возвращает текст сообщения для предпросмотра, поиска и уведомлений:
+// текст или подпись, у геопозиции — название или адрес, у контакта — имя
+func (m *Message) PlainText() string {
+	switch {
+	case m.ContentType == ContentLocation && m.Location != nil:
+		if m.Location.Title != "" {
+			return m.Location.Title
+		}
+		return m.Location.Address
+	case m.ContentType == ContentContact && m.Contact != nil:
+		return m.Contact.Name
+	}
+	return m.Content
+}
+
+// ContentAttachment возвращает вложение, которому посвящено сообщение с изображением или файлом
+func (m *Message) ContentAttachment() *Attachment {
+	if !m.ContentType.HasCaption() {
+		return nil
+	}
+	for _, attachment := range m.Attachments {
+		if attachment.AttachmentID == m.ContentAttachmentID {
+			return attachment
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"crypto/sha1"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type ConversationType int32
+
+const (
+	ConversationTypeUnspecified ConversationType = 0
+	ConversationTypeDirect      ConversationType = 1
+	ConversationTypeGroup       ConversationType = 2
+)
+
+// Текстовые значения типов в колонке conversations.type
+var conversationTypeNames = map[ConversationType]string{
+	ConversationTypeDirect: "direct",
+	ConversationTypeGroup:  "group",
+}
+
+func (t ConversationType) String() string {
+	if name, ok := conversationTypeNames[t]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+func ParseConversationType(name string) ConversationType {
+	for conversationType, typeName := range conversationTypeNames {
+		if typeName == name {
+			return conversationType
+		}
+	}
+	return ConversationTypeUnspecified
+}
+
+type MemberRole int32
+
+const (
+	RoleUnspecified MemberRole = 0
+	RoleAdmin       MemberRole = 1
+	RoleMember      MemberRole = 2
+)
+
+// Текстовые значения ролей в колонке conversation_members.role
+var roleNames = map[MemberRole]string{
+	RoleAdmin:  "admin",
+	RoleMember: "member",
+}
+
+func (r MemberRole) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+func ParseMemberRole(name string) MemberRole {
+	for role, roleName := range roleNames {
+		if roleName == name {
+			return role
+		}
+	}
+	return RoleUnspecified
+}
+
+type Conversation struct {
+	ConversationID gocql.UUID            `json:"conversation_id"`
+	Type           ConversationType      `json:"type"`
+	Title          string                `json:"title"`
+	CreatorID      gocql.UUID            `json:"creator_id"`
+	CreatedAt      time.Time             `json:"created_at"`
+	Members        []*ConversationMember `json:"members,omitempty"`
+	// MessageTTL — время жизни новых сообщений, нулевое значение — сообщения не исчезают
+	MessageTTL time.Duration `json:"message_ttl"`
+}
+
+type ConversationMember struct {
+	ConversationID gocql.UUID `json:"conversation_id"`
+	UserID         gocql.UUID `json:"user_id"`
+	Role           MemberRole `json:"role"`
+	JoinedAt       time.Time  `json:"joined_at"`
+}
+
+// MembershipChange — вид изменения состава беседы
+type MembershipChange int32
+
+const (
+	MembershipChangeUnspecified MembershipChange = 0
+	MembershipAdded             MembershipChange = 1
+	MembershipRemoved           MembershipChange = 2
+	MembershipRoleChanged       MembershipChange = 3
+)
+
+// DirectConversationID возвращает детерминированный идентификатор личной переписки
+// двух пользователей, не зависящий от порядка аргументов
+func DirectConversationID(user1ID, user2ID gocql.UUID) (gocql.UUID, error) {
+	var (
+		minID, maxID string
+	)
+	if user1ID.String() < user2ID.String() {
+		minID = user1ID.String()
+		maxID = user2ID.String()
+	} else {
+		minID = user2ID.String()
+		maxID = user1ID.String()
+	}
+
+	h := sha1.New()
+	h.Write([]byte(minID + maxID))
+	hashed := h.Sum(nil)
+	conversationID, err := gocql.UUIDFromBytes(hashed[:16])
+	if err != nil {
+		return gocql.UUID{}, err
+	}
+	return conversationID, nil
+}
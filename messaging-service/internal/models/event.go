@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type MessageEventType string
+
+const (
+	EventMessageCreated       MessageEventType = "message.created"
+	EventMessageStatusChanged MessageEventType = "message.status_changed"
+	EventMessageEdited        MessageEventType = "message.edited"
+	EventMessageDeleted       MessageEventType = "message.deleted"
+	EventReactionAdded        MessageEventType = "message.reaction_added"
+	EventReactionRemoved      MessageEventType = "message.reaction_removed"
+	EventConversationRead     MessageEventType = "message.conversation_read"
+	EventMessagePinned        MessageEventType = "message.pinned"
+	EventMessageUnpinned      MessageEventType = "message.unpinned"
+
+	// События присутствия не сохраняются в базе и доставляются только через брокер
+	EventTyping          MessageEventType = "presence.typing"
+	EventPresenceChanged MessageEventType = "presence.changed"
+
+	// Предупреждение владельцу устройства, что у него заканчиваются одноразовые ключи
+	EventPreKeysLow MessageEventType = "keys.pre_keys_low"
+)
+
+// MessageEvent — событие об изменении сообщения, доставляемое подписчикам через общий брокер
+type MessageEvent struct {
+	EventID   string           `json:"-"`
+	Type      MessageEventType `json:"-"`
+	Message   *Message         `json:"message,omitempty"`
+	Reaction  *Reaction        `json:"reaction,omitempty"`
+	Receipt   *ReadReceipt     `json:"receipt,omitempty"`
+	Typing    *TypingIndicator `json:"typing,omitempty"`
+	Presence  *Presence        `json:"presence,omitempty"`
+	PreKeys   *PreKeyStatus    `json:"pre_keys,omitempty"`
+	Pin       *PinnedMessage   `json:"pin,omitempty"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// ConversationID возвращает беседу, к которой относится событие
+func (e *MessageEvent) ConversationID() gocql.UUID {
+	if e.Receipt != nil {
+		return e.Receipt.ConversationID
+	}
+	if e.Typing != nil {
+		return e.Typing.ConversationID
+	}
+	return e.Message.ConversationID
+}
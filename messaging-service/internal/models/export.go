@@ -0,0 +1,11 @@
+package models
+
+type ExportFormat int32
+
+const (
+	ExportFormatUnspecified ExportFormat = 0
+	// ExportFormatJSONL — JSON Lines: одно сообщение в строке
+	ExportFormatJSONL ExportFormat = 1
+	// ExportFormatHTML — HTML-страница для чтения
+	ExportFormatHTML ExportFormat = 2
+)
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/gocql/gocql"
+)
+
+// Максимальная длина текста последнего сообщения в списке бесед, в символах
+const MaxPreviewLength = 100
+
+// MutedForever — срок отключения уведомлений беседы без ограничения
+var MutedForever = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// InboxEntry — беседа в списке бесед пользователя.
+// ActivityID — timeuuid последней активности, по нему список упорядочен.
+// Archived, MutedUntil и MarkedUnread — личные настройки беседы пользователя
+type InboxEntry struct {
+	UserID             gocql.UUID
+	ConversationID     gocql.UUID
+	ActivityID         gocql.UUID
+	LastMessageID      gocql.UUID
+	LastSenderID       gocql.UUID
+	LastMessagePreview string
+	LastReadMessageID  gocql.UUID
+	Archived           bool
+	MutedUntil         time.Time
+	MarkedUnread       bool
+}
+
+// Muted сообщает, отключены ли уведомления беседы в момент now
+func (e *InboxEntry) Muted(now time.Time) bool {
+	return now.Before(e.MutedUntil)
+}
+
+// InboxFolder — часть списка бесед пользователя
+type InboxFolder int
+
+const (
+	// InboxAll — все беседы пользователя
+	InboxAll InboxFolder = iota
+	// InboxMain — беседы вне архива
+	InboxMain
+	// InboxArchive — архивные беседы
+	InboxArchive
+)
+
+// Includes сообщает, относится ли беседа к части списка
+func (f InboxFolder) Includes(entry *InboxEntry) bool {
+	switch f {
+	case InboxMain:
+		return !entry.Archived
+	case InboxArchive:
+		return entry.Archived
+	}
+	return true
+}
+
+// HasMessages сообщает, есть ли в беседе сообщения
+func (e *InboxEntry) HasMessages() bool {
+	return e.LastMessageID != (gocql.UUID{})
+}
+
+// HasUnread сообщает, могут ли в беседе быть непрочитанные пользователем сообщения.
+// Беседа, отмеченная пользователем непрочитанной, считается непрочитанной до следующего прочтения
+func (e *InboxEntry) HasUnread() bool {
+	return e.MarkedUnread || (e.HasMessages() && e.LastSenderID != e.UserID && e.LastMessageID != e.LastReadMessageID)
+}
+
+// ReadReceipt — отметка прочтения беседы участником до сообщения LastReadMessageID
+type ReadReceipt struct {
+	ConversationID    gocql.UUID `json:"conversation_id"`
+	UserID            gocql.UUID `json:"user_id"`
+	LastReadMessageID gocql.UUID `json:"last_read_message_id"`
+	ReadAt            time.Time  `json:"read_at"`
+}
+
+// ReadState — состояние прочтения беседы пользователем
+type ReadState struct {
+	LastReadMessageID   gocql.UUID
+	UnreadCount         int
+	UnreadConversations int
+}
+
+// UserProfile — краткий профиль пользователя из user-service
+type UserProfile struct {
+	UserID    gocql.UUID `json:"user_id"`
+	Nickname  string     `json:"nickname"`
+	AvatarURL string     `json:"avatar_url"`
+}
+
+// ConversationSummary — беседа со сведениями для списка бесед пользователя
+type ConversationSummary struct {
+	Conversation *Conversation
+	Entry        *InboxEntry
+	UnreadCount  int
+	Peer         *UserProfile
+}
+
+// LastActivityAt возвращает время последней активности в беседе
+func (s *ConversationSummary) LastActivityAt() time.Time {
+	return s.Entry.ActivityID.Time()
+}
+
+// ConversationPage — страница бесед, упорядоченных от последней активности к более ранней
+type ConversationPage struct {
+	Conversations []*ConversationSummary
+	NextCursor    gocql.UUID
+	HasMore       bool
+}
+
+// MessagePreview возвращает начало текста сообщения для списка бесед
+func MessagePreview(message *Message) string {
+	if message.Deleted {
+		return ""
+	}
+	text := message.PlainText()
+	if utf8.RuneCountInString(text) <= MaxPreviewLength {
+		return text
+	}
+	return string([]rune(text)[:MaxPreviewLength])
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// DeviceKeys — открытые ключи устройства пользователя для сквозного шифрования
+type DeviceKeys struct {
+	UserID       gocql.UUID
+	DeviceID     gocql.UUID
+	IdentityKey  []byte
+	SignedPreKey *SignedPreKey
+	UpdatedAt    time.Time
+}
+
+// SignedPreKey — среднесрочный ключ устройства, подписанный ключом идентичности
+type SignedPreKey struct {
+	KeyID     uint32
+	PublicKey []byte
+	Signature []byte
+}
+
+// OneTimePreKey — одноразовый ключ устройства, выдается только одному собеседнику
+type OneTimePreKey struct {
+	KeyID     uint32
+	PublicKey []byte
+}
+
+// KeyUpload — ключи, загружаемые устройством. Пустые поля не меняют сохраненные ключи
+type KeyUpload struct {
+	UserID         gocql.UUID
+	DeviceID       gocql.UUID
+	IdentityKey    []byte
+	SignedPreKey   *SignedPreKey
+	OneTimePreKeys []*OneTimePreKey
+}
+
+// PreKeyBundle — ключи устройства для установки сессии шифрования.
+// Одноразового ключа нет, если ключи устройства закончились
+type PreKeyBundle struct {
+	Device        *DeviceKeys
+	OneTimePreKey *OneTimePreKey
+}
+
+// PreKeyStatus — остаток одноразовых ключей устройства
+type PreKeyStatus struct {
+	UserID    gocql.UUID `json:"user_id"`
+	DeviceID  gocql.UUID `json:"device_id"`
+	Remaining int        `json:"remaining"`
+	Replenish bool       `json:"replenish"`
+}
@@ -15,12 +15,163 @@ const (
 	StatusRead        MessageStatus = 3
 )
 
+// Текстовые значения статусов в колонке messages.status
+var statusNames = map[MessageStatus]string{
+	StatusSent:      "sent",
+	StatusDelivered: "delivered",
+	StatusRead:      "read",
+}
+
+func (s MessageStatus) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+// CanAdvanceTo сообщает, допустим ли переход статуса. Статус только растет: SENT → DELIVERED → READ,
+// получатель может сразу отметить прочитанным недоставленное сообщение
+func (s MessageStatus) CanAdvanceTo(next MessageStatus) bool {
+	return next > s && (next == StatusDelivered || next == StatusRead)
+}
+
+func ParseMessageStatus(name string) MessageStatus {
+	for status, statusName := range statusNames {
+		if statusName == name {
+			return status
+		}
+	}
+	return StatusUnspecified
+}
+
 type Message struct {
-	MessageID      gocql.UUID
-	SenderID       gocql.UUID
-	RecipientID    gocql.UUID
-	ConversationID gocql.UUID
-	Content        string
-	Status         MessageStatus
-	Timestamp      time.Time
+	MessageID        gocql.UUID         `json:"message_id"`
+	SenderID         gocql.UUID         `json:"sender_id"`
+	RecipientID      gocql.UUID         `json:"recipient_id"`
+	ConversationID   gocql.UUID         `json:"conversation_id"`
+	Content          string             `json:"content"`
+	Status           MessageStatus      `json:"status"`
+	Timestamp        time.Time          `json:"timestamp"`
+	EditedAt         time.Time          `json:"edited_at"`
+	Deleted          bool               `json:"deleted"`
+	Reactions        []*ReactionSummary `json:"reactions,omitempty"`
+	ReplyToMessageID gocql.UUID         `json:"reply_to_message_id"`
+	ThreadRootID     gocql.UUID         `json:"thread_root_id"`
+	ReplyCount       int                `json:"reply_count,omitempty"`
+	Attachments      []*Attachment      `json:"attachments,omitempty"`
+	Mentions         []*Mention         `json:"mentions,omitempty"`
+	ExpiresAt        time.Time          `json:"expires_at"`
+	DeliveredAt      time.Time          `json:"delivered_at"`
+	ReadAt           time.Time          `json:"read_at"`
+	// ContentType задает, что хранит сообщение. Content у изображения и файла — подпись,
+	// у геопозиции, контакта и системного события — пустой
+	ContentType         ContentType   `json:"content_type"`
+	Entities            []*TextEntity `json:"entities,omitempty"`
+	ContentAttachmentID gocql.UUID    `json:"content_attachment_id"`
+	Location            *Location     `json:"location,omitempty"`
+	Contact             *ContactCard  `json:"contact,omitempty"`
+	SystemEvent         *SystemEvent  `json:"system_event,omitempty"`
+	// SenderDeviceID и EncryptedPayloads заполнены у зашифрованных сообщений, текст у них пустой
+	SenderDeviceID    gocql.UUID          `json:"sender_device_id"`
+	EncryptedPayloads []*EncryptedPayload `json:"encrypted_payloads,omitempty"`
+	// ClientMessageID задается клиентом для защиты от повторной отправки и в строке сообщения не хранится
+	ClientMessageID gocql.UUID `json:"-"`
+}
+
+// Mention — упоминание участника беседы в тексте сообщения, хранится в строке сообщения.
+// Offset и Length считаются в символах Unicode и охватывают упоминание вместе с @
+type Mention struct {
+	UserID gocql.UUID `json:"user_id" cql:"user_id"`
+	Offset int        `json:"offset" cql:"offset"`
+	Length int        `json:"length" cql:"length"`
+}
+
+// MentionedUserIDs возвращает упомянутых пользователей без повторов, кроме отправителя
+func (m *Message) MentionedUserIDs() []gocql.UUID {
+	var userIDs []gocql.UUID
+	seen := make(map[gocql.UUID]bool, len(m.Mentions))
+	for _, mention := range m.Mentions {
+		if mention.UserID == m.SenderID || seen[mention.UserID] {
+			continue
+		}
+		seen[mention.UserID] = true
+		userIDs = append(userIDs, mention.UserID)
+	}
+	return userIDs
+}
+
+type EncryptedPayloadType int32
+
+const (
+	PayloadTypeUnspecified EncryptedPayloadType = 0
+	// PayloadTypePreKey — первое сообщение сессии, установленной по набору ключей устройства
+	PayloadTypePreKey  EncryptedPayloadType = 1
+	PayloadTypeMessage EncryptedPayloadType = 2
+)
+
+// EncryptedPayload — шифротекст сообщения для одного устройства, хранится в строке сообщения
+type EncryptedPayload struct {
+	UserID     gocql.UUID           `json:"user_id" cql:"user_id"`
+	DeviceID   gocql.UUID           `json:"device_id" cql:"device_id"`
+	Type       EncryptedPayloadType `json:"type" cql:"type"`
+	Ciphertext []byte               `json:"ciphertext" cql:"ciphertext"`
 }
+
+// Encrypted сообщает, зашифровано ли сообщение на устройствах участников
+func (m *Message) Encrypted() bool {
+	return len(m.EncryptedPayloads) > 0
+}
+
+// IsReply сообщает, входит ли сообщение в ветку ответов
+func (m *Message) IsReply() bool {
+	return m.ThreadRootID != (gocql.UUID{})
+}
+
+// Expires сообщает, исчезнет ли сообщение по таймеру беседы
+func (m *Message) Expires() bool {
+	return !m.ExpiresAt.IsZero()
+}
+
+// TTL возвращает оставшееся время жизни сообщения для записей, связанных с ним.
+// Нулевое значение означает бессрочное хранение, поэтому у истекающего сообщения TTL не меньше секунды
+func (m *Message) TTL(now time.Time) time.Duration {
+	if !m.Expires() {
+		return 0
+	}
+	if ttl := m.ExpiresAt.Sub(now); ttl > time.Second {
+		return ttl
+	}
+	return time.Second
+}
+
+// AdvanceStatus переводит сообщение в статус и запоминает время перехода.
+// Пропущенный переход в DELIVERED получает время прочтения
+func (m *Message) AdvanceStatus(status MessageStatus, at time.Time) {
+	m.Status = status
+	if status >= StatusDelivered && m.DeliveredAt.IsZero() {
+		m.DeliveredAt = at
+	}
+	if status >= StatusRead && m.ReadAt.IsZero() {
+		m.ReadAt = at
+	}
+}
+
+// Edited сообщает, редактировалось ли сообщение
+func (m *Message) Edited() bool {
+	return !m.EditedAt.IsZero()
+}
+
+// MessageEdit — предыдущая версия сообщения, сохраняемая при правке
+type MessageEdit struct {
+	MessageID gocql.UUID `json:"message_id"`
+	Content   string     `json:"content"`
+	EditedAt  time.Time  `json:"edited_at"`
+}
+
+type DeleteMode int32
+
+const (
+	DeleteModeUnspecified DeleteMode = 0
+	DeleteModeForMe       DeleteMode = 1
+	DeleteModeForEveryone DeleteMode = 2
+)
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"hash/crc32"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Число партиций таблицы outbox_events, события одной беседы всегда попадают в одну партицию
+const OutboxShards = 16
+
+// OutboxEvent — событие для других сервисов, которое сохраняется вместе с изменением сообщения
+// и затем публикуется в Kafka фоновой рассылкой
+type OutboxEvent struct {
+	Shard     int
+	EventID   gocql.UUID
+	Topic     string
+	Key       string
+	Type      string
+	Version   int
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// OutboxShard определяет партицию outbox для беседы, чтобы сохранить порядок ее событий
+func OutboxShard(conversationID gocql.UUID) int {
+	return int(crc32.ChecksumIEEE(conversationID.Bytes()) % OutboxShards)
+}
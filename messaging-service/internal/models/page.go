@@ -0,0 +1,34 @@
+package models
+
+import "github.com/gocql/gocql"
+
+type PageDirection int32
+
+const (
+	PageDirectionUnspecified PageDirection = 0
+	PageDirectionOlder       PageDirection = 1
+	PageDirectionNewer       PageDirection = 2
+)
+
+// PageQuery — запрос страницы по кластерному ключу message_id.
+// Нулевой Cursor означает начало истории в выбранном направлении.
+type PageQuery struct {
+	Cursor    gocql.UUID
+	Direction PageDirection
+	Limit     int
+}
+
+// MessagePage — страница сообщений, упорядоченных от новых к старым
+type MessagePage struct {
+	Messages   []*Message
+	NextCursor gocql.UUID
+	HasMore    bool
+}
+
+// Thread — страница ответов в ветке, упорядоченных от старых к новым
+type Thread struct {
+	Root       *Message
+	Replies    []*Message
+	NextCursor gocql.UUID
+	HasMore    bool
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// PinnedMessage — сообщение, закрепленное участником беседы
+type PinnedMessage struct {
+	ConversationID gocql.UUID `json:"conversation_id"`
+	MessageID      gocql.UUID `json:"message_id"`
+	PinnedBy       gocql.UUID `json:"pinned_by"`
+	PinnedAt       time.Time  `json:"pinned_at"`
+	Message        *Message   `json:"-"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Presence — присутствие пользователя в сети
+type Presence struct {
+	UserID         gocql.UUID `json:"user_id"`
+	Online         bool       `json:"online"`
+	LastSeenAt     time.Time  `json:"last_seen_at"`
+	LastSeenHidden bool       `json:"last_seen_hidden"`
+}
+
+// HideLastSeen убирает время последнего присутствия, скрытое пользователем
+func (p *Presence) HideLastSeen() {
+	p.LastSeenAt = time.Time{}
+	p.LastSeenHidden = true
+}
+
+// TypingIndicator — участник беседы начал или закончил набирать сообщение
+type TypingIndicator struct {
+	ConversationID gocql.UUID `json:"conversation_id"`
+	UserID         gocql.UUID `json:"user_id"`
+	Typing         bool       `json:"typing"`
+}
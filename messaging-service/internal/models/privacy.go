@@ -0,0 +1,29 @@
+package models
+
+// MessagingPolicy — кто может писать пользователю в личные сообщения
+type MessagingPolicy int32
+
+const (
+	MessagingPolicyUnspecified MessagingPolicy = 0
+	MessagingPolicyFriends     MessagingPolicy = 1
+	MessagingPolicyEveryone    MessagingPolicy = 2
+	MessagingPolicyNobody      MessagingPolicy = 3
+)
+
+// DefaultMessagingPolicy действует, пока пользователь не изменил настройки
+const DefaultMessagingPolicy = MessagingPolicyFriends
+
+// OrDefault возвращает политику по умолчанию вместо неустановленной
+func (p MessagingPolicy) OrDefault() MessagingPolicy {
+	if p == MessagingPolicyUnspecified {
+		return DefaultMessagingPolicy
+	}
+	return p
+}
+
+// Relationship — отношения пользователя с другим пользователем в friendship-service
+type Relationship struct {
+	Friends   bool
+	Blocked   bool
+	BlockedBy bool
+}
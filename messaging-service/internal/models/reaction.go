@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Reaction — реакция пользователя на сообщение
+type Reaction struct {
+	MessageID gocql.UUID `json:"message_id"`
+	UserID    gocql.UUID `json:"user_id"`
+	Emoji     string     `json:"emoji"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ReactionSummary — количество реакций одного вида на сообщение
+type ReactionSummary struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"-"`
+}
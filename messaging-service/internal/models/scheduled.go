@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type ScheduledMessageState int32
+
+const (
+	ScheduledUnspecified ScheduledMessageState = 0
+	ScheduledPending     ScheduledMessageState = 1
+	ScheduledSending     ScheduledMessageState = 2
+	ScheduledFailed      ScheduledMessageState = 3
+)
+
+// Текстовые значения состояний в колонке scheduled_messages.state
+var scheduledStateNames = map[ScheduledMessageState]string{
+	ScheduledPending: "pending",
+	ScheduledSending: "sending",
+	ScheduledFailed:  "failed",
+}
+
+func (s ScheduledMessageState) String() string {
+	if name, ok := scheduledStateNames[s]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+func ParseScheduledMessageState(name string) ScheduledMessageState {
+	for state, stateName := range scheduledStateNames {
+		if stateName == name {
+			return state
+		}
+	}
+	return ScheduledUnspecified
+}
+
+// ScheduledMessage — сообщение, которое будет отправлено от имени пользователя в момент SendAt.
+// MessageID назначается при первой попытке отправки, чтобы повторная попытка не создала второе сообщение
+type ScheduledMessage struct {
+	ScheduledID      gocql.UUID            `json:"scheduled_id"`
+	SenderID         gocql.UUID            `json:"sender_id"`
+	ConversationID   gocql.UUID            `json:"conversation_id"`
+	RecipientID      gocql.UUID            `json:"recipient_id"`
+	Content          string                `json:"content"`
+	ReplyToMessageID gocql.UUID            `json:"reply_to_message_id"`
+	AttachmentIDs    []gocql.UUID          `json:"attachment_ids,omitempty"`
+	SendAt           time.Time             `json:"send_at"`
+	State            ScheduledMessageState `json:"state"`
+	MessageID        gocql.UUID            `json:"message_id"`
+	ClaimedAt        time.Time             `json:"claimed_at"`
+	FailureReason    string                `json:"failure_reason,omitempty"`
+	Entities         []*TextEntity         `json:"entities,omitempty"`
+}
+
+// Message собирает сообщение для отправки, время сообщения — момент фактической отправки
+func (s *ScheduledMessage) Message(now time.Time) *Message {
+	message := &Message{
+		MessageID:        s.MessageID,
+		SenderID:         s.SenderID,
+		RecipientID:      s.RecipientID,
+		ConversationID:   s.ConversationID,
+		Content:          s.Content,
+		ContentType:      ContentText,
+		Entities:         s.Entities,
+		Timestamp:        now,
+		Status:           StatusSent,
+		ReplyToMessageID: s.ReplyToMessageID,
+	}
+	for _, attachmentID := range s.AttachmentIDs {
+		message.Attachments = append(message.Attachments, &Attachment{AttachmentID: attachmentID})
+	}
+	return message
+}
+
+// ScheduledEntry — запись очереди отправки. Записи группируются по часу отправки,
+// чтобы планировщик читал их без обхода всей таблицы
+type ScheduledEntry struct {
+	Bucket      time.Time
+	SendAt      time.Time
+	ScheduledID gocql.UUID
+	SenderID    gocql.UUID
+}
+
+// ScheduleBucket возвращает час, к которому относится отправка сообщения
+func ScheduleBucket(sendAt time.Time) time.Time {
+	return sendAt.UTC().Truncate(time.Hour)
+}
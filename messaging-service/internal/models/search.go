@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// SearchDocument — сообщение в поисковом индексе
+type SearchDocument struct {
+	MessageID      gocql.UUID
+	ConversationID gocql.UUID
+	SenderID       gocql.UUID
+	Content        string
+	SentAt         time.Time
+	// ExpiresAt — время исчезновения сообщения, нулевое для обычных сообщений
+	ExpiresAt time.Time
+}
+
+// SearchQuery — запрос к поисковому индексу.
+// Пустые фильтры не ограничивают выдачу, кроме списка бесед: без бесед ничего не найдется
+type SearchQuery struct {
+	Text            string
+	ConversationIDs []gocql.UUID
+	SenderID        gocql.UUID
+	From            time.Time
+	To              time.Time
+	Offset          int
+	Limit           int
+}
+
+// SearchHit — найденное сообщение с фрагментами, где совпадения выделены тегом <mark>
+type SearchHit struct {
+	MessageID      gocql.UUID
+	ConversationID gocql.UUID
+	Highlights     []string
+}
+
+// SearchResult — страница результатов поиска от новых сообщений к старым
+type SearchResult struct {
+	Hits  []*SearchHit
+	Total int
+}
+
+// SearchMatch — найденное сообщение вместе с выделенными фрагментами
+type SearchMatch struct {
+	Message    *Message
+	Highlights []string
+}
+
+// SearchPage — страница найденных сообщений, доступных пользователю
+type SearchPage struct {
+	Matches   []*SearchMatch
+	NextToken string
+	HasMore   bool
+}
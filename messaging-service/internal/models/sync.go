@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type SyncChangeType int32
+
+const (
+	SyncChangeUnspecified    SyncChangeType = 0
+	SyncMessageCreated       SyncChangeType = 1
+	SyncMessageEdited        SyncChangeType = 2
+	SyncMessageDeleted       SyncChangeType = 3
+	SyncMessageStatusChanged SyncChangeType = 4
+	SyncMemberAdded          SyncChangeType = 5
+	SyncMemberRemoved        SyncChangeType = 6
+	SyncMemberRoleChanged    SyncChangeType = 7
+)
+
+// Текстовые значения видов изменений в колонке user_sync_log.change_type
+var syncChangeTypeNames = map[SyncChangeType]string{
+	SyncMessageCreated:       "message_created",
+	SyncMessageEdited:        "message_edited",
+	SyncMessageDeleted:       "message_deleted",
+	SyncMessageStatusChanged: "message_status_changed",
+	SyncMemberAdded:          "member_added",
+	SyncMemberRemoved:        "member_removed",
+	SyncMemberRoleChanged:    "member_role_changed",
+}
+
+func (t SyncChangeType) String() string {
+	if name, ok := syncChangeTypeNames[t]; ok {
+		return name
+	}
+	return "unspecified"
+}
+
+func ParseSyncChangeType(name string) SyncChangeType {
+	for changeType, typeName := range syncChangeTypeNames {
+		if typeName == name {
+			return changeType
+		}
+	}
+	return SyncChangeUnspecified
+}
+
+// HasMessage сообщает, нужно ли клиенту текущее состояние сообщения для применения изменения
+func (t SyncChangeType) HasMessage() bool {
+	return t == SyncMessageCreated || t == SyncMessageEdited
+}
+
+// SyncChange — запись журнала синхронизации пользователя. Seq монотонно растет в пределах
+// пользователя, а запись описывает только факт изменения: состояние сообщения читается при выдаче.
+// При повторной доставке события одно изменение может попасть в журнал дважды, поэтому
+// клиент применяет изменения идемпотентно
+type SyncChange struct {
+	UserID         gocql.UUID     `json:"user_id"`
+	Seq            int64          `json:"seq"`
+	Type           SyncChangeType `json:"type"`
+	ConversationID gocql.UUID     `json:"conversation_id"`
+	MessageID      gocql.UUID     `json:"message_id"`
+	MemberID       gocql.UUID     `json:"member_id"`
+	Status         MessageStatus  `json:"status"`
+	Role           MemberRole     `json:"role"`
+	EventID        gocql.UUID     `json:"event_id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	Message        *Message       `json:"message,omitempty"`
+}
+
+// SyncUpdates — страница журнала синхронизации после известного клиенту номера
+type SyncUpdates struct {
+	Changes []*SyncChange
+	// LatestSeq — номер последнего изменения в журнале пользователя
+	LatestSeq int64
+	// HasMore — в журнале остались изменения после последнего на странице
+	HasMore bool
+	// ResyncRequired — часть изменений после известного клиенту номера уже удалена из журнала,
+	// клиенту нужно заново загрузить беседы и продолжить синхронизацию с LatestSeq
+	ResyncRequired bool
+}
@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type AttachmentRepository interface {
+	SaveAttachment(ctx context.Context, attachment *models.Attachment) error
+	GetAttachment(ctx context.Context, attachmentID gocql.UUID) (*models.Attachment, error)
+	// BindAttachment привязывает файл к сообщению и сообщает, не был ли он уже отправлен
+	BindAttachment(ctx context.Context, attachmentID, conversationID, messageID gocql.UUID) (bool, error)
+	// UnbindAttachment снимает привязку файла к несохраненному сообщению
+	UnbindAttachment(ctx context.Context, attachmentID, messageID gocql.UUID) error
+	// GetExpirations возвращает запланированные удаления файлов из часа bucket, истекшие к моменту before
+	GetExpirations(ctx context.Context, bucket, before time.Time) ([]*models.AttachmentExpiration, error)
+	// DeleteExpired удаляет сведения о файле вместе с запланированным удалением
+	DeleteExpired(ctx context.Context, expiration *models.AttachmentExpiration) error
+}
+
+type attachmentRepository struct {
+	session *gocql.Session
+}
+
+func NewAttachmentRepository(session *gocql.Session) AttachmentRepository {
+	return &attachmentRepository{
+		session: session,
+	}
+}
+
+func (r *attachmentRepository) SaveAttachment(ctx context.Context, attachment *models.Attachment) error {
+	query := `INSERT INTO attachments (
+        attachment_id, uploader_id, file_name, mime_type, size, checksum, width, height, storage_key, created_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	return r.session.Query(query,
+		attachment.AttachmentID,
+		attachment.UploaderID,
+		attachment.FileName,
+		attachment.MimeType,
+		attachment.Size,
+		attachment.Checksum,
+		attachment.Width,
+		attachment.Height,
+		attachment.StorageKey,
+		attachment.CreatedAt,
+	).WithContext(ctx).Exec()
+}
+
+func (r *attachmentRepository) GetAttachment(ctx context.Context, attachmentID gocql.UUID) (*models.Attachment, error) {
+	query := `SELECT attachment_id, uploader_id, conversation_id, message_id, file_name, mime_type,
+        size, checksum, width, height, storage_key, created_at
+        FROM attachments WHERE attachment_id = ?`
+	var attachment models.Attachment
+	err := r.session.Query(query, attachmentID).WithContext(ctx).Scan(
+		&attachment.AttachmentID,
+		&attachment.UploaderID,
+		&attachment.ConversationID,
+		&attachment.MessageID,
+		&attachment.FileName,
+		&attachment.MimeType,
+		&attachment.Size,
+		&attachment.Checksum,
+		&attachment.Width,
+		&attachment.Height,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (r *attachmentRepository) BindAttachment(ctx context.Context, attachmentID, conversationID, messageID gocql.UUID) (bool, error) {
+	// Легковесная транзакция не дает отправить один файл в двух сообщениях
+	query := `UPDATE attachments SET conversation_id = ?, message_id = ? WHERE attachment_id = ? IF message_id = null`
+	previous := map[string]interface{}{}
+	applied, err := r.session.Query(query, conversationID, messageID, attachmentID).WithContext(ctx).MapScanCAS(previous)
+	if err != nil || applied {
+		return applied, err
+	}
+	// Файл уже привязан к этому же сообщению прерванной попыткой отправки
+	current, _ := previous["message_id"].(gocql.UUID)
+	return current == messageID, nil
+}
+
+func (r *attachmentRepository) UnbindAttachment(ctx context.Context, attachmentID, messageID gocql.UUID) error {
+	// Условие не дает снять привязку, если файл уже отправлен в другом сообщении
+	query := `UPDATE attachments SET conversation_id = null, message_id = null WHERE attachment_id = ? IF message_id = ?`
+	_, err := r.session.Query(query, attachmentID, messageID).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	return err
+}
+
+func (r *attachmentRepository) GetExpirations(ctx context.Context, bucket, before time.Time) ([]*models.AttachmentExpiration, error) {
+	query := `SELECT expires_at, attachment_id FROM attachment_expirations WHERE bucket = ? AND expires_at <= ?`
+	iter := r.session.Query(query, bucket, before).WithContext(ctx).Iter()
+
+	var expirations []*models.AttachmentExpiration
+	var expiresAt time.Time
+	var attachmentID gocql.UUID
+	for iter.Scan(&expiresAt, &attachmentID) {
+		expirations = append(expirations, &models.AttachmentExpiration{
+			Bucket:       bucket,
+			ExpiresAt:    expiresAt,
+			AttachmentID: attachmentID,
+		})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return expirations, nil
+}
+
+func (r *attachmentRepository) DeleteExpired(ctx context.Context, expiration *models.AttachmentExpiration) error {
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM attachments WHERE attachment_id = ?`, expiration.AttachmentID)
+	batch.Query(`DELETE FROM attachment_expirations WHERE bucket = ? AND expires_at = ? AND attachment_id = ?`,
+		expiration.Bucket,
+		expiration.ExpiresAt,
+		expiration.AttachmentID,
+	)
+	return r.session.ExecuteBatch(batch)
+}
+
+// addAttachmentExpiration планирует удаление файла вместе с записью сообщения
+func addAttachmentExpiration(batch *gocql.Batch, expiration *models.AttachmentExpiration) {
+	batch.Query(`INSERT INTO attachment_expirations (bucket, expires_at, attachment_id) VALUES (?, ?, ?)`,
+		expiration.Bucket,
+		expiration.ExpiresAt,
+		expiration.AttachmentID,
+	)
+}
@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type ConversationRepository interface {
+	CreateConversation(ctx context.Context, conversation *models.Conversation, members []*models.ConversationMember, events ...*models.OutboxEvent) error
+	// CreateConversationIfNotExists создает беседу только при ее отсутствии и сообщает, была ли она создана
+	CreateConversationIfNotExists(ctx context.Context, conversation *models.Conversation) (bool, error)
+	GetConversation(ctx context.Context, conversationID gocql.UUID) (*models.Conversation, error)
+	GetMembers(ctx context.Context, conversationID gocql.UUID) ([]*models.ConversationMember, error)
+	GetMember(ctx context.Context, conversationID, userID gocql.UUID) (*models.ConversationMember, error)
+	// SaveMembers, UpdateMemberRole и RemoveMember записывают события об изменении состава тем же батчем
+	SaveMembers(ctx context.Context, members []*models.ConversationMember, events ...*models.OutboxEvent) error
+	UpdateMemberRole(ctx context.Context, conversationID, userID gocql.UUID, role models.MemberRole, events ...*models.OutboxEvent) error
+	RemoveMember(ctx context.Context, conversationID, userID gocql.UUID, events ...*models.OutboxEvent) error
+	SetMessageTTL(ctx context.Context, conversationID gocql.UUID, ttl time.Duration) error
+}
+
+type conversationRepository struct {
+	session *gocql.Session
+}
+
+func NewConversationRepository(session *gocql.Session) ConversationRepository {
+	return &conversationRepository{
+		session: session,
+	}
+}
+
+// Участники сохраняются раньше беседы, поэтому найденная беседа всегда имеет состав
+func (r *conversationRepository) CreateConversation(ctx context.Context, conversation *models.Conversation, members []*models.ConversationMember, events ...*models.OutboxEvent) error {
+	if err := r.SaveMembers(ctx, members, events...); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO conversations (
+        conversation_id, type, title, creator_id, created_at
+    ) VALUES (?, ?, ?, ?, ?)`
+	return r.session.Query(query,
+		conversation.ConversationID,
+		conversation.Type.String(),
+		conversation.Title,
+		conversation.CreatorID,
+		conversation.CreatedAt,
+	).WithContext(ctx).Exec()
+}
+
+func (r *conversationRepository) CreateConversationIfNotExists(ctx context.Context, conversation *models.Conversation) (bool, error) {
+	query := `INSERT INTO conversations (
+        conversation_id, type, title, creator_id, created_at
+    ) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS`
+	return r.session.Query(query,
+		conversation.ConversationID,
+		conversation.Type.String(),
+		conversation.Title,
+		conversation.CreatorID,
+		conversation.CreatedAt,
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *conversationRepository) GetConversation(ctx context.Context, conversationID gocql.UUID) (*models.Conversation, error) {
+	query := `SELECT conversation_id, type, title, creator_id, created_at, message_ttl FROM conversations WHERE conversation_id = ?`
+	var conversation models.Conversation
+	var conversationType string
+	var messageTTL int
+	if err := r.session.Query(query, conversationID).WithContext(ctx).Scan(
+		&conversation.ConversationID,
+		&conversationType,
+		&conversation.Title,
+		&conversation.CreatorID,
+		&conversation.CreatedAt,
+		&messageTTL,
+	); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	conversation.Type = models.ParseConversationType(conversationType)
+	conversation.MessageTTL = time.Duration(messageTTL) * time.Second
+	return &conversation, nil
+}
+
+func (r *conversationRepository) GetMembers(ctx context.Context, conversationID gocql.UUID) ([]*models.ConversationMember, error) {
+	query := `SELECT user_id, role, joined_at FROM conversation_members WHERE conversation_id = ?`
+	iter := r.session.Query(query, conversationID).WithContext(ctx).Iter()
+
+	var members []*models.ConversationMember
+	var member models.ConversationMember
+	var role string
+	for iter.Scan(&member.UserID, &role, &member.JoinedAt) {
+		memberCopy := member
+		memberCopy.ConversationID = conversationID
+		memberCopy.Role = models.ParseMemberRole(role)
+		members = append(members, &memberCopy)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *conversationRepository) GetMember(ctx context.Context, conversationID, userID gocql.UUID) (*models.ConversationMember, error) {
+	query := `SELECT role, joined_at FROM conversation_members WHERE conversation_id = ? AND user_id = ?`
+	member := models.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+	}
+	var role string
+	if err := r.session.Query(query, conversationID, userID).WithContext(ctx).Scan(&role, &member.JoinedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	member.Role = models.ParseMemberRole(role)
+	return &member, nil
+}
+
+func (r *conversationRepository) SaveMembers(ctx context.Context, members []*models.ConversationMember, events ...*models.OutboxEvent) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	// Беседа попадает в списки бесед участников тем же логируемым батчем, что и состав
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	for _, member := range members {
+		batch.Query(`INSERT INTO conversation_members (conversation_id, user_id, role, joined_at) VALUES (?, ?, ?, ?)`,
+			member.ConversationID,
+			member.UserID,
+			member.Role.String(),
+			member.JoinedAt,
+		)
+	}
+	addInboxEntries(batch, members)
+	for _, event := range events {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *conversationRepository) UpdateMemberRole(ctx context.Context, conversationID, userID gocql.UUID, role models.MemberRole, events ...*models.OutboxEvent) error {
+	query := `UPDATE conversation_members SET role = ? WHERE conversation_id = ? AND user_id = ?`
+	if len(events) == 0 {
+		return r.session.Query(query, role.String(), conversationID, userID).WithContext(ctx).Exec()
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(query, role.String(), conversationID, userID)
+	for _, event := range events {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *conversationRepository) RemoveMember(ctx context.Context, conversationID, userID gocql.UUID, events ...*models.OutboxEvent) error {
+	var activityID gocql.UUID
+	err := r.session.Query(`SELECT activity_id FROM user_conversations WHERE user_id = ? AND conversation_id = ?`,
+		userID,
+		conversationID,
+	).WithContext(ctx).Scan(&activityID)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return err
+	}
+
+	// Вместе с участником беседа убирается из его списка бесед
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM conversation_members WHERE conversation_id = ? AND user_id = ?`, conversationID, userID)
+	batch.Query(`DELETE FROM user_conversations WHERE user_id = ? AND conversation_id = ?`, userID, conversationID)
+	if activityID != (gocql.UUID{}) {
+		batch.Query(`DELETE FROM user_inbox WHERE user_id = ? AND activity_id = ? AND conversation_id = ?`,
+			userID,
+			activityID,
+			conversationID,
+		)
+	}
+	for _, event := range events {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *conversationRepository) SetMessageTTL(ctx context.Context, conversationID gocql.UUID, ttl time.Duration) error {
+	query := `UPDATE conversations SET message_ttl = ? WHERE conversation_id = ?`
+	return r.session.Query(query, int(ttl.Seconds()), conversationID).WithContext(ctx).Exec()
+}
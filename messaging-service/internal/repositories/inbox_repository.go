@@ -0,0 +1,414 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Число попыток сдвинуть отметку прочтения при одновременных обновлениях
+const maxMarkReadAttempts = 3
+
+// InboxRepository хранит списки бесед пользователей.
+// user_conversations содержит последнее сообщение и отметку прочтения,
+// user_inbox упорядочивает беседы пользователя по последней активности
+type InboxRepository interface {
+	// GetInbox возвращает беседы части списка пользователя от последней активности к более ранней, начиная после курсора
+	GetInbox(ctx context.Context, userID, cursor gocql.UUID, folder models.InboxFolder, limit int) ([]*models.InboxEntry, error)
+	GetEntry(ctx context.Context, userID, conversationID gocql.UUID) (*models.InboxEntry, error)
+	// RecordMessage поднимает беседу в списках пользователей и запоминает последнее сообщение.
+	// Отправитель прочитал беседу до своего сообщения. Архивная беседа без отключенных уведомлений возвращается из архива
+	RecordMessage(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error
+	// UpdatePreview обновляет текст последнего сообщения у пользователей, для которых оно все еще последнее
+	UpdatePreview(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error
+	// MarkRead сдвигает отметку прочтения вперед и сообщает, изменилась ли она
+	MarkRead(ctx context.Context, userID, conversationID, messageID gocql.UUID) (bool, error)
+	// GetReadMarks возвращает отметки прочтения участников беседы
+	GetReadMarks(ctx context.Context, conversationID gocql.UUID) (map[gocql.UUID]gocql.UUID, error)
+	// CountUnreadConversations считает беседы пользователя с непрочитанными сообщениями
+	CountUnreadConversations(ctx context.Context, userID gocql.UUID) (int, error)
+	// GetConversationIDs возвращает все беседы, в которых состоит пользователь
+	GetConversationIDs(ctx context.Context, userID gocql.UUID) ([]gocql.UUID, error)
+	SetArchived(ctx context.Context, userID, conversationID gocql.UUID, archived bool) error
+	// SetMutedUntil отключает уведомления беседы до mutedUntil, нулевое время включает их
+	SetMutedUntil(ctx context.Context, userID, conversationID gocql.UUID, mutedUntil time.Time) error
+	SetMarkedUnread(ctx context.Context, userID, conversationID gocql.UUID, markedUnread bool) error
+	// GetMutedUserIDs возвращает пользователей, у которых уведомления беседы отключены в момент now
+	GetMutedUserIDs(ctx context.Context, conversationID gocql.UUID, userIDs []gocql.UUID, now time.Time) ([]gocql.UUID, error)
+}
+
+type inboxRepository struct {
+	session *gocql.Session
+}
+
+func NewInboxRepository(session *gocql.Session) InboxRepository {
+	return &inboxRepository{
+		session: session,
+	}
+}
+
+func (r *inboxRepository) GetInbox(ctx context.Context, userID, cursor gocql.UUID, folder models.InboxFolder, limit int) ([]*models.InboxEntry, error) {
+	var entries []*models.InboxEntry
+	for len(entries) < limit {
+		rows, err := r.getInboxRows(ctx, userID, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]gocql.UUID, len(rows))
+		for i, row := range rows {
+			ids[i] = row.ConversationID
+		}
+		current, err := r.getEntries(ctx, userID, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Строка индекса устаревает, когда беседа поднимается выше или пользователь ее покидает
+		for _, row := range rows {
+			entry := current[row.ConversationID]
+			if entry == nil || entry.ActivityID != row.ActivityID {
+				r.deleteInboxRow(ctx, userID, row.ActivityID, row.ConversationID)
+				continue
+			}
+			if folder.Includes(entry) && len(entries) < limit {
+				entries = append(entries, entry)
+			}
+		}
+
+		if len(rows) < limit {
+			break
+		}
+		cursor = rows[len(rows)-1].ActivityID
+	}
+	return entries, nil
+}
+
+func (r *inboxRepository) getInboxRows(ctx context.Context, userID, cursor gocql.UUID, limit int) ([]*models.InboxEntry, error) {
+	query := `SELECT activity_id, conversation_id FROM user_inbox WHERE user_id = ?`
+	args := []interface{}{userID}
+	if cursor != (gocql.UUID{}) {
+		query += ` AND activity_id < ?`
+		args = append(args, cursor)
+	}
+	query += ` LIMIT ?`
+	args = append(args, limit)
+
+	iter := r.session.Query(query, args...).WithContext(ctx).Iter()
+	var rows []*models.InboxEntry
+	var row models.InboxEntry
+	for iter.Scan(&row.ActivityID, &row.ConversationID) {
+		found := row
+		rows = append(rows, &found)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *inboxRepository) deleteInboxRow(ctx context.Context, userID, activityID, conversationID gocql.UUID) {
+	// Не удаленная строка будет пропущена и при следующем чтении
+	_ = r.session.Query(`DELETE FROM user_inbox WHERE user_id = ? AND activity_id = ? AND conversation_id = ?`,
+		userID,
+		activityID,
+		conversationID,
+	).WithContext(ctx).Exec()
+}
+
+func (r *inboxRepository) getEntries(ctx context.Context, userID gocql.UUID, conversationIDs []gocql.UUID) (map[gocql.UUID]*models.InboxEntry, error) {
+	entries := make(map[gocql.UUID]*models.InboxEntry, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return entries, nil
+	}
+
+	query := `SELECT ` + inboxEntryColumns + ` FROM user_conversations WHERE user_id = ? AND conversation_id IN ?`
+	iter := r.session.Query(query, userID, conversationIDs).WithContext(ctx).Iter()
+	var row inboxEntryRow
+	for iter.Scan(row.dest()...) {
+		entry := row.entry(userID)
+		entries[entry.ConversationID] = entry
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *inboxRepository) GetEntry(ctx context.Context, userID, conversationID gocql.UUID) (*models.InboxEntry, error) {
+	query := `SELECT ` + inboxEntryColumns + ` FROM user_conversations WHERE user_id = ? AND conversation_id = ?`
+	var row inboxEntryRow
+	if err := r.session.Query(query, userID, conversationID).WithContext(ctx).Scan(row.dest()...); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.entry(userID), nil
+}
+
+func (r *inboxRepository) RecordMessage(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error {
+	preview := models.MessagePreview(message)
+	var errs []error
+	for _, userID := range userIDs {
+		if err := r.recordMessage(ctx, userID, message, preview); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *inboxRepository) recordMessage(ctx context.Context, userID gocql.UUID, message *models.Message, preview string) error {
+	entry, err := r.GetEntry(ctx, userID, message.ConversationID)
+	if err != nil {
+		return err
+	}
+	// Запоздавшая запись более старого сообщения не опускает беседу в списке
+	if entry != nil && !timeUUIDBefore(entry.ActivityID, message.MessageID) {
+		return nil
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	// Строка без активности появляется, когда отметка прочтения опередила добавление в список
+	if entry != nil && entry.ActivityID != (gocql.UUID{}) {
+		batch.Query(`DELETE FROM user_inbox WHERE user_id = ? AND activity_id = ? AND conversation_id = ?`,
+			userID,
+			entry.ActivityID,
+			message.ConversationID,
+		)
+	}
+	batch.Query(`INSERT INTO user_inbox (user_id, activity_id, conversation_id) VALUES (?, ?, ?)`,
+		userID,
+		message.MessageID,
+		message.ConversationID,
+	)
+	// Текст исчезающего сообщения пропадает из списка бесед вместе с самим сообщением
+	batch.Query(`UPDATE user_conversations USING TTL ? SET last_message_preview = ? WHERE user_id = ? AND conversation_id = ?`,
+		ttlSeconds(message),
+		preview,
+		userID,
+		message.ConversationID,
+	)
+	update := `UPDATE user_conversations SET activity_id = ?, last_message_id = ?, last_sender_id = ?`
+	args := []interface{}{message.MessageID, message.MessageID, message.SenderID}
+	if entry != nil && entry.Archived && !entry.Muted(message.Timestamp) {
+		update += `, archived = false`
+	}
+	if userID == message.SenderID {
+		update += `, last_read_message_id = ?`
+		args = append(args, message.MessageID)
+		batch.Query(updateReadMarkQuery, readMarkArgs(message.ConversationID, userID, message.MessageID)...)
+	}
+	update += ` WHERE user_id = ? AND conversation_id = ?`
+	args = append(args, userID, message.ConversationID)
+	batch.Query(update, args...)
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *inboxRepository) UpdatePreview(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error {
+	preview := models.MessagePreview(message)
+	var errs []error
+	for _, userID := range userIDs {
+		entry, err := r.GetEntry(ctx, userID, message.ConversationID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if entry == nil || entry.LastMessageID != message.MessageID {
+			continue
+		}
+		// Условие отсекает случай, когда за время чтения в беседу пришло новое сообщение
+		query := `UPDATE user_conversations USING TTL ? SET last_message_preview = ?
+            WHERE user_id = ? AND conversation_id = ? IF last_message_id = ?`
+		if _, err := r.session.Query(query, ttlSeconds(message), preview, userID, message.ConversationID, message.MessageID).
+			WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *inboxRepository) MarkRead(ctx context.Context, userID, conversationID, messageID gocql.UUID) (bool, error) {
+	for attempt := 0; attempt < maxMarkReadAttempts; attempt++ {
+		entry, err := r.GetEntry(ctx, userID, conversationID)
+		if err != nil {
+			return false, err
+		}
+		var readID gocql.UUID
+		if entry != nil {
+			readID = entry.LastReadMessageID
+		}
+		// Отметка прочтения не сдвигается назад
+		if !timeUUIDBefore(readID, messageID) {
+			return false, nil
+		}
+
+		// Беседы, созданные до появления списков бесед, получают строку при первом прочтении
+		query := `UPDATE user_conversations SET last_read_message_id = ?
+            WHERE user_id = ? AND conversation_id = ? IF last_read_message_id = ?`
+		args := []interface{}{messageID, userID, conversationID, nullableUUID(readID)}
+		if entry == nil {
+			query = `INSERT INTO user_conversations (user_id, conversation_id, last_read_message_id) VALUES (?, ?, ?) IF NOT EXISTS`
+			args = []interface{}{userID, conversationID, messageID}
+		}
+		applied, err := r.session.Query(query, args...).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			return false, err
+		}
+		if !applied {
+			continue
+		}
+
+		if err := r.session.Query(updateReadMarkQuery, readMarkArgs(conversationID, userID, messageID)...).
+			WithContext(ctx).Exec(); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *inboxRepository) GetReadMarks(ctx context.Context, conversationID gocql.UUID) (map[gocql.UUID]gocql.UUID, error) {
+	iter := r.session.Query(`SELECT user_id, last_read_message_id FROM conversation_read_marks WHERE conversation_id = ?`,
+		conversationID,
+	).WithContext(ctx).Iter()
+	marks := make(map[gocql.UUID]gocql.UUID)
+	var userID, messageID gocql.UUID
+	for iter.Scan(&userID, &messageID) {
+		marks[userID] = messageID
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return marks, nil
+}
+
+func (r *inboxRepository) CountUnreadConversations(ctx context.Context, userID gocql.UUID) (int, error) {
+	query := `SELECT ` + inboxEntryColumns + ` FROM user_conversations WHERE user_id = ?`
+	iter := r.session.Query(query, userID).WithContext(ctx).Iter()
+	count := 0
+	var row inboxEntryRow
+	for iter.Scan(row.dest()...) {
+		if row.entry(userID).HasUnread() {
+			count++
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *inboxRepository) GetConversationIDs(ctx context.Context, userID gocql.UUID) ([]gocql.UUID, error) {
+	iter := r.session.Query(`SELECT conversation_id FROM user_conversations WHERE user_id = ?`, userID).
+		WithContext(ctx).Iter()
+	var conversationIDs []gocql.UUID
+	var conversationID gocql.UUID
+	for iter.Scan(&conversationID) {
+		conversationIDs = append(conversationIDs, conversationID)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return conversationIDs, nil
+}
+
+func (r *inboxRepository) SetArchived(ctx context.Context, userID, conversationID gocql.UUID, archived bool) error {
+	query := `UPDATE user_conversations SET archived = ? WHERE user_id = ? AND conversation_id = ?`
+	return r.session.Query(query, archived, userID, conversationID).WithContext(ctx).Exec()
+}
+
+func (r *inboxRepository) SetMutedUntil(ctx context.Context, userID, conversationID gocql.UUID, mutedUntil time.Time) error {
+	query := `UPDATE user_conversations SET muted_until = ? WHERE user_id = ? AND conversation_id = ?`
+	return r.session.Query(query, nullableTime(mutedUntil), userID, conversationID).WithContext(ctx).Exec()
+}
+
+func (r *inboxRepository) SetMarkedUnread(ctx context.Context, userID, conversationID gocql.UUID, markedUnread bool) error {
+	query := `UPDATE user_conversations SET marked_unread = ? WHERE user_id = ? AND conversation_id = ?`
+	return r.session.Query(query, markedUnread, userID, conversationID).WithContext(ctx).Exec()
+}
+
+func (r *inboxRepository) GetMutedUserIDs(ctx context.Context, conversationID gocql.UUID, userIDs []gocql.UUID, now time.Time) ([]gocql.UUID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	query := `SELECT user_id, muted_until FROM user_conversations WHERE user_id IN ? AND conversation_id = ?`
+	iter := r.session.Query(query, userIDs, conversationID).WithContext(ctx).Iter()
+	var muted []gocql.UUID
+	var userID gocql.UUID
+	var mutedUntil time.Time
+	for iter.Scan(&userID, &mutedUntil) {
+		if now.Before(mutedUntil) {
+			muted = append(muted, userID)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return muted, nil
+}
+
+// Колонки строки списка бесед в порядке полей inboxEntryRow.dest
+const inboxEntryColumns = `conversation_id, activity_id, last_message_id, last_sender_id, last_message_preview, last_read_message_id, archived, muted_until, marked_unread`
+
+// inboxEntryRow — буфер для чтения строки user_conversations
+type inboxEntryRow struct {
+	inbox models.InboxEntry
+}
+
+func (r *inboxEntryRow) dest() []interface{} {
+	return []interface{}{
+		&r.inbox.ConversationID,
+		&r.inbox.ActivityID,
+		&r.inbox.LastMessageID,
+		&r.inbox.LastSenderID,
+		&r.inbox.LastMessagePreview,
+		&r.inbox.LastReadMessageID,
+		&r.inbox.Archived,
+		&r.inbox.MutedUntil,
+		&r.inbox.MarkedUnread,
+	}
+}
+
+func (r *inboxEntryRow) entry(userID gocql.UUID) *models.InboxEntry {
+	entry := r.inbox
+	entry.UserID = userID
+	return &entry
+}
+
+// addInboxEntries добавляет беседу в списки бесед новых участников
+func addInboxEntries(batch *gocql.Batch, members []*models.ConversationMember) {
+	for _, member := range members {
+		activityID := gocql.UUIDFromTime(member.JoinedAt)
+		batch.Query(`INSERT INTO user_conversations (user_id, conversation_id, activity_id) VALUES (?, ?, ?)`,
+			member.UserID,
+			member.ConversationID,
+			activityID,
+		)
+		batch.Query(`INSERT INTO user_inbox (user_id, activity_id, conversation_id) VALUES (?, ?, ?)`,
+			member.UserID,
+			activityID,
+			member.ConversationID,
+		)
+	}
+}
+
+// Отметка прочтения копируется в партицию беседы, где ее читают вместе с отметками других участников.
+// Время записи берется из сообщения, поэтому запоздавшая запись более ранней отметки не затирает более позднюю
+const updateReadMarkQuery = `UPDATE conversation_read_marks USING TIMESTAMP ? SET last_read_message_id = ?
+    WHERE conversation_id = ? AND user_id = ?`
+
+func readMarkArgs(conversationID, userID, messageID gocql.UUID) []interface{} {
+	return []interface{}{messageID.Time().UnixMicro(), messageID, conversationID, userID}
+}
+
+// timeUUIDBefore сравнивает timeuuid по времени, нулевой идентификатор раньше любого другого
+func timeUUIDBefore(a, b gocql.UUID) bool {
+	if a == (gocql.UUID{}) {
+		return b != (gocql.UUID{})
+	}
+	return a.Time().Before(b.Time())
+}
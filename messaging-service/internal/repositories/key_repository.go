@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Сколько раз пробовать забрать одноразовый ключ, если его одновременно забрал другой запрос
+const consumePreKeyAttempts = 3
+
+// KeyRepository хранит открытые ключи устройств для сквозного шифрования
+type KeyRepository interface {
+	GetDevice(ctx context.Context, userID, deviceID gocql.UUID) (*models.DeviceKeys, error)
+	GetDevices(ctx context.Context, userID gocql.UUID) ([]*models.DeviceKeys, error)
+	// SaveDevice сохраняет ключи устройства, resetPreKeys удаляет одноразовые ключи прежней установки
+	SaveDevice(ctx context.Context, device *models.DeviceKeys, resetPreKeys bool) error
+	AddOneTimePreKeys(ctx context.Context, userID, deviceID gocql.UUID, keys []*models.OneTimePreKey) error
+	// ConsumeOneTimePreKey забирает один одноразовый ключ устройства, nil — ключи закончились
+	ConsumeOneTimePreKey(ctx context.Context, userID, deviceID gocql.UUID) (*models.OneTimePreKey, error)
+	CountOneTimePreKeys(ctx context.Context, userID, deviceID gocql.UUID) (int, error)
+}
+
+type keyRepository struct {
+	session *gocql.Session
+}
+
+func NewKeyRepository(session *gocql.Session) KeyRepository {
+	return &keyRepository{
+		session: session,
+	}
+}
+
+// Колонки устройства в порядке полей deviceRow.dest
+const deviceColumns = `user_id, device_id, identity_key, signed_pre_key_id, signed_pre_key, signed_pre_key_signature, updated_at`
+
+// deviceRow — буфер для чтения строки device_keys
+type deviceRow struct {
+	device       models.DeviceKeys
+	signedPreKey models.SignedPreKey
+	signedKeyID  int64
+}
+
+func (r *deviceRow) dest() []interface{} {
+	return []interface{}{
+		&r.device.UserID,
+		&r.device.DeviceID,
+		&r.device.IdentityKey,
+		&r.signedKeyID,
+		&r.signedPreKey.PublicKey,
+		&r.signedPreKey.Signature,
+		&r.device.UpdatedAt,
+	}
+}
+
+func (r *deviceRow) keys() *models.DeviceKeys {
+	device := r.device
+	signedPreKey := r.signedPreKey
+	signedPreKey.KeyID = uint32(r.signedKeyID)
+	device.SignedPreKey = &signedPreKey
+	return &device
+}
+
+func (r *keyRepository) GetDevice(ctx context.Context, userID, deviceID gocql.UUID) (*models.DeviceKeys, error) {
+	query := `SELECT ` + deviceColumns + ` FROM device_keys WHERE user_id = ? AND device_id = ?`
+	var row deviceRow
+	if err := r.session.Query(query, userID, deviceID).WithContext(ctx).Scan(row.dest()...); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.keys(), nil
+}
+
+func (r *keyRepository) GetDevices(ctx context.Context, userID gocql.UUID) ([]*models.DeviceKeys, error) {
+	query := `SELECT ` + deviceColumns + ` FROM device_keys WHERE user_id = ?`
+	iter := r.session.Query(query, userID).WithContext(ctx).Iter()
+
+	var devices []*models.DeviceKeys
+	var row deviceRow
+	for iter.Scan(row.dest()...) {
+		devices = append(devices, row.keys())
+		row = deviceRow{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (r *keyRepository) SaveDevice(ctx context.Context, device *models.DeviceKeys, resetPreKeys bool) error {
+	query := `INSERT INTO device_keys (` + deviceColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	args := []interface{}{
+		device.UserID,
+		device.DeviceID,
+		device.IdentityKey,
+		int64(device.SignedPreKey.KeyID),
+		device.SignedPreKey.PublicKey,
+		device.SignedPreKey.Signature,
+		device.UpdatedAt,
+	}
+	if !resetPreKeys {
+		return r.session.Query(query, args...).WithContext(ctx).Exec()
+	}
+
+	// Ключи прежней установки не подходят к новому ключу идентичности
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM one_time_pre_keys WHERE user_id = ? AND device_id = ?`, device.UserID, device.DeviceID)
+	batch.Query(query, args...)
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *keyRepository) AddOneTimePreKeys(ctx context.Context, userID, deviceID gocql.UUID, keys []*models.OneTimePreKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	// Все ключи попадают в одну партицию, поэтому журнал батча не нужен
+	batch := r.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, key := range keys {
+		batch.Query(`INSERT INTO one_time_pre_keys (user_id, device_id, key_id, public_key) VALUES (?, ?, ?, ?)`,
+			userID,
+			deviceID,
+			int64(key.KeyID),
+			key.PublicKey,
+		)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *keyRepository) ConsumeOneTimePreKey(ctx context.Context, userID, deviceID gocql.UUID) (*models.OneTimePreKey, error) {
+	for attempt := 0; attempt < consumePreKeyAttempts; attempt++ {
+		var keyID int64
+		var publicKey []byte
+		err := r.session.Query(`SELECT key_id, public_key FROM one_time_pre_keys WHERE user_id = ? AND device_id = ? LIMIT 1`,
+			userID, deviceID).WithContext(ctx).Scan(&keyID, &publicKey)
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Легковесная транзакция не дает выдать один ключ двум собеседникам
+		applied, err := r.session.Query(`DELETE FROM one_time_pre_keys WHERE user_id = ? AND device_id = ? AND key_id = ? IF EXISTS`,
+			userID, deviceID, keyID).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			return &models.OneTimePreKey{KeyID: uint32(keyID), PublicKey: publicKey}, nil
+		}
+	}
+	// Набор ключей можно выдать и без одноразового ключа, протокол это допускает
+	return nil, nil
+}
+
+func (r *keyRepository) CountOneTimePreKeys(ctx context.Context, userID, deviceID gocql.UUID) (int, error) {
+	var count int
+	err := r.session.Query(`SELECT COUNT(*) FROM one_time_pre_keys WHERE user_id = ? AND device_id = ?`,
+		userID, deviceID).WithContext(ctx).Scan(&count)
+	return count, err
+}
@@ -2,17 +2,47 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"math"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
 )
 
+// MessageRepository хранит сообщения. Записи исчезающего сообщения и связанных с ним данных
+// пишутся с TTL до его истечения, иначе обновленные колонки пережили бы саму строку
 type MessageRepository interface {
-	SaveMessage(ctx context.Context, message *models.Message) error
-	GetMessages(ctx context.Context, conversationID gocql.UUID, limit int) ([]*models.Message, error)
-	UpdateMessageStatus(ctx context.Context, messageID gocql.UUID, status models.MessageStatus) error
+	// SaveMessage сохраняет сообщение вместе с событиями outbox, чтобы события не потерялись
+	SaveMessage(ctx context.Context, message *models.Message, events ...*models.OutboxEvent) error
+	// GetMessages возвращает сообщения в порядке листания: для направления Newer от старых к новым
+	GetMessages(ctx context.Context, conversationID gocql.UUID, page models.PageQuery) ([]*models.Message, error)
+	GetMessageByID(ctx context.Context, messageID gocql.UUID) (*models.Message, error)
+	UpdateMessageStatus(ctx context.Context, message *models.Message, status models.MessageStatus, event *models.OutboxEvent) error
+	// EditMessage заменяет текст и упоминания сообщения, сохраняя предыдущую версию текста в истории правок
+	EditMessage(ctx context.Context, message *models.Message, previous *models.MessageEdit, event *models.OutboxEvent) error
+	// DeleteMessage очищает текст сообщения у всех участников и удаляет историю правок
+	DeleteMessage(ctx context.Context, message *models.Message, event *models.OutboxEvent) error
+	GetMessageEdits(ctx context.Context, messageID gocql.UUID) ([]*models.MessageEdit, error)
+	HideMessage(ctx context.Context, userID gocql.UUID, message *models.Message) error
+	GetHiddenMessageIDs(ctx context.Context, userID, conversationID gocql.UUID, messageIDs []gocql.UUID) (map[gocql.UUID]bool, error)
+	// GetReplies возвращает ответы в ветке от старых к новым после курсора
+	GetReplies(ctx context.Context, conversationID, rootID, cursor gocql.UUID, limit int) ([]*models.Message, error)
+	GetReplyCounts(ctx context.Context, rootIDs []gocql.UUID) (map[gocql.UUID]int, error)
+	// CountUnread считает не удаленные сообщения других участников после отметки прочтения, но не больше limit
+	CountUnread(ctx context.Context, conversationID, userID, lastReadID gocql.UUID, limit int) (int, error)
+	// ReserveClientMessageID закрепляет идентификатор клиента за новым сообщением отправителя.
+	// Если идентификатор уже закреплен, возвращает false и сообщение, за которым он закреплен
+	ReserveClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID, ttl time.Duration) (gocql.UUID, bool, error)
+	// ConfirmClientMessageID продлевает закрепление после сохранения сообщения
+	ConfirmClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID, ttl time.Duration) error
+	// ReleaseClientMessageID снимает закрепление, если сообщение не удалось сохранить
+	ReleaseClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID) error
 }
 
+// Колонки сообщения в порядке полей messageRow.dest
+const messageColumns = `message_id, sender_id, recipient_id, conversation_id, content, status, timestamp, edited_at, deleted, reply_to_message_id, thread_root_id, attachments, expires_at, sender_device_id, encrypted_payloads, delivered_at, read_at, mentions, content_type, entities, content_attachment_id, location, contact, system_event`
+
 type messageRepository struct {
 	session *gocql.Session
 }
@@ -23,29 +53,144 @@ func NewMessageRepository(session *gocql.Session) MessageRepository {
 	}
 }
 
-func (r *messageRepository) SaveMessage(ctx context.Context, message *models.Message) error {
+// messageRow — буфер для чтения строки сообщения
+type messageRow struct {
+	msg         models.Message
+	status      string
+	contentType string
+}
+
+func (r *messageRow) dest() []interface{} {
+	return []interface{}{
+		&r.msg.MessageID,
+		&r.msg.SenderID,
+		&r.msg.RecipientID,
+		&r.msg.ConversationID,
+		&r.msg.Content,
+		&r.status,
+		&r.msg.Timestamp,
+		&r.msg.EditedAt,
+		&r.msg.Deleted,
+		&r.msg.ReplyToMessageID,
+		&r.msg.ThreadRootID,
+		&r.msg.Attachments,
+		&r.msg.ExpiresAt,
+		&r.msg.SenderDeviceID,
+		&r.msg.EncryptedPayloads,
+		&r.msg.DeliveredAt,
+		&r.msg.ReadAt,
+		&r.msg.Mentions,
+		&r.contentType,
+		&r.msg.Entities,
+		&r.msg.ContentAttachmentID,
+		&r.msg.Location,
+		&r.msg.Contact,
+		&r.msg.SystemEvent,
+	}
+}
+
+func (r *messageRow) message() *models.Message {
+	msg := r.msg
+	msg.Status = models.ParseMessageStatus(r.status)
+	msg.ContentType = models.ParseContentType(r.contentType)
+	// Время переходов пишется только один раз, поэтому по нему статус восстанавливается,
+	// даже если запоздавшая запись DELIVERED легла поверх READ
+	if !msg.ReadAt.IsZero() {
+		msg.Status = models.StatusRead
+	} else if !msg.DeliveredAt.IsZero() && msg.Status < models.StatusDelivered {
+		msg.Status = models.StatusDelivered
+	}
+	return &msg
+}
+
+func (r *messageRepository) SaveMessage(ctx context.Context, message *models.Message, events ...*models.OutboxEvent) error {
+	ttl := ttlSeconds(message)
 	query := `INSERT INTO messages (
-        message_id, sender_id, recipient_id, conversation_id, content, status, timestamp
-    ) VALUES (?, ?, ?, ?, ?, ?, ?)`
-	return r.session.Query(query,
+        message_id, sender_id, recipient_id, conversation_id, content, status, timestamp,
+        reply_to_message_id, thread_root_id, attachments, expires_at, sender_device_id, encrypted_payloads, mentions,
+        content_type, entities, content_attachment_id, location, contact, system_event
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) USING TTL ?`
+	args := []interface{}{
 		message.MessageID,
 		message.SenderID,
 		message.RecipientID,
 		message.ConversationID,
 		message.Content,
-		int32(message.Status),
+		message.Status.String(),
 		message.Timestamp,
-	).WithContext(ctx).Exec()
+		nullableUUID(message.ReplyToMessageID),
+		nullableUUID(message.ThreadRootID),
+		message.Attachments,
+		nullableTime(message.ExpiresAt),
+		nullableUUID(message.SenderDeviceID),
+		message.EncryptedPayloads,
+		message.Mentions,
+		nullableContentType(message.ContentType),
+		message.Entities,
+		nullableUUID(message.ContentAttachmentID),
+		message.Location,
+		message.Contact,
+		message.SystemEvent,
+		ttl,
+	}
+	expiringFiles := message.Expires() && len(message.Attachments) > 0
+	if !message.IsReply() && len(events) == 0 && !expiringFiles {
+		return r.session.Query(query, args...).WithContext(ctx).Exec()
+	}
+
+	// Ответ записывается вместе с индексом ветки, а события вместе с сообщением,
+	// чтобы ни ветка, ни поток событий не теряли сообщений
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(query, args...)
+	if message.IsReply() {
+		batch.Query(`INSERT INTO message_replies (root_message_id, message_id, conversation_id) VALUES (?, ?, ?) USING TTL ?`,
+			message.ThreadRootID,
+			message.MessageID,
+			message.ConversationID,
+			ttl,
+		)
+	}
+	// Файлы хранятся вне Cassandra, поэтому их удаление планируется отдельно
+	if expiringFiles {
+		for _, attachment := range message.Attachments {
+			addAttachmentExpiration(batch, &models.AttachmentExpiration{
+				Bucket:       models.ExpirationBucket(message.ExpiresAt),
+				ExpiresAt:    message.ExpiresAt,
+				AttachmentID: attachment.AttachmentID,
+			})
+		}
+	}
+	for _, event := range events {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
 }
 
-func (r *messageRepository) GetMessages(ctx context.Context, conversationID gocql.UUID, limit int) ([]*models.Message, error) {
-	query := `SELECT message_id, sender_id, recipient_id, content, status, timestamp FROM messages WHERE conversation_id = ? LIMIT ?`
-	iter := r.session.Query(query, conversationID, limit).WithContext(ctx).Iter()
+func (r *messageRepository) GetMessages(ctx context.Context, conversationID gocql.UUID, page models.PageQuery) ([]*models.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE conversation_id = ?`
+	args := []interface{}{conversationID}
+
+	// Кластерный ключ упорядочен по убыванию, для листания к новым порядок разворачивается
+	hasCursor := page.Cursor != (gocql.UUID{})
+	if page.Direction == models.PageDirectionNewer {
+		if hasCursor {
+			query += ` AND message_id > ?`
+			args = append(args, page.Cursor)
+		}
+		query += ` ORDER BY message_id ASC`
+	} else if hasCursor {
+		query += ` AND message_id < ?`
+		args = append(args, page.Cursor)
+	}
+	query += ` LIMIT ?`
+	args = append(args, page.Limit)
+
+	iter := r.session.Query(query, args...).WithContext(ctx).Iter()
 
 	var messages []*models.Message
-	var msg models.Message
-	for iter.Scan(&msg.MessageID, &msg.SenderID, &msg.RecipientID, &msg.Content, &msg.Status, &msg.Timestamp) {
-		messages = append(messages, &msg)
+	var row messageRow
+	for iter.Scan(row.dest()...) {
+		messages = append(messages, row.message())
 	}
 	if err := iter.Close(); err != nil {
 		return nil, err
@@ -53,7 +198,277 @@ func (r *messageRepository) GetMessages(ctx context.Context, conversationID gocq
 	return messages, nil
 }
 
-func (r *messageRepository) UpdateMessageStatus(ctx context.Context, messageID gocql.UUID, status models.MessageStatus) error {
-	query := `UPDATE messages SET status = ? WHERE message_id = ?`
-	return r.session.Query(query, int32(status), messageID).WithContext(ctx).Exec()
+func (r *messageRepository) GetMessageByID(ctx context.Context, messageID gocql.UUID) (*models.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE message_id = ?`
+	var row messageRow
+	if err := r.session.Query(query, messageID).WithContext(ctx).Scan(row.dest()...); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.message(), nil
+}
+
+func (r *messageRepository) UpdateMessageStatus(ctx context.Context, message *models.Message, status models.MessageStatus, event *models.OutboxEvent) error {
+	// Пустое время перехода не пишется, чтобы не стереть время, записанное параллельным запросом
+	query := `UPDATE messages USING TTL ? SET status = ?`
+	args := []interface{}{ttlSeconds(message), status.String()}
+	if !message.DeliveredAt.IsZero() {
+		query += `, delivered_at = ?`
+		args = append(args, message.DeliveredAt)
+	}
+	if !message.ReadAt.IsZero() {
+		query += `, read_at = ?`
+		args = append(args, message.ReadAt)
+	}
+	query += ` WHERE conversation_id = ? AND message_id = ?`
+	args = append(args, message.ConversationID, message.MessageID)
+	if event == nil {
+		return r.session.Query(query, args...).WithContext(ctx).Exec()
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(query, args...)
+	addOutboxEvent(batch, event)
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *messageRepository) EditMessage(ctx context.Context, message *models.Message, previous *models.MessageEdit, event *models.OutboxEvent) error {
+	// Логируемый батч, чтобы правка и история не разошлись между партициями
+	ttl := ttlSeconds(message)
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`INSERT INTO message_edits (message_id, edited_at, content) VALUES (?, ?, ?) USING TTL ?`,
+		previous.MessageID,
+		previous.EditedAt,
+		previous.Content,
+		ttl,
+	)
+	batch.Query(`UPDATE messages USING TTL ? SET content = ?, edited_at = ?, mentions = ?, entities = ? WHERE conversation_id = ? AND message_id = ?`,
+		ttl,
+		message.Content,
+		message.EditedAt,
+		message.Mentions,
+		message.Entities,
+		message.ConversationID,
+		message.MessageID,
+	)
+	if event != nil {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *messageRepository) DeleteMessage(ctx context.Context, message *models.Message, event *models.OutboxEvent) error {
+	// Строка сообщения остается, чтобы не нарушать порядок истории и курсоры клиентов
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`UPDATE messages USING TTL ? SET content = '', attachments = null, encrypted_payloads = null, mentions = null,
+        entities = null, content_attachment_id = null, location = null, contact = null, system_event = null, deleted = true WHERE conversation_id = ? AND message_id = ?`,
+		ttlSeconds(message),
+		message.ConversationID,
+		message.MessageID,
+	)
+	batch.Query(`DELETE FROM message_edits WHERE message_id = ?`, message.MessageID)
+	if event != nil {
+		addOutboxEvent(batch, event)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *messageRepository) GetMessageEdits(ctx context.Context, messageID gocql.UUID) ([]*models.MessageEdit, error) {
+	query := `SELECT edited_at, content FROM message_edits WHERE message_id = ?`
+	iter := r.session.Query(query, messageID).WithContext(ctx).Iter()
+
+	var edits []*models.MessageEdit
+	var editedAt time.Time
+	var content string
+	for iter.Scan(&editedAt, &content) {
+		edits = append(edits, &models.MessageEdit{
+			MessageID: messageID,
+			Content:   content,
+			EditedAt:  editedAt,
+		})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return edits, nil
+}
+
+func (r *messageRepository) HideMessage(ctx context.Context, userID gocql.UUID, message *models.Message) error {
+	query := `INSERT INTO hidden_messages (user_id, conversation_id, message_id) VALUES (?, ?, ?) USING TTL ?`
+	return r.session.Query(query, userID, message.ConversationID, message.MessageID, ttlSeconds(message)).WithContext(ctx).Exec()
+}
+
+func (r *messageRepository) GetHiddenMessageIDs(ctx context.Context, userID, conversationID gocql.UUID, messageIDs []gocql.UUID) (map[gocql.UUID]bool, error) {
+	hidden := make(map[gocql.UUID]bool)
+	if len(messageIDs) == 0 {
+		return hidden, nil
+	}
+
+	query := `SELECT message_id FROM hidden_messages WHERE user_id = ? AND conversation_id = ? AND message_id IN ?`
+	iter := r.session.Query(query, userID, conversationID, messageIDs).WithContext(ctx).Iter()
+
+	var messageID gocql.UUID
+	for iter.Scan(&messageID) {
+		hidden[messageID] = true
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return hidden, nil
+}
+
+func (r *messageRepository) GetReplies(ctx context.Context, conversationID, rootID, cursor gocql.UUID, limit int) ([]*models.Message, error) {
+	query := `SELECT message_id FROM message_replies WHERE root_message_id = ?`
+	args := []interface{}{rootID}
+	if cursor != (gocql.UUID{}) {
+		query += ` AND message_id > ?`
+		args = append(args, cursor)
+	}
+	query += ` LIMIT ?`
+	args = append(args, limit)
+
+	iter := r.session.Query(query, args...).WithContext(ctx).Iter()
+	var replyIDs []gocql.UUID
+	var replyID gocql.UUID
+	for iter.Scan(&replyID) {
+		replyIDs = append(replyIDs, replyID)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	if len(replyIDs) == 0 {
+		return nil, nil
+	}
+
+	// Сами сообщения читаются из партиции беседы, порядок восстанавливается по индексу ветки
+	query = `SELECT ` + messageColumns + ` FROM messages WHERE conversation_id = ? AND message_id IN ?`
+	iter = r.session.Query(query, conversationID, replyIDs).WithContext(ctx).Iter()
+
+	byID := make(map[gocql.UUID]*models.Message, len(replyIDs))
+	var row messageRow
+	for iter.Scan(row.dest()...) {
+		msg := row.message()
+		byID[msg.MessageID] = msg
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	replies := make([]*models.Message, 0, len(replyIDs))
+	for _, id := range replyIDs {
+		if msg, ok := byID[id]; ok {
+			replies = append(replies, msg)
+		}
+	}
+	return replies, nil
+}
+
+func (r *messageRepository) GetReplyCounts(ctx context.Context, rootIDs []gocql.UUID) (map[gocql.UUID]int, error) {
+	counts := make(map[gocql.UUID]int)
+	if len(rootIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `SELECT root_message_id, COUNT(*) FROM message_replies WHERE root_message_id IN ? GROUP BY root_message_id`
+	iter := r.session.Query(query, rootIDs).WithContext(ctx).Iter()
+
+	var rootID gocql.UUID
+	var count int64
+	for iter.Scan(&rootID, &count) {
+		counts[rootID] = int(count)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *messageRepository) CountUnread(ctx context.Context, conversationID, userID, lastReadID gocql.UUID, limit int) (int, error) {
+	query := `SELECT sender_id, deleted FROM messages WHERE conversation_id = ?`
+	args := []interface{}{conversationID}
+	if lastReadID != (gocql.UUID{}) {
+		query += ` AND message_id > ?`
+		args = append(args, lastReadID)
+	}
+	query += ` LIMIT ?`
+	args = append(args, limit)
+
+	iter := r.session.Query(query, args...).WithContext(ctx).Iter()
+	count := 0
+	var senderID gocql.UUID
+	var deleted bool
+	for iter.Scan(&senderID, &deleted) {
+		if senderID != userID && !deleted {
+			count++
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *messageRepository) ReserveClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID, ttl time.Duration) (gocql.UUID, bool, error) {
+	// Легковесная транзакция пропускает только первую из одновременных попыток отправки
+	query := `INSERT INTO client_message_ids (sender_id, client_message_id, message_id) VALUES (?, ?, ?) IF NOT EXISTS USING TTL ?`
+	existing := map[string]interface{}{}
+	reserved, err := r.session.Query(query,
+		senderID,
+		clientMessageID,
+		messageID,
+		int(math.Ceil(ttl.Seconds())),
+	).WithContext(ctx).MapScanCAS(existing)
+	if err != nil || reserved {
+		return messageID, reserved, err
+	}
+	originalID, _ := existing["message_id"].(gocql.UUID)
+	return originalID, false, nil
+}
+
+func (r *messageRepository) ConfirmClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID, ttl time.Duration) error {
+	query := `UPDATE client_message_ids USING TTL ? SET message_id = ? WHERE sender_id = ? AND client_message_id = ? IF message_id = ?`
+	_, err := r.session.Query(query,
+		int(math.Ceil(ttl.Seconds())),
+		messageID,
+		senderID,
+		clientMessageID,
+		messageID,
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	return err
+}
+
+func (r *messageRepository) ReleaseClientMessageID(ctx context.Context, senderID, clientMessageID, messageID gocql.UUID) error {
+	query := `DELETE FROM client_message_ids WHERE sender_id = ? AND client_message_id = ? IF message_id = ?`
+	_, err := r.session.Query(query, senderID, clientMessageID, messageID).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	return err
+}
+
+// Нулевой идентификатор записывается как null, колонка timeuuid не принимает нулевой UUID
+func nullableUUID(id gocql.UUID) interface{} {
+	if id == (gocql.UUID{}) {
+		return nil
+	}
+	return id
+}
+
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+// nullableContentType не пишет тип, если он не задан: такая строка читается как текстовая
+func nullableContentType(contentType models.ContentType) interface{} {
+	if contentType == models.ContentTypeUnspecified {
+		return nil
+	}
+	return contentType.String()
+}
+
+// ttlSeconds переводит оставшееся время жизни сообщения в TTL Cassandra, 0 — без ограничения
+func ttlSeconds(message *models.Message) int {
+	return int(math.Ceil(message.TTL(time.Now()).Seconds()))
 }
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// MessagingSettingsRepository хранит настройки личных сообщений пользователей
+type MessagingSettingsRepository interface {
+	// GetMessagingPolicy возвращает MessagingPolicyUnspecified, если пользователь не менял настройки
+	GetMessagingPolicy(ctx context.Context, userID gocql.UUID) (models.MessagingPolicy, error)
+	SetMessagingPolicy(ctx context.Context, userID gocql.UUID, policy models.MessagingPolicy) error
+}
+
+type messagingSettingsRepository struct {
+	session *gocql.Session
+}
+
+func NewMessagingSettingsRepository(session *gocql.Session) MessagingSettingsRepository {
+	return &messagingSettingsRepository{
+		session: session,
+	}
+}
+
+func (r *messagingSettingsRepository) GetMessagingPolicy(ctx context.Context, userID gocql.UUID) (models.MessagingPolicy, error) {
+	var policy int
+	err := r.session.Query(`SELECT messaging_policy FROM messaging_settings WHERE user_id = ?`, userID).
+		WithContext(ctx).Scan(&policy)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return models.MessagingPolicyUnspecified, nil
+	}
+	if err != nil {
+		return models.MessagingPolicyUnspecified, err
+	}
+	return models.MessagingPolicy(policy), nil
+}
+
+func (r *messagingSettingsRepository) SetMessagingPolicy(ctx context.Context, userID gocql.UUID, policy models.MessagingPolicy) error {
+	return r.session.Query(`UPDATE messaging_settings SET messaging_policy = ? WHERE user_id = ?`, int(policy), userID).
+		WithContext(ctx).Exec()
+}
@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Запись события в outbox, выполняется в одном батче с изменением сообщения
+const insertOutboxEventQuery = `INSERT INTO outbox_events (
+        shard, event_id, topic, event_key, event_type, event_version, payload, created_at
+    ) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+type OutboxRepository interface {
+	// GetPendingEvents возвращает неопубликованные события партиции от старых к новым
+	GetPendingEvents(ctx context.Context, shard, limit int) ([]*models.OutboxEvent, error)
+	DeleteEvent(ctx context.Context, shard int, eventID gocql.UUID) error
+}
+
+type outboxRepository struct {
+	session *gocql.Session
+}
+
+func NewOutboxRepository(session *gocql.Session) OutboxRepository {
+	return &outboxRepository{
+		session: session,
+	}
+}
+
+func (r *outboxRepository) GetPendingEvents(ctx context.Context, shard, limit int) ([]*models.OutboxEvent, error) {
+	query := `SELECT event_id, topic, event_key, event_type, event_version, payload, created_at
+        FROM outbox_events WHERE shard = ? LIMIT ?`
+	iter := r.session.Query(query, shard, limit).WithContext(ctx).Iter()
+
+	var events []*models.OutboxEvent
+	var event models.OutboxEvent
+	for iter.Scan(
+		&event.EventID,
+		&event.Topic,
+		&event.Key,
+		&event.Type,
+		&event.Version,
+		&event.Payload,
+		&event.CreatedAt,
+	) {
+		found := event
+		found.Shard = shard
+		events = append(events, &found)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *outboxRepository) DeleteEvent(ctx context.Context, shard int, eventID gocql.UUID) error {
+	query := `DELETE FROM outbox_events WHERE shard = ? AND event_id = ?`
+	return r.session.Query(query, shard, eventID).WithContext(ctx).Exec()
+}
+
+// addOutboxEvent добавляет запись события в батч изменения сообщения
+func addOutboxEvent(batch *gocql.Batch, event *models.OutboxEvent) {
+	batch.Query(insertOutboxEventQuery,
+		event.Shard,
+		event.EventID,
+		event.Topic,
+		event.Key,
+		event.Type,
+		event.Version,
+		event.Payload,
+		event.CreatedAt,
+	)
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type PinRepository interface {
+	// PinMessage закрепляет сообщение и сообщает, было ли оно закреплено впервые.
+	// Закрепление исчезающего сообщения хранится не дольше него, нулевой ttl — без ограничения
+	PinMessage(ctx context.Context, pin *models.PinnedMessage, ttl time.Duration) (bool, error)
+	// UnpinMessage открепляет сообщение и сообщает, было ли оно закреплено
+	UnpinMessage(ctx context.Context, conversationID, messageID gocql.UUID) (bool, error)
+	// GetPin возвращает закрепление сообщения или nil, если оно не закреплено
+	GetPin(ctx context.Context, conversationID, messageID gocql.UUID) (*models.PinnedMessage, error)
+	// GetPins возвращает закрепления беседы от новых сообщений к старым
+	GetPins(ctx context.Context, conversationID gocql.UUID) ([]*models.PinnedMessage, error)
+	CountPins(ctx context.Context, conversationID gocql.UUID) (int, error)
+}
+
+type pinRepository struct {
+	session *gocql.Session
+}
+
+func NewPinRepository(session *gocql.Session) PinRepository {
+	return &pinRepository{
+		session: session,
+	}
+}
+
+func (r *pinRepository) PinMessage(ctx context.Context, pin *models.PinnedMessage, ttl time.Duration) (bool, error) {
+	// Легковесная транзакция сохраняет автора и время первого закрепления
+	query := `INSERT INTO pinned_messages (conversation_id, message_id, pinned_by, pinned_at) VALUES (?, ?, ?, ?) IF NOT EXISTS USING TTL ?`
+	return r.session.Query(query,
+		pin.ConversationID,
+		pin.MessageID,
+		pin.PinnedBy,
+		pin.PinnedAt,
+		int(math.Ceil(ttl.Seconds())),
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *pinRepository) UnpinMessage(ctx context.Context, conversationID, messageID gocql.UUID) (bool, error) {
+	query := `DELETE FROM pinned_messages WHERE conversation_id = ? AND message_id = ? IF EXISTS`
+	return r.session.Query(query, conversationID, messageID).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *pinRepository) GetPin(ctx context.Context, conversationID, messageID gocql.UUID) (*models.PinnedMessage, error) {
+	pin := models.PinnedMessage{
+		ConversationID: conversationID,
+		MessageID:      messageID,
+	}
+	query := `SELECT pinned_by, pinned_at FROM pinned_messages WHERE conversation_id = ? AND message_id = ?`
+	if err := r.session.Query(query, conversationID, messageID).WithContext(ctx).Scan(&pin.PinnedBy, &pin.PinnedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &pin, nil
+}
+
+func (r *pinRepository) GetPins(ctx context.Context, conversationID gocql.UUID) ([]*models.PinnedMessage, error) {
+	query := `SELECT message_id, pinned_by, pinned_at FROM pinned_messages WHERE conversation_id = ?`
+	iter := r.session.Query(query, conversationID).WithContext(ctx).Iter()
+
+	var pins []*models.PinnedMessage
+	var pin models.PinnedMessage
+	for iter.Scan(&pin.MessageID, &pin.PinnedBy, &pin.PinnedAt) {
+		pinCopy := pin
+		pinCopy.ConversationID = conversationID
+		pins = append(pins, &pinCopy)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return pins, nil
+}
+
+func (r *pinRepository) CountPins(ctx context.Context, conversationID gocql.UUID) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM pinned_messages WHERE conversation_id = ?`
+	if err := r.session.Query(query, conversationID).WithContext(ctx).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Время последнего присутствия хранится дольше, чем живет признак присутствия в сети
+const lastSeenRetention = 30 * 24 * time.Hour
+
+// PresenceRepository хранит эфемерное присутствие пользователей в Redis.
+// Ключи живут недолго и пропадают сами, если клиент перестал присылать сигналы
+type PresenceRepository interface {
+	// SetOnline продлевает присутствие пользователя в сети и сообщает, появился ли он в сети только что
+	SetOnline(ctx context.Context, userID gocql.UUID, at time.Time, ttl time.Duration) (bool, error)
+	// SetOffline убирает пользователя из сети и сообщает, был ли он в сети
+	SetOffline(ctx context.Context, userID gocql.UUID, at time.Time) (bool, error)
+	GetPresence(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.Presence, error)
+	// SetTyping включает или выключает индикатор набора и сообщает, изменился ли он
+	SetTyping(ctx context.Context, conversationID, userID gocql.UUID, typing bool, ttl time.Duration) (bool, error)
+}
+
+type presenceRepository struct {
+	client *redis.Client
+}
+
+func NewPresenceRepository(client *redis.Client) PresenceRepository {
+	return &presenceRepository{
+		client: client,
+	}
+}
+
+func onlineKey(userID gocql.UUID) string {
+	return fmt.Sprintf("presence:online:%s", userID)
+}
+
+func lastSeenKey(userID gocql.UUID) string {
+	return fmt.Sprintf("presence:last_seen:%s", userID)
+}
+
+func typingKey(conversationID, userID gocql.UUID) string {
+	return fmt.Sprintf("presence:typing:%s:%s", conversationID, userID)
+}
+
+func (r *presenceRepository) SetOnline(ctx context.Context, userID gocql.UUID, at time.Time, ttl time.Duration) (bool, error) {
+	pipe := r.client.TxPipeline()
+	added := pipe.SetNX(ctx, onlineKey(userID), at.Unix(), ttl)
+	pipe.Expire(ctx, onlineKey(userID), ttl)
+	pipe.Set(ctx, lastSeenKey(userID), at.Unix(), lastSeenRetention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to update presence of %s: %w", userID, err)
+	}
+	return added.Val(), nil
+}
+
+func (r *presenceRepository) SetOffline(ctx context.Context, userID gocql.UUID, at time.Time) (bool, error) {
+	pipe := r.client.TxPipeline()
+	removed := pipe.Del(ctx, onlineKey(userID))
+	pipe.Set(ctx, lastSeenKey(userID), at.Unix(), lastSeenRetention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to update presence of %s: %w", userID, err)
+	}
+	return removed.Val() > 0, nil
+}
+
+func (r *presenceRepository) GetPresence(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.Presence, error) {
+	presences := make(map[gocql.UUID]*models.Presence, len(userIDs))
+	if len(userIDs) == 0 {
+		return presences, nil
+	}
+
+	onlineKeys := make([]string, len(userIDs))
+	lastSeenKeys := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		onlineKeys[i] = onlineKey(userID)
+		lastSeenKeys[i] = lastSeenKey(userID)
+	}
+	pipe := r.client.Pipeline()
+	online := pipe.MGet(ctx, onlineKeys...)
+	lastSeen := pipe.MGet(ctx, lastSeenKeys...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+
+	for i, userID := range userIDs {
+		presence := &models.Presence{
+			UserID: userID,
+			Online: online.Val()[i] != nil,
+		}
+		if value, ok := lastSeen.Val()[i].(string); ok {
+			if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+				presence.LastSeenAt = time.Unix(unix, 0)
+			}
+		}
+		presences[userID] = presence
+	}
+	return presences, nil
+}
+
+func (r *presenceRepository) SetTyping(ctx context.Context, conversationID, userID gocql.UUID, typing bool, ttl time.Duration) (bool, error) {
+	key := typingKey(conversationID, userID)
+	if !typing {
+		removed, err := r.client.Del(ctx, key).Result()
+		if err != nil {
+			return false, fmt.Errorf("failed to clear typing of %s: %w", userID, err)
+		}
+		return removed > 0, nil
+	}
+
+	// Повторный сигнал только продлевает индикатор, не рассылая его заново
+	pipe := r.client.TxPipeline()
+	added := pipe.SetNX(ctx, key, 1, ttl)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to set typing of %s: %w", userID, err)
+	}
+	return added.Val(), nil
+}
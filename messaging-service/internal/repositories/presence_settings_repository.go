@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+)
+
+// PresenceSettingsRepository хранит настройки видимости присутствия пользователей
+type PresenceSettingsRepository interface {
+	// GetHiddenLastSeen возвращает пользователей, скрывших время последнего присутствия
+	GetHiddenLastSeen(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]bool, error)
+	SetHideLastSeen(ctx context.Context, userID gocql.UUID, hide bool) error
+}
+
+type presenceSettingsRepository struct {
+	session *gocql.Session
+}
+
+func NewPresenceSettingsRepository(session *gocql.Session) PresenceSettingsRepository {
+	return &presenceSettingsRepository{
+		session: session,
+	}
+}
+
+func (r *presenceSettingsRepository) GetHiddenLastSeen(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]bool, error) {
+	hidden := make(map[gocql.UUID]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return hidden, nil
+	}
+
+	iter := r.session.Query(`SELECT user_id, hide_last_seen FROM presence_settings WHERE user_id IN ?`, userIDs).
+		WithContext(ctx).Iter()
+	var userID gocql.UUID
+	var hide bool
+	for iter.Scan(&userID, &hide) {
+		if hide {
+			hidden[userID] = true
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return hidden, nil
+}
+
+func (r *presenceSettingsRepository) SetHideLastSeen(ctx context.Context, userID gocql.UUID, hide bool) error {
+	return r.session.Query(`UPDATE presence_settings SET hide_last_seen = ? WHERE user_id = ?`, hide, userID).
+		WithContext(ctx).Exec()
+}
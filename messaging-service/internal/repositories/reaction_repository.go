@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type ReactionRepository interface {
+	// AddReaction сохраняет реакцию и сообщает, была ли она поставлена впервые.
+	// Реакция на исчезающее сообщение хранится не дольше него, нулевой ttl — без ограничения
+	AddReaction(ctx context.Context, reaction *models.Reaction, ttl time.Duration) (bool, error)
+	// RemoveReaction удаляет реакцию и сообщает, существовала ли она
+	RemoveReaction(ctx context.Context, messageID gocql.UUID, emoji string, userID gocql.UUID) (bool, error)
+	// GetReactions возвращает страницу реакций, emoji ограничивает выборку одним видом
+	GetReactions(ctx context.Context, messageID gocql.UUID, emoji string, pageState []byte, limit int) ([]*models.Reaction, []byte, error)
+	// GetSummaries возвращает сводки реакций для набора сообщений
+	GetSummaries(ctx context.Context, messageIDs []gocql.UUID, userID gocql.UUID) (map[gocql.UUID][]*models.ReactionSummary, error)
+}
+
+type reactionRepository struct {
+	session *gocql.Session
+}
+
+func NewReactionRepository(session *gocql.Session) ReactionRepository {
+	return &reactionRepository{
+		session: session,
+	}
+}
+
+func (r *reactionRepository) AddReaction(ctx context.Context, reaction *models.Reaction, ttl time.Duration) (bool, error) {
+	// Первичный ключ гарантирует одну реакцию каждого вида от пользователя,
+	// легковесная транзакция сохраняет время первой реакции
+	query := `INSERT INTO message_reactions (message_id, emoji, user_id, created_at) VALUES (?, ?, ?, ?) IF NOT EXISTS USING TTL ?`
+	return r.session.Query(query,
+		reaction.MessageID,
+		reaction.Emoji,
+		reaction.UserID,
+		reaction.CreatedAt,
+		int(math.Ceil(ttl.Seconds())),
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *reactionRepository) RemoveReaction(ctx context.Context, messageID gocql.UUID, emoji string, userID gocql.UUID) (bool, error) {
+	query := `DELETE FROM message_reactions WHERE message_id = ? AND emoji = ? AND user_id = ? IF EXISTS`
+	return r.session.Query(query, messageID, emoji, userID).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *reactionRepository) GetReactions(ctx context.Context, messageID gocql.UUID, emoji string, pageState []byte, limit int) ([]*models.Reaction, []byte, error) {
+	query := `SELECT emoji, user_id, created_at FROM message_reactions WHERE message_id = ?`
+	args := []interface{}{messageID}
+	if emoji != "" {
+		query += ` AND emoji = ?`
+		args = append(args, emoji)
+	}
+
+	iter := r.session.Query(query, args...).WithContext(ctx).PageSize(limit).PageState(pageState).Iter()
+	nextPageState := iter.PageState()
+
+	var reactions []*models.Reaction
+	var reaction models.Reaction
+	for iter.Scan(&reaction.Emoji, &reaction.UserID, &reaction.CreatedAt) {
+		reactionCopy := reaction
+		reactionCopy.MessageID = messageID
+		reactions = append(reactions, &reactionCopy)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+	return reactions, nextPageState, nil
+}
+
+func (r *reactionRepository) GetSummaries(ctx context.Context, messageIDs []gocql.UUID, userID gocql.UUID) (map[gocql.UUID][]*models.ReactionSummary, error) {
+	summaries := make(map[gocql.UUID][]*models.ReactionSummary)
+	if len(messageIDs) == 0 {
+		return summaries, nil
+	}
+
+	query := `SELECT message_id, emoji, user_id FROM message_reactions WHERE message_id IN ?`
+	iter := r.session.Query(query, messageIDs).WithContext(ctx).Iter()
+
+	byEmoji := make(map[gocql.UUID]map[string]*models.ReactionSummary)
+	var messageID, reactedBy gocql.UUID
+	var emoji string
+	for iter.Scan(&messageID, &emoji, &reactedBy) {
+		if byEmoji[messageID] == nil {
+			byEmoji[messageID] = make(map[string]*models.ReactionSummary)
+		}
+		summary, ok := byEmoji[messageID][emoji]
+		if !ok {
+			summary = &models.ReactionSummary{Emoji: emoji}
+			byEmoji[messageID][emoji] = summary
+			summaries[messageID] = append(summaries[messageID], summary)
+		}
+		summary.Count++
+		if reactedBy == userID {
+			summary.ReactedByMe = true
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	// Популярные реакции показываются первыми
+	for _, list := range summaries {
+		sort.SliceStable(list, func(i, j int) bool {
+			if list[i].Count != list[j].Count {
+				return list[i].Count > list[j].Count
+			}
+			return list[i].Emoji < list[j].Emoji
+		})
+	}
+	return summaries, nil
+}
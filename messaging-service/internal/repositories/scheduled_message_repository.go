@@ -0,0 +1,248 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// ScheduledMessageRepository хранит запланированные сообщения и очередь их отправки.
+// Строка scheduled_messages — источник истины: запись очереди, не совпадающая с ней по времени, устарела
+type ScheduledMessageRepository interface {
+	SaveScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error
+	GetScheduledMessage(ctx context.Context, senderID, scheduledID gocql.UUID) (*models.ScheduledMessage, error)
+	GetScheduledMessages(ctx context.Context, senderID gocql.UUID) ([]*models.ScheduledMessage, error)
+	// GetDueEntries возвращает записи очереди из часа bucket, срок отправки которых наступил к моменту before
+	GetDueEntries(ctx context.Context, bucket, before time.Time) ([]*models.ScheduledEntry, error)
+	DeleteEntry(ctx context.Context, entry *models.ScheduledEntry) error
+	// ClaimScheduledMessage захватывает отправку легковесной транзакцией, чтобы сообщение отправила
+	// только одна реплика. Ожидающее сообщение захватывается, если не было перенесено, зависшая
+	// отправка — если ее никто не захватил повторно
+	ClaimScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, claimedAt time.Time) (bool, error)
+	// CancelScheduledMessage удаляет сообщение, если оно еще не отправляется
+	CancelScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) (bool, error)
+	// RescheduleMessage переносит отправку, если сообщение еще не отправляется. Неотправленное
+	// сообщение снова становится ожидающим
+	RescheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, sendAt time.Time) (bool, error)
+	// CompleteScheduledMessage удаляет отправленное сообщение вместе с записью очереди
+	CompleteScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error
+	// FailScheduledMessage сохраняет причину неудачной отправки и убирает сообщение из очереди
+	FailScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, reason string) error
+}
+
+// Колонки запланированного сообщения в порядке полей scheduledRow.dest
+const scheduledColumns = `sender_id, scheduled_id, conversation_id, recipient_id, content, reply_to_message_id, attachment_ids, send_at, state, message_id, claimed_at, failure_reason, entities`
+
+type scheduledMessageRepository struct {
+	session *gocql.Session
+}
+
+func NewScheduledMessageRepository(session *gocql.Session) ScheduledMessageRepository {
+	return &scheduledMessageRepository{
+		session: session,
+	}
+}
+
+// scheduledRow — буфер для чтения строки запланированного сообщения
+type scheduledRow struct {
+	scheduled models.ScheduledMessage
+	state     string
+}
+
+func (r *scheduledRow) dest() []interface{} {
+	return []interface{}{
+		&r.scheduled.SenderID,
+		&r.scheduled.ScheduledID,
+		&r.scheduled.ConversationID,
+		&r.scheduled.RecipientID,
+		&r.scheduled.Content,
+		&r.scheduled.ReplyToMessageID,
+		&r.scheduled.AttachmentIDs,
+		&r.scheduled.SendAt,
+		&r.state,
+		&r.scheduled.MessageID,
+		&r.scheduled.ClaimedAt,
+		&r.scheduled.FailureReason,
+		&r.scheduled.Entities,
+	}
+}
+
+func (r *scheduledRow) message() *models.ScheduledMessage {
+	scheduled := r.scheduled
+	scheduled.State = models.ParseScheduledMessageState(r.state)
+	return &scheduled
+}
+
+func (r *scheduledMessageRepository) SaveScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error {
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`INSERT INTO scheduled_messages (
+            sender_id, scheduled_id, conversation_id, recipient_id, content, reply_to_message_id, attachment_ids, send_at, state, entities
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		scheduled.SenderID,
+		scheduled.ScheduledID,
+		scheduled.ConversationID,
+		scheduled.RecipientID,
+		scheduled.Content,
+		scheduled.ReplyToMessageID,
+		scheduled.AttachmentIDs,
+		scheduled.SendAt,
+		scheduled.State.String(),
+		scheduled.Entities,
+	)
+	addScheduledEntry(batch, scheduled.SenderID, scheduled.ScheduledID, scheduled.SendAt)
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *scheduledMessageRepository) GetScheduledMessage(ctx context.Context, senderID, scheduledID gocql.UUID) (*models.ScheduledMessage, error) {
+	var row scheduledRow
+	query := `SELECT ` + scheduledColumns + ` FROM scheduled_messages WHERE sender_id = ? AND scheduled_id = ?`
+	if err := r.session.Query(query, senderID, scheduledID).WithContext(ctx).Scan(row.dest()...); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return row.message(), nil
+}
+
+func (r *scheduledMessageRepository) GetScheduledMessages(ctx context.Context, senderID gocql.UUID) ([]*models.ScheduledMessage, error) {
+	query := `SELECT ` + scheduledColumns + ` FROM scheduled_messages WHERE sender_id = ?`
+	iter := r.session.Query(query, senderID).WithContext(ctx).Iter()
+
+	var scheduled []*models.ScheduledMessage
+	var row scheduledRow
+	for iter.Scan(row.dest()...) {
+		scheduled = append(scheduled, row.message())
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+func (r *scheduledMessageRepository) GetDueEntries(ctx context.Context, bucket, before time.Time) ([]*models.ScheduledEntry, error) {
+	query := `SELECT send_at, scheduled_id, sender_id FROM scheduled_message_queue WHERE bucket = ? AND send_at <= ?`
+	iter := r.session.Query(query, bucket, before).WithContext(ctx).Iter()
+
+	var entries []*models.ScheduledEntry
+	var sendAt time.Time
+	var scheduledID, senderID gocql.UUID
+	for iter.Scan(&sendAt, &scheduledID, &senderID) {
+		entries = append(entries, &models.ScheduledEntry{
+			Bucket:      bucket,
+			SendAt:      sendAt,
+			ScheduledID: scheduledID,
+			SenderID:    senderID,
+		})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *scheduledMessageRepository) DeleteEntry(ctx context.Context, entry *models.ScheduledEntry) error {
+	query := `DELETE FROM scheduled_message_queue WHERE bucket = ? AND send_at = ? AND scheduled_id = ?`
+	return r.session.Query(query, entry.Bucket, entry.SendAt, entry.ScheduledID).WithContext(ctx).Exec()
+}
+
+func (r *scheduledMessageRepository) ClaimScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, claimedAt time.Time) (bool, error) {
+	query := `UPDATE scheduled_messages SET state = ?, claimed_at = ?, message_id = ?
+        WHERE sender_id = ? AND scheduled_id = ?`
+	args := []interface{}{
+		models.ScheduledSending.String(),
+		claimedAt,
+		scheduled.MessageID,
+		scheduled.SenderID,
+		scheduled.ScheduledID,
+	}
+	if scheduled.State == models.ScheduledSending {
+		query += ` IF state = ? AND claimed_at = ?`
+		args = append(args, models.ScheduledSending.String(), scheduled.ClaimedAt)
+	} else {
+		query += ` IF state = ? AND send_at = ?`
+		args = append(args, models.ScheduledPending.String(), scheduled.SendAt)
+	}
+	return r.session.Query(query, args...).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+}
+
+func (r *scheduledMessageRepository) CancelScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) (bool, error) {
+	query := `DELETE FROM scheduled_messages WHERE sender_id = ? AND scheduled_id = ? IF state IN (?, ?)`
+	cancelled, err := r.session.Query(query,
+		scheduled.SenderID,
+		scheduled.ScheduledID,
+		models.ScheduledPending.String(),
+		models.ScheduledFailed.String(),
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil || !cancelled {
+		return false, err
+	}
+
+	// Легковесная транзакция не объединяется в батч с другой партицией. Оставшуюся запись
+	// очереди планировщик удалит сам, не найдя для нее сообщения
+	return true, r.DeleteEntry(ctx, scheduledEntry(scheduled))
+}
+
+func (r *scheduledMessageRepository) RescheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, sendAt time.Time) (bool, error) {
+	query := `UPDATE scheduled_messages SET send_at = ?, state = ?, message_id = null, claimed_at = null, failure_reason = null
+        WHERE sender_id = ? AND scheduled_id = ? IF state IN (?, ?)`
+	rescheduled, err := r.session.Query(query,
+		sendAt,
+		models.ScheduledPending.String(),
+		scheduled.SenderID,
+		scheduled.ScheduledID,
+		models.ScheduledPending.String(),
+		models.ScheduledFailed.String(),
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil || !rescheduled {
+		return false, err
+	}
+
+	// Старая запись очереди устареет и без удаления: время в ней больше не совпадает с сообщением
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	addScheduledEntry(batch, scheduled.SenderID, scheduled.ScheduledID, sendAt)
+	old := scheduledEntry(scheduled)
+	batch.Query(`DELETE FROM scheduled_message_queue WHERE bucket = ? AND send_at = ? AND scheduled_id = ?`,
+		old.Bucket, old.SendAt, old.ScheduledID)
+	return true, r.session.ExecuteBatch(batch)
+}
+
+func (r *scheduledMessageRepository) CompleteScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error {
+	entry := scheduledEntry(scheduled)
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM scheduled_messages WHERE sender_id = ? AND scheduled_id = ?`, scheduled.SenderID, scheduled.ScheduledID)
+	batch.Query(`DELETE FROM scheduled_message_queue WHERE bucket = ? AND send_at = ? AND scheduled_id = ?`,
+		entry.Bucket, entry.SendAt, entry.ScheduledID)
+	return r.session.ExecuteBatch(batch)
+}
+
+func (r *scheduledMessageRepository) FailScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, reason string) error {
+	entry := scheduledEntry(scheduled)
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`UPDATE scheduled_messages SET state = ?, failure_reason = ? WHERE sender_id = ? AND scheduled_id = ?`,
+		models.ScheduledFailed.String(), reason, scheduled.SenderID, scheduled.ScheduledID)
+	batch.Query(`DELETE FROM scheduled_message_queue WHERE bucket = ? AND send_at = ? AND scheduled_id = ?`,
+		entry.Bucket, entry.SendAt, entry.ScheduledID)
+	return r.session.ExecuteBatch(batch)
+}
+
+func scheduledEntry(scheduled *models.ScheduledMessage) *models.ScheduledEntry {
+	return &models.ScheduledEntry{
+		Bucket:      models.ScheduleBucket(scheduled.SendAt),
+		SendAt:      scheduled.SendAt,
+		ScheduledID: scheduled.ScheduledID,
+		SenderID:    scheduled.SenderID,
+	}
+}
+
+func addScheduledEntry(batch *gocql.Batch, senderID, scheduledID gocql.UUID, sendAt time.Time) {
+	batch.Query(`INSERT INTO scheduled_message_queue (bucket, send_at, scheduled_id, sender_id) VALUES (?, ?, ?, ?)`,
+		models.ScheduleBucket(sendAt),
+		sendAt,
+		scheduledID,
+		senderID,
+	)
+}
@@ -0,0 +1,224 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Число попыток занять следующий номер журнала при одновременной записи изменений пользователю
+const maxSyncSeqAttempts = 10
+
+type SyncLogRepository interface {
+	// Append записывает изменение в журнал пользователя под следующим номером и возвращает этот номер.
+	// Запись хранится retention, более старые изменения из журнала удаляются.
+	// Повторная запись изменения из того же события не дублирует его и возвращает прежний номер
+	Append(ctx context.Context, change *models.SyncChange, retention time.Duration) (int64, error)
+	// GetChanges возвращает до limit изменений пользователя по возрастанию номера, начиная с fromSeq включительно
+	GetChanges(ctx context.Context, userID gocql.UUID, fromSeq int64, limit int) ([]*models.SyncChange, error)
+	// GetLatestSeq возвращает номер последнего изменения пользователя, 0 — изменений не было
+	GetLatestSeq(ctx context.Context, userID gocql.UUID) (int64, error)
+}
+
+type syncLogRepository struct {
+	session *gocql.Session
+}
+
+func NewSyncLogRepository(session *gocql.Session) SyncLogRepository {
+	return &syncLogRepository{
+		session: session,
+	}
+}
+
+// Append занимает номер и записывает изменение условным батчем в партиции пользователя:
+// номер хранится статической колонкой, поэтому в журнале не бывает пропусков.
+// Перед записью номер закрепляется за событием в user_sync_events, поэтому повторная доставка
+// события не дублирует изменение, даже если предыдущая попытка прервалась после записи в журнал
+func (r *syncLogRepository) Append(ctx context.Context, change *models.SyncChange, retention time.Duration) (int64, error) {
+	ttl := int(math.Ceil(retention.Seconds()))
+	current, err := r.GetLatestSeq(ctx, change.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	var reserved int64
+	if change.EventID != (gocql.UUID{}) {
+		reserved, err = r.reserveSeq(ctx, change, current+1, ttl)
+		if err != nil {
+			return 0, err
+		}
+		if reserved != current+1 {
+			recorded, err := r.hasChange(ctx, change, reserved)
+			if err != nil {
+				return 0, err
+			}
+			if recorded {
+				change.Seq = reserved
+				return reserved, nil
+			}
+		}
+	}
+
+	for attempt := 0; attempt < maxSyncSeqAttempts; attempt++ {
+		seq := current + 1
+		if reserved != 0 && reserved != seq {
+			if err := r.moveReservedSeq(ctx, change, reserved, seq, ttl); err != nil {
+				return 0, err
+			}
+			reserved = seq
+		}
+
+		batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+		batch.Query(`UPDATE user_sync_log SET last_seq = ? WHERE user_id = ? IF last_seq = ?`,
+			seq,
+			change.UserID,
+			nullableSeq(current),
+		)
+		batch.Query(`INSERT INTO user_sync_log (
+            user_id, seq, change_type, conversation_id, message_id, member_id, status, role, event_id, created_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) USING TTL ?`,
+			change.UserID,
+			seq,
+			change.Type.String(),
+			change.ConversationID,
+			nullableUUID(change.MessageID),
+			nullableUUID(change.MemberID),
+			nullableStatus(change.Status),
+			nullableRole(change.Role),
+			nullableUUID(change.EventID),
+			change.CreatedAt,
+			ttl,
+		)
+
+		previous := map[string]interface{}{}
+		applied, iter, err := r.session.MapExecuteBatchCAS(batch, previous)
+		if err != nil {
+			return 0, err
+		}
+		if err := iter.Close(); err != nil {
+			return 0, err
+		}
+		if applied {
+			change.Seq = seq
+			return seq, nil
+		}
+		// Неудачная транзакция возвращает номер, занятый параллельной записью
+		current, _ = previous["last_seq"].(int64)
+	}
+	return 0, fmt.Errorf("too many concurrent changes to sync log of %s", change.UserID)
+}
+
+// reserveSeq закрепляет номер за изменением из события и возвращает закрепленный номер.
+// Если изменение уже записывалось, возвращается номер, занятый прошлой попыткой
+func (r *syncLogRepository) reserveSeq(ctx context.Context, change *models.SyncChange, seq int64, ttl int) (int64, error) {
+	query := `INSERT INTO user_sync_events (user_id, event_id, member_id, seq) VALUES (?, ?, ?, ?) IF NOT EXISTS USING TTL ?`
+	previous := map[string]interface{}{}
+	applied, err := r.session.Query(query, change.UserID, change.EventID, change.MemberID, seq, ttl).
+		WithContext(ctx).MapScanCAS(previous)
+	if err != nil {
+		return 0, err
+	}
+	if applied {
+		return seq, nil
+	}
+	reserved, _ := previous["seq"].(int64)
+	return reserved, nil
+}
+
+// moveReservedSeq переносит закрепление на новый номер, если прошлый занят другим изменением
+func (r *syncLogRepository) moveReservedSeq(ctx context.Context, change *models.SyncChange, from, to int64, ttl int) error {
+	query := `UPDATE user_sync_events USING TTL ? SET seq = ? WHERE user_id = ? AND event_id = ? AND member_id = ? IF seq = ?`
+	applied, err := r.session.Query(query, ttl, to, change.UserID, change.EventID, change.MemberID, from).
+		WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return fmt.Errorf("sync change of event %s is being recorded concurrently", change.EventID)
+	}
+	return nil
+}
+
+// hasChange сообщает, записано ли изменение из того же события под номером seq
+func (r *syncLogRepository) hasChange(ctx context.Context, change *models.SyncChange, seq int64) (bool, error) {
+	var eventID, memberID gocql.UUID
+	err := r.session.Query(`SELECT event_id, member_id FROM user_sync_log WHERE user_id = ? AND seq = ?`, change.UserID, seq).
+		WithContext(ctx).Scan(&eventID, &memberID)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return eventID == change.EventID && memberID == change.MemberID, nil
+}
+
+func (r *syncLogRepository) GetChanges(ctx context.Context, userID gocql.UUID, fromSeq int64, limit int) ([]*models.SyncChange, error) {
+	query := `SELECT seq, change_type, conversation_id, message_id, member_id, status, role, event_id, created_at
+        FROM user_sync_log WHERE user_id = ? AND seq >= ? LIMIT ?`
+	iter := r.session.Query(query, userID, fromSeq, limit).WithContext(ctx).Iter()
+
+	var changes []*models.SyncChange
+	var (
+		change                   models.SyncChange
+		changeType, status, role string
+	)
+	for iter.Scan(
+		&change.Seq,
+		&changeType,
+		&change.ConversationID,
+		&change.MessageID,
+		&change.MemberID,
+		&status,
+		&role,
+		&change.EventID,
+		&change.CreatedAt,
+	) {
+		changeCopy := change
+		changeCopy.UserID = userID
+		changeCopy.Type = models.ParseSyncChangeType(changeType)
+		changeCopy.Status = models.ParseMessageStatus(status)
+		changeCopy.Role = models.ParseMemberRole(role)
+		changes = append(changes, &changeCopy)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (r *syncLogRepository) GetLatestSeq(ctx context.Context, userID gocql.UUID) (int64, error) {
+	var seq int64
+	err := r.session.Query(`SELECT last_seq FROM user_sync_log WHERE user_id = ? LIMIT 1`, userID).
+		WithContext(ctx).Scan(&seq)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return 0, err
+	}
+	return seq, nil
+}
+
+func nullableSeq(seq int64) interface{} {
+	if seq == 0 {
+		return nil
+	}
+	return seq
+}
+
+func nullableStatus(status models.MessageStatus) interface{} {
+	if status == models.StatusUnspecified {
+		return nil
+	}
+	return status.String()
+}
+
+func nullableRole(role models.MemberRole) interface{} {
+	if role == models.RoleUnspecified {
+		return nil
+	}
+	return role.String()
+}
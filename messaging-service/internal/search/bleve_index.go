@@ -0,0 +1,248 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Поля документа сообщения в индексе
+const (
+	fieldConversationID = "conversation_id"
+	fieldSenderID       = "sender_id"
+	fieldContent        = "content"
+	fieldSentAt         = "sent_at"
+	fieldExpiresAt      = "expires_at"
+)
+
+// Сколько исчезнувших сообщений удаляется из индекса за один пакет
+const expiredBatchSize = 500
+
+// messageDocument — представление сообщения в индексе bleve, идентификатор документа — message_id
+type messageDocument struct {
+	ConversationID string    `json:"conversation_id"`
+	SenderID       string    `json:"sender_id"`
+	Content        string    `json:"content"`
+	SentAt         time.Time `json:"sent_at"`
+	// ExpiresAt не индексируется у сообщений без срока жизни
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type bleveIndex struct {
+	index bleve.Index
+}
+
+// NewBleveIndex открывает встроенный индекс в каталоге или создает его, если каталога нет.
+// Пустой путь создает индекс в памяти
+func NewBleveIndex(path string) (Index, error) {
+	if path == "" {
+		index, err := bleve.NewMemOnly(newIndexMapping())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create search index: %w", err)
+		}
+		return &bleveIndex{index: index}, nil
+	}
+
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.New(path, newIndexMapping())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open search index %s: %w", path, err)
+	}
+	return &bleveIndex{index: index}, nil
+}
+
+// newIndexMapping индексирует только известные поля: текст разбивается на слова,
+// идентификаторы хранятся целиком для точных фильтров
+func newIndexMapping() mapping.IndexMapping {
+	content := bleve.NewTextFieldMapping()
+	content.Analyzer = standard.Name
+	content.Store = true
+	content.IncludeTermVectors = true
+
+	identifier := bleve.NewTextFieldMapping()
+	identifier.Analyzer = keyword.Name
+	identifier.Store = true
+	identifier.IncludeInAll = false
+
+	sentAt := bleve.NewDateTimeFieldMapping()
+	sentAt.IncludeInAll = false
+
+	document := bleve.NewDocumentStaticMapping()
+	document.AddFieldMappingsAt(fieldContent, content)
+	document.AddFieldMappingsAt(fieldConversationID, identifier)
+	document.AddFieldMappingsAt(fieldSenderID, identifier)
+	document.AddFieldMappingsAt(fieldSentAt, sentAt)
+	document.AddFieldMappingsAt(fieldExpiresAt, sentAt)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = document
+	indexMapping.DefaultAnalyzer = standard.Name
+	return indexMapping
+}
+
+func (i *bleveIndex) Index(ctx context.Context, document *models.SearchDocument) error {
+	doc := &messageDocument{
+		ConversationID: document.ConversationID.String(),
+		SenderID:       document.SenderID.String(),
+		Content:        document.Content,
+		SentAt:         document.SentAt.UTC(),
+	}
+	if !document.ExpiresAt.IsZero() {
+		expiresAt := document.ExpiresAt.UTC()
+		doc.ExpiresAt = &expiresAt
+	}
+	err := i.index.Index(document.MessageID.String(), doc)
+	if err != nil {
+		return fmt.Errorf("failed to index message %s: %w", document.MessageID, err)
+	}
+	return nil
+}
+
+func (i *bleveIndex) Delete(ctx context.Context, messageID gocql.UUID) error {
+	if err := i.index.Delete(messageID.String()); err != nil {
+		return fmt.Errorf("failed to delete message %s from index: %w", messageID, err)
+	}
+	return nil
+}
+
+func (i *bleveIndex) Search(ctx context.Context, q *models.SearchQuery) (*models.SearchResult, error) {
+	if len(q.ConversationIDs) == 0 {
+		return &models.SearchResult{}, nil
+	}
+
+	request := bleve.NewSearchRequestOptions(buildQuery(q), q.Limit, q.Offset, false)
+	request.SortBy([]string{"-" + fieldSentAt, "-_id"})
+	request.Fields = []string{fieldConversationID}
+	request.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	request.Highlight.AddField(fieldContent)
+
+	response, err := i.index.SearchInContext(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	result := &models.SearchResult{
+		Hits:  make([]*models.SearchHit, 0, len(response.Hits)),
+		Total: int(response.Total),
+	}
+	for _, match := range response.Hits {
+		messageID, err := gocql.ParseUUID(match.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message id %q in index: %w", match.ID, err)
+		}
+		conversationID, _ := match.Fields[fieldConversationID].(string)
+		hit := &models.SearchHit{
+			MessageID:  messageID,
+			Highlights: match.Fragments[fieldContent],
+		}
+		hit.ConversationID, err = gocql.ParseUUID(conversationID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conversation id %q in index: %w", conversationID, err)
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+	return result, nil
+}
+
+// DeleteExpired удаляет документы пакетами, пока запрос находит исчезнувшие сообщения
+func (i *bleveIndex) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	inclusiveStart, inclusiveEnd := true, true
+	expired := bleve.NewDateRangeInclusiveQuery(time.Time{}, before.UTC(), &inclusiveStart, &inclusiveEnd)
+	expired.SetField(fieldExpiresAt)
+
+	deleted := 0
+	for {
+		response, err := i.index.SearchInContext(ctx, bleve.NewSearchRequestOptions(expired, expiredBatchSize, 0, false))
+		if err != nil {
+			return deleted, fmt.Errorf("failed to find expired messages: %w", err)
+		}
+		if len(response.Hits) == 0 {
+			return deleted, nil
+		}
+		batch := i.index.NewBatch()
+		for _, match := range response.Hits {
+			batch.Delete(match.ID)
+		}
+		if err := i.index.Batch(batch); err != nil {
+			return deleted, fmt.Errorf("failed to delete expired messages from index: %w", err)
+		}
+		deleted += len(response.Hits)
+	}
+}
+
+func (i *bleveIndex) Close() error {
+	return i.index.Close()
+}
+
+// buildQuery требует совпадения всех слов и фраз запроса и накладывает фильтры
+func buildQuery(q *models.SearchQuery) query.Query {
+	words, phrases := parseText(q.Text)
+	conjuncts := make([]query.Query, 0, len(phrases)+4)
+	if len(words) > 0 {
+		match := bleve.NewMatchQuery(strings.Join(words, " "))
+		match.SetField(fieldContent)
+		match.SetOperator(query.MatchQueryOperatorAnd)
+		conjuncts = append(conjuncts, match)
+	}
+	for _, phrase := range phrases {
+		match := bleve.NewMatchPhraseQuery(phrase)
+		match.SetField(fieldContent)
+		conjuncts = append(conjuncts, match)
+	}
+
+	conversations := make([]query.Query, len(q.ConversationIDs))
+	for i, conversationID := range q.ConversationIDs {
+		term := bleve.NewTermQuery(conversationID.String())
+		term.SetField(fieldConversationID)
+		conversations[i] = term
+	}
+	conjuncts = append(conjuncts, bleve.NewDisjunctionQuery(conversations...))
+
+	if q.SenderID != (gocql.UUID{}) {
+		sender := bleve.NewTermQuery(q.SenderID.String())
+		sender.SetField(fieldSenderID)
+		conjuncts = append(conjuncts, sender)
+	}
+	if !q.From.IsZero() || !q.To.IsZero() {
+		inclusiveStart, inclusiveEnd := true, false
+		sentAt := bleve.NewDateRangeInclusiveQuery(q.From.UTC(), q.To.UTC(), &inclusiveStart, &inclusiveEnd)
+		sentAt.SetField(fieldSentAt)
+		conjuncts = append(conjuncts, sentAt)
+	}
+	return bleve.NewConjunctionQuery(conjuncts...)
+}
+
+// parseText делит запрос на отдельные слова и фразы в двойных кавычках.
+// Незакрытая кавычка считается обычным символом
+func parseText(text string) (words, phrases []string) {
+	for {
+		start := strings.IndexByte(text, '"')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start+1:], '"')
+		if end < 0 {
+			break
+		}
+		words = append(words, strings.Fields(text[:start])...)
+		if phrase := strings.TrimSpace(text[start+1 : start+1+end]); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+		text = text[start+end+2:]
+	}
+	words = append(words, strings.Fields(text)...)
+	return words, phrases
+}
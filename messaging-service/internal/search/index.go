@@ -0,0 +1,21 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Index — полнотекстовый индекс сообщений. Индекс строится из потока событий сообщений,
+// поэтому может немного отставать от Cassandra и не является источником истины
+type Index interface {
+	// Index добавляет сообщение в индекс или заменяет его прежнюю версию
+	Index(ctx context.Context, document *models.SearchDocument) error
+	Delete(ctx context.Context, messageID gocql.UUID) error
+	Search(ctx context.Context, query *models.SearchQuery) (*models.SearchResult, error)
+	// DeleteExpired удаляет исчезнувшие к моменту before сообщения и возвращает их количество
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+	Close() error
+}
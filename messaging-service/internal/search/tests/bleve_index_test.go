@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// indexFixture — индекс в памяти с тремя сообщениями в двух беседах
+type indexFixture struct {
+	index    search.Index
+	first    gocql.UUID
+	second   gocql.UUID
+	alice    gocql.UUID
+	docs     []*models.SearchDocument
+	baseTime time.Time
+}
+
+func newIndexFixture(t *testing.T) *indexFixture {
+	index, err := search.NewBleveIndex("")
+	require.NoError(t, err)
+	t.Cleanup(func() { index.Close() })
+
+	f := &indexFixture{
+		index:    index,
+		first:    gocql.TimeUUID(),
+		second:   gocql.TimeUUID(),
+		alice:    gocql.TimeUUID(),
+		baseTime: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.docs = []*models.SearchDocument{
+		{ConversationID: f.first, SenderID: f.alice, Content: "Release notes are ready", SentAt: f.baseTime},
+		{ConversationID: f.first, SenderID: gocql.TimeUUID(), Content: "notes about the release <draft>", SentAt: f.baseTime.Add(time.Hour)},
+		{ConversationID: f.second, SenderID: f.alice, Content: "Релиз завтра, notes later", SentAt: f.baseTime.Add(2 * time.Hour)},
+	}
+	for _, doc := range f.docs {
+		doc.MessageID = gocql.TimeUUID()
+		require.NoError(t, index.Index(context.Background(), doc))
+	}
+	return f
+}
+
+func (f *indexFixture) search(t *testing.T, q models.SearchQuery) *models.SearchResult {
+	if q.ConversationIDs == nil {
+		q.ConversationIDs = []gocql.UUID{f.first, f.second}
+	}
+	if q.Limit == 0 {
+		q.Limit = 10
+	}
+	result, err := f.index.Search(context.Background(), &q)
+	require.NoError(t, err)
+	return result
+}
+
+func hitIDs(result *models.SearchResult) []gocql.UUID {
+	ids := make([]gocql.UUID, len(result.Hits))
+	for i, hit := range result.Hits {
+		ids[i] = hit.MessageID
+	}
+	return ids
+}
+
+func TestBleveIndexSearchWordsNewestFirst(t *testing.T) {
+	f := newIndexFixture(t)
+
+	result := f.search(t, models.SearchQuery{Text: "NOTES release"})
+
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, []gocql.UUID{f.docs[1].MessageID, f.docs[0].MessageID}, hitIDs(result))
+	assert.Equal(t, f.first, result.Hits[0].ConversationID)
+	// Совпадения выделяются, а разметка в тексте экранируется
+	require.Len(t, result.Hits[0].Highlights, 1)
+	assert.Equal(t, "<mark>notes</mark> about the <mark>release</mark> &lt;draft&gt;", result.Hits[0].Highlights[0])
+}
+
+func TestBleveIndexSearchPhrase(t *testing.T) {
+	f := newIndexFixture(t)
+
+	result := f.search(t, models.SearchQuery{Text: `"release notes"`})
+
+	assert.Equal(t, []gocql.UUID{f.docs[0].MessageID}, hitIDs(result))
+}
+
+func TestBleveIndexSearchFilters(t *testing.T) {
+	f := newIndexFixture(t)
+
+	bySender := f.search(t, models.SearchQuery{Text: "notes", SenderID: f.alice})
+	assert.Equal(t, []gocql.UUID{f.docs[2].MessageID, f.docs[0].MessageID}, hitIDs(bySender))
+
+	byConversation := f.search(t, models.SearchQuery{Text: "notes", ConversationIDs: []gocql.UUID{f.second}})
+	assert.Equal(t, []gocql.UUID{f.docs[2].MessageID}, hitIDs(byConversation))
+
+	// Начало интервала включается, конец — нет
+	byDate := f.search(t, models.SearchQuery{Text: "notes", From: f.baseTime.Add(time.Hour), To: f.baseTime.Add(2 * time.Hour)})
+	assert.Equal(t, []gocql.UUID{f.docs[1].MessageID}, hitIDs(byDate))
+
+	cyrillic := f.search(t, models.SearchQuery{Text: "релиз"})
+	assert.Equal(t, []gocql.UUID{f.docs[2].MessageID}, hitIDs(cyrillic))
+}
+
+func TestBleveIndexSearchPaging(t *testing.T) {
+	f := newIndexFixture(t)
+
+	result := f.search(t, models.SearchQuery{Text: "notes", Offset: 1, Limit: 1})
+
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, []gocql.UUID{f.docs[1].MessageID}, hitIDs(result))
+}
+
+func TestBleveIndexIndexReplacesAndDeletes(t *testing.T) {
+	f := newIndexFixture(t)
+	ctx := context.Background()
+
+	edited := *f.docs[0]
+	edited.Content = "changed text"
+	require.NoError(t, f.index.Index(ctx, &edited))
+	require.NoError(t, f.index.Delete(ctx, f.docs[1].MessageID))
+
+	assert.Equal(t, []gocql.UUID{f.docs[2].MessageID}, hitIDs(f.search(t, models.SearchQuery{Text: "notes"})))
+	assert.Equal(t, []gocql.UUID{f.docs[0].MessageID}, hitIDs(f.search(t, models.SearchQuery{Text: "changed"})))
+	// Без бесед пользователя искать негде
+	assert.Empty(t, f.search(t, models.SearchQuery{Text: "notes", ConversationIDs: []gocql.UUID{}}).Hits)
+}
+
+func TestBleveIndexDeleteExpired(t *testing.T) {
+	f := newIndexFixture(t)
+	ctx := context.Background()
+
+	expiring := *f.docs[0]
+	expiring.ExpiresAt = f.baseTime.Add(time.Hour)
+	require.NoError(t, f.index.Index(ctx, &expiring))
+	later := *f.docs[1]
+	later.ExpiresAt = f.baseTime.Add(24 * time.Hour)
+	require.NoError(t, f.index.Index(ctx, &later))
+
+	deleted, err := f.index.DeleteExpired(ctx, f.baseTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	// Сообщения без срока жизни и с более поздним сроком остаются в индексе
+	assert.Equal(t, []gocql.UUID{f.docs[2].MessageID, f.docs[1].MessageID}, hitIDs(f.search(t, models.SearchQuery{Text: "notes"})))
+}
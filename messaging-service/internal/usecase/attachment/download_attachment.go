@@ -0,0 +1,58 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+)
+
+type DownloadAttachmentUsecase interface {
+	Execute(ctx context.Context, attachmentID gocql.UUID, token string) (*models.Attachment, io.ReadCloser, error)
+}
+
+type downloadAttachmentUsecase struct {
+	attachmentRepo repositories.AttachmentRepository
+	blobStore      storage.BlobStore
+	signer         *URLSigner
+}
+
+func NewDownloadAttachmentUsecase(
+	attachmentRepo repositories.AttachmentRepository,
+	blobStore storage.BlobStore,
+	signer *URLSigner,
+) DownloadAttachmentUsecase {
+	return &downloadAttachmentUsecase{
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		signer:         signer,
+	}
+}
+
+func (uc *downloadAttachmentUsecase) Execute(ctx context.Context, attachmentID gocql.UUID, token string) (*models.Attachment, io.ReadCloser, error) {
+	// Подписанная ссылка заменяет проверку доступа пользователя
+	if err := uc.signer.Verify(attachmentID, token); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := uc.attachmentRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if attachment == nil {
+		return nil, nil, ErrAttachmentNotFound
+	}
+
+	content, err := uc.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, nil, ErrAttachmentNotFound
+		}
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
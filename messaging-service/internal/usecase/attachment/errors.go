@@ -0,0 +1,13 @@
+package attachment
+
+import "errors"
+
+var (
+	ErrAttachmentNotFound       = errors.New("attachment not found")
+	ErrEmptyAttachment          = errors.New("attachment is empty")
+	ErrAttachmentTooLarge       = errors.New("attachment is too large")
+	ErrAttachmentTypeNotAllowed = errors.New("attachment type is not allowed")
+	ErrAttachmentAlreadySent    = errors.New("attachment is already sent")
+	ErrNotAttachmentUploader    = errors.New("only the uploader can send the attachment")
+	ErrInvalidDownloadToken     = errors.New("invalid or expired download token")
+)
@@ -0,0 +1,67 @@
+package attachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+)
+
+// ExpirationLookback — за какой срок очистка просматривает пропущенные удаления файлов,
+// например после простоя сервиса
+const ExpirationLookback = 7 * 24 * time.Hour
+
+// ExpireAttachmentsUsecase удаляет файлы исчезнувших сообщений
+type ExpireAttachmentsUsecase interface {
+	// Execute удаляет файлы, истекшие к моменту now, и возвращает их количество
+	Execute(ctx context.Context, now time.Time) (int, error)
+}
+
+type expireAttachmentsUsecase struct {
+	attachmentRepo repositories.AttachmentRepository
+	blobStore      storage.BlobStore
+}
+
+func NewExpireAttachmentsUsecase(
+	attachmentRepo repositories.AttachmentRepository,
+	blobStore storage.BlobStore,
+) ExpireAttachmentsUsecase {
+	return &expireAttachmentsUsecase{
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+	}
+}
+
+func (uc *expireAttachmentsUsecase) Execute(ctx context.Context, now time.Time) (int, error) {
+	deleted := 0
+	last := models.ExpirationBucket(now)
+	for bucket := models.ExpirationBucket(now.Add(-ExpirationLookback)); !bucket.After(last); bucket = bucket.Add(time.Hour) {
+		expirations, err := uc.attachmentRepo.GetExpirations(ctx, bucket, now)
+		if err != nil {
+			return deleted, err
+		}
+		for _, expiration := range expirations {
+			if err := uc.expire(ctx, expiration); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// expire удаляет сначала файл, а затем сведения о нем: при сбое удаление повторится при следующей очистке
+func (uc *expireAttachmentsUsecase) expire(ctx context.Context, expiration *models.AttachmentExpiration) error {
+	attachment, err := uc.attachmentRepo.GetAttachment(ctx, expiration.AttachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment != nil {
+		if err := uc.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			return err
+		}
+	}
+	return uc.attachmentRepo.DeleteExpired(ctx, expiration)
+}
@@ -0,0 +1,72 @@
+package attachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+type GetAttachmentURLUsecase interface {
+	Execute(ctx context.Context, attachmentID, userID gocql.UUID) (string, time.Time, error)
+}
+
+type getAttachmentURLUsecase struct {
+	attachmentRepo   repositories.AttachmentRepository
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	signer           *URLSigner
+}
+
+func NewGetAttachmentURLUsecase(
+	attachmentRepo repositories.AttachmentRepository,
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	signer *URLSigner,
+) GetAttachmentURLUsecase {
+	return &getAttachmentURLUsecase{
+		attachmentRepo:   attachmentRepo,
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		signer:           signer,
+	}
+}
+
+func (uc *getAttachmentURLUsecase) Execute(ctx context.Context, attachmentID, userID gocql.UUID) (string, time.Time, error) {
+	attachment, err := uc.attachmentRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if attachment == nil {
+		return "", time.Time{}, ErrAttachmentNotFound
+	}
+
+	// До отправки файл доступен только загрузившему, после — участникам беседы
+	if !attachment.Attached() {
+		if attachment.UploaderID != userID {
+			return "", time.Time{}, ErrAttachmentNotFound
+		}
+	} else {
+		member, err := uc.conversationRepo.GetMember(ctx, attachment.ConversationID, userID)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if member == nil {
+			return "", time.Time{}, conversation.ErrNotConversationMember
+		}
+
+		// Вложения сообщения, удаленного у всех, больше не выдаются
+		message, err := uc.messageRepo.GetMessageByID(ctx, attachment.MessageID)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if message == nil || message.Deleted {
+			return "", time.Time{}, ErrAttachmentNotFound
+		}
+	}
+
+	url, expiresAt := uc.signer.SignURL(attachmentID)
+	return url, expiresAt, nil
+}
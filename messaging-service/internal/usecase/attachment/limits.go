@@ -0,0 +1,39 @@
+package attachment
+
+const mib = 1 << 20
+
+// Limits — допустимые типы вложений и максимальный размер файла каждого типа
+type Limits map[string]int64
+
+// DefaultLimits возвращает ограничения по умолчанию
+func DefaultLimits() Limits {
+	return Limits{
+		"image/jpeg":      10 * mib,
+		"image/png":       10 * mib,
+		"image/gif":       10 * mib,
+		"image/webp":      10 * mib,
+		"video/mp4":       100 * mib,
+		"video/webm":      100 * mib,
+		"audio/mpeg":      20 * mib,
+		"audio/wave":      20 * mib,
+		"application/ogg": 20 * mib,
+		"application/pdf": 25 * mib,
+		"application/zip": 25 * mib,
+		"text/plain":      5 * mib,
+	}
+}
+
+// WithMaxSize ограничивает размер файлов всех типов сверху
+func (l Limits) WithMaxSize(maxSize int64) Limits {
+	capped := make(Limits, len(l))
+	for mimeType, size := range l {
+		capped[mimeType] = min(size, maxSize)
+	}
+	return capped
+}
+
+// MaxSize возвращает ограничение размера для типа и допустим ли тип
+func (l Limits) MaxSize(mimeType string) (int64, bool) {
+	size, ok := l[mimeType]
+	return size, ok
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireAttachmentsUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), StorageKey: "ab/cd"}
+	current := &models.AttachmentExpiration{
+		Bucket:       models.ExpirationBucket(now),
+		ExpiresAt:    now.Add(-time.Minute),
+		AttachmentID: stored.AttachmentID,
+	}
+	// Сведения о файле уже удалены, осталось только запланированное удаление
+	missed := &models.AttachmentExpiration{
+		Bucket:       models.ExpirationBucket(now.Add(-3 * time.Hour)),
+		ExpiresAt:    now.Add(-3 * time.Hour),
+		AttachmentID: gocql.TimeUUID(),
+	}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockStore := new(mocks.BlobStore)
+	mockRepo.On("GetExpirations", ctx, current.Bucket, now).Return([]*models.AttachmentExpiration{current}, nil)
+	mockRepo.On("GetExpirations", ctx, missed.Bucket, now).Return([]*models.AttachmentExpiration{missed}, nil)
+	mockRepo.On("GetExpirations", ctx, mock.Anything, now).Return(nil, nil)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockRepo.On("GetAttachment", ctx, missed.AttachmentID).Return(nil, nil)
+	mockStore.On("Delete", ctx, stored.StorageKey).Return(nil)
+	mockRepo.On("DeleteExpired", ctx, current).Return(nil)
+	mockRepo.On("DeleteExpired", ctx, missed).Return(nil)
+
+	usecase := attachment.NewExpireAttachmentsUsecase(mockRepo, mockStore)
+	deleted, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	mockRepo.AssertNumberOfCalls(t, "GetExpirations", int(attachment.ExpirationLookback/time.Hour)+1)
+	mockRepo.AssertExpectations(t)
+	mockStore.AssertExpectations(t)
+}
+
+func TestExpireAttachmentsUsecaseExecuteBlobError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), StorageKey: "ab/cd"}
+	expiration := &models.AttachmentExpiration{
+		Bucket:       models.ExpirationBucket(now.Add(-attachment.ExpirationLookback)),
+		ExpiresAt:    now.Add(-attachment.ExpirationLookback),
+		AttachmentID: stored.AttachmentID,
+	}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockStore := new(mocks.BlobStore)
+	mockRepo.On("GetExpirations", ctx, expiration.Bucket, now).Return([]*models.AttachmentExpiration{expiration}, nil)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockStore.On("Delete", ctx, stored.StorageKey).Return(assert.AnError)
+
+	usecase := attachment.NewExpireAttachmentsUsecase(mockRepo, mockStore)
+	deleted, err := usecase.Execute(ctx, now)
+
+	// Запланированное удаление остается, чтобы повторить его при следующей очистке
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Zero(t, deleted)
+	mockRepo.AssertNotCalled(t, "DeleteExpired", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment/mocks"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tokenFromURL(t *testing.T, rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return parsed.Query().Get("token")
+}
+
+func TestURLSigner(t *testing.T) {
+	signer := attachment.NewURLSigner([]byte("secret"), time.Minute, "https://example.com/v1/messaging/attachments")
+	attachmentID := gocql.TimeUUID()
+
+	signed, expiresAt := signer.SignURL(attachmentID)
+	assert.True(t, strings.HasPrefix(signed, "https://example.com/v1/messaging/attachments/"+attachmentID.String()+"/download?token="))
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, 2*time.Second)
+
+	token := tokenFromURL(t, signed)
+	assert.NoError(t, signer.Verify(attachmentID, token))
+	// Токен привязан к вложению и секрету
+	assert.ErrorIs(t, signer.Verify(gocql.TimeUUID(), token), attachment.ErrInvalidDownloadToken)
+	other := attachment.NewURLSigner([]byte("other"), time.Minute, "")
+	assert.ErrorIs(t, other.Verify(attachmentID, token), attachment.ErrInvalidDownloadToken)
+}
+
+func TestURLSignerExpired(t *testing.T) {
+	signer := attachment.NewURLSigner([]byte("secret"), -time.Minute, "")
+	attachmentID := gocql.TimeUUID()
+
+	signed, _ := signer.SignURL(attachmentID)
+	assert.ErrorIs(t, signer.Verify(attachmentID, tokenFromURL(t, signed)), attachment.ErrInvalidDownloadToken)
+}
+
+func TestGetAttachmentURLUsecaseExecuteUploader(t *testing.T) {
+	ctx := context.Background()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: gocql.TimeUUID()}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	signer := attachment.NewURLSigner([]byte("secret"), time.Minute, "")
+
+	usecase := attachment.NewGetAttachmentURLUsecase(mockRepo, new(mocks.MessageRepository), new(mocks.ConversationRepository), signer)
+
+	signed, _, err := usecase.Execute(ctx, stored.AttachmentID, stored.UploaderID)
+	assert.NoError(t, err)
+	assert.NoError(t, signer.Verify(stored.AttachmentID, tokenFromURL(t, signed)))
+
+	// До отправки вложение недоступно другим пользователям
+	_, _, err = usecase.Execute(ctx, stored.AttachmentID, gocql.TimeUUID())
+	assert.ErrorIs(t, err, attachment.ErrAttachmentNotFound)
+}
+
+func TestGetAttachmentURLUsecaseExecuteMember(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	stored := &models.Attachment{
+		AttachmentID:   gocql.TimeUUID(),
+		UploaderID:     gocql.TimeUUID(),
+		ConversationID: gocql.TimeUUID(),
+		MessageID:      gocql.TimeUUID(),
+	}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockMsgRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockConvRepo.On("GetMember", ctx, stored.ConversationID, userID).Return(&models.ConversationMember{UserID: userID}, nil)
+	mockMsgRepo.On("GetMessageByID", ctx, stored.MessageID).Return(&models.Message{MessageID: stored.MessageID}, nil)
+
+	usecase := attachment.NewGetAttachmentURLUsecase(mockRepo, mockMsgRepo, mockConvRepo, attachment.NewURLSigner([]byte("secret"), time.Minute, ""))
+	_, _, err := usecase.Execute(ctx, stored.AttachmentID, userID)
+
+	assert.NoError(t, err)
+	mockMsgRepo.AssertExpectations(t)
+}
+
+func TestGetAttachmentURLUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	stored := &models.Attachment{
+		AttachmentID:   gocql.TimeUUID(),
+		ConversationID: gocql.TimeUUID(),
+		MessageID:      gocql.TimeUUID(),
+	}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockConvRepo.On("GetMember", ctx, stored.ConversationID, userID).Return(nil, nil)
+
+	usecase := attachment.NewGetAttachmentURLUsecase(mockRepo, new(mocks.MessageRepository), mockConvRepo, attachment.NewURLSigner([]byte("secret"), time.Minute, ""))
+	_, _, err := usecase.Execute(ctx, stored.AttachmentID, userID)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+}
+
+func TestGetAttachmentURLUsecaseExecuteDeletedMessage(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	stored := &models.Attachment{
+		AttachmentID:   gocql.TimeUUID(),
+		ConversationID: gocql.TimeUUID(),
+		MessageID:      gocql.TimeUUID(),
+	}
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockMsgRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockConvRepo.On("GetMember", ctx, stored.ConversationID, userID).Return(&models.ConversationMember{UserID: userID}, nil)
+	mockMsgRepo.On("GetMessageByID", ctx, stored.MessageID).Return(&models.Message{MessageID: stored.MessageID, Deleted: true}, nil)
+
+	usecase := attachment.NewGetAttachmentURLUsecase(mockRepo, mockMsgRepo, mockConvRepo, attachment.NewURLSigner([]byte("secret"), time.Minute, ""))
+	_, _, err := usecase.Execute(ctx, stored.AttachmentID, userID)
+
+	assert.ErrorIs(t, err, attachment.ErrAttachmentNotFound)
+}
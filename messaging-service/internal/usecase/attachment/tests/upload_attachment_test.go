@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) storage.BlobStore {
+	store, err := storage.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func newTestPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestUploadAttachmentUsecaseExecuteImage(t *testing.T) {
+	ctx := context.Background()
+	uploaderID := gocql.TimeUUID()
+	content := newTestPNG(t, 40, 30)
+	checksum := sha256.Sum256(content)
+	store := newTestStore(t)
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockRepo.On("SaveAttachment", ctx, mock.AnythingOfType("*models.Attachment")).Return(nil)
+
+	usecase := attachment.NewUploadAttachmentUsecase(mockRepo, store, attachment.DefaultLimits())
+	result, err := usecase.Execute(ctx, uploaderID, "../photos/cat.png", bytes.NewReader(content))
+
+	require.NoError(t, err)
+	assert.Equal(t, uploaderID, result.UploaderID)
+	assert.Equal(t, "cat.png", result.FileName)
+	assert.Equal(t, "image/png", result.MimeType)
+	assert.Equal(t, int64(len(content)), result.Size)
+	assert.Equal(t, hex.EncodeToString(checksum[:]), result.Checksum)
+	assert.Equal(t, 40, result.Width)
+	assert.Equal(t, 30, result.Height)
+	assert.False(t, result.Attached())
+
+	stored, err := store.Get(ctx, result.StorageKey)
+	require.NoError(t, err)
+	defer stored.Close()
+	data, err := io.ReadAll(stored)
+	require.NoError(t, err)
+	assert.Equal(t, content, data)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUploadAttachmentUsecaseExecuteTooLarge(t *testing.T) {
+	ctx := context.Background()
+	store := new(mocks.BlobStore)
+	var key string
+	store.On("Put", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		key = args.String(1)
+		io.Copy(io.Discard, args.Get(2).(io.Reader))
+	}).Return(nil)
+	store.On("Delete", ctx, mock.Anything).Return(nil)
+
+	mockRepo := new(mocks.AttachmentRepository)
+	limits := attachment.DefaultLimits().WithMaxSize(10)
+
+	usecase := attachment.NewUploadAttachmentUsecase(mockRepo, store, limits)
+	_, err := usecase.Execute(ctx, gocql.TimeUUID(), "notes.txt", strings.NewReader("more than ten bytes"))
+
+	assert.ErrorIs(t, err, attachment.ErrAttachmentTooLarge)
+	store.AssertCalled(t, "Delete", ctx, key)
+	mockRepo.AssertNotCalled(t, "SaveAttachment", mock.Anything, mock.Anything)
+}
+
+func TestUploadAttachmentUsecaseExecuteTypeNotAllowed(t *testing.T) {
+	ctx := context.Background()
+	store := new(mocks.BlobStore)
+	mockRepo := new(mocks.AttachmentRepository)
+
+	// Исполняемый файл определяется как application/octet-stream
+	usecase := attachment.NewUploadAttachmentUsecase(mockRepo, store, attachment.DefaultLimits())
+	_, err := usecase.Execute(ctx, gocql.TimeUUID(), "notes.txt", bytes.NewReader([]byte{0x7f, 'E', 'L', 'F', 0x02, 0x01, 0x01, 0x00}))
+
+	assert.ErrorIs(t, err, attachment.ErrAttachmentTypeNotAllowed)
+	store.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadAttachmentUsecaseExecuteEmpty(t *testing.T) {
+	ctx := context.Background()
+
+	usecase := attachment.NewUploadAttachmentUsecase(new(mocks.AttachmentRepository), new(mocks.BlobStore), attachment.DefaultLimits())
+	_, err := usecase.Execute(ctx, gocql.TimeUUID(), "empty.txt", strings.NewReader(""))
+
+	assert.ErrorIs(t, err, attachment.ErrEmptyAttachment)
+}
+
+func TestDownloadAttachmentUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	signer := attachment.NewURLSigner([]byte("secret"), time.Minute, "/v1/messaging/attachments")
+	stored := &models.Attachment{
+		AttachmentID: gocql.TimeUUID(),
+		MimeType:     "text/plain",
+		StorageKey:   "attachments/test",
+	}
+	require.NoError(t, store.Put(ctx, stored.StorageKey, strings.NewReader("hello")))
+
+	mockRepo := new(mocks.AttachmentRepository)
+	mockRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+
+	signed, _ := signer.SignURL(stored.AttachmentID)
+	token := tokenFromURL(t, signed)
+
+	usecase := attachment.NewDownloadAttachmentUsecase(mockRepo, store, signer)
+	result, content, err := usecase.Execute(ctx, stored.AttachmentID, token)
+
+	require.NoError(t, err)
+	defer content.Close()
+	data, _ := io.ReadAll(content)
+	assert.Equal(t, stored, result)
+	assert.Equal(t, "hello", string(data))
+}
+
+func TestDownloadAttachmentUsecaseExecuteInvalidToken(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(mocks.AttachmentRepository)
+	signer := attachment.NewURLSigner([]byte("secret"), time.Minute, "")
+
+	usecase := attachment.NewDownloadAttachmentUsecase(mockRepo, new(mocks.BlobStore), signer)
+	_, _, err := usecase.Execute(ctx, gocql.TimeUUID(), "forged")
+
+	assert.ErrorIs(t, err, attachment.ErrInvalidDownloadToken)
+	mockRepo.AssertNotCalled(t, "GetAttachment", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,159 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	// Декодеры для определения размеров изображений
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/storage"
+)
+
+const (
+	// Количество байт, по которым определяется тип файла
+	sniffLen = 512
+	// Максимальная длина имени файла
+	maxFileNameLen  = 255
+	defaultFileName = "file"
+)
+
+type UploadAttachmentUsecase interface {
+	Execute(ctx context.Context, uploaderID gocql.UUID, fileName string, content io.Reader) (*models.Attachment, error)
+}
+
+type uploadAttachmentUsecase struct {
+	attachmentRepo repositories.AttachmentRepository
+	blobStore      storage.BlobStore
+	limits         Limits
+}
+
+func NewUploadAttachmentUsecase(
+	attachmentRepo repositories.AttachmentRepository,
+	blobStore storage.BlobStore,
+	limits Limits,
+) UploadAttachmentUsecase {
+	return &uploadAttachmentUsecase{
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		limits:         limits,
+	}
+}
+
+func (uc *uploadAttachmentUsecase) Execute(ctx context.Context, uploaderID gocql.UUID, fileName string, content io.Reader) (*models.Attachment, error) {
+	// Тип определяется по содержимому, заявленному клиентом типу не доверяем
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrEmptyAttachment
+		}
+		return nil, err
+	}
+	head = head[:n]
+
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return nil, ErrAttachmentTypeNotAllowed
+	}
+	maxSize, ok := uc.limits.MaxSize(mimeType)
+	if !ok {
+		return nil, ErrAttachmentTypeNotAllowed
+	}
+
+	attachment := &models.Attachment{
+		AttachmentID: gocql.TimeUUID(),
+		UploaderID:   uploaderID,
+		FileName:     sanitizeFileName(fileName),
+		MimeType:     mimeType,
+		CreatedAt:    time.Now(),
+	}
+	attachment.StorageKey = "attachments/" + attachment.AttachmentID.String()
+
+	// Лишний байт сверх ограничения показывает, что файл слишком большой
+	hash := sha256.New()
+	counter := &countingWriter{}
+	body := io.TeeReader(
+		io.LimitReader(io.MultiReader(bytes.NewReader(head), content), maxSize+1),
+		io.MultiWriter(hash, counter),
+	)
+	if err := uc.blobStore.Put(ctx, attachment.StorageKey, body); err != nil {
+		return nil, err
+	}
+	if counter.n > maxSize {
+		uc.deleteBlob(ctx, attachment.StorageKey)
+		return nil, ErrAttachmentTooLarge
+	}
+	attachment.Size = counter.n
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if strings.HasPrefix(mimeType, "image/") {
+		attachment.Width, attachment.Height = uc.imageSize(ctx, attachment.StorageKey)
+	}
+
+	if err := uc.attachmentRepo.SaveAttachment(ctx, attachment); err != nil {
+		uc.deleteBlob(ctx, attachment.StorageKey)
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// imageSize читает размеры изображения из заголовка сохраненного файла.
+// Для форматов без декодера размеры остаются нулевыми.
+func (uc *uploadAttachmentUsecase) imageSize(ctx context.Context, key string) (int, int) {
+	blob, err := uc.blobStore.Get(ctx, key)
+	if err != nil {
+		log.Printf("error reading attachment %s: %v", key, err)
+		return 0, 0
+	}
+	defer blob.Close()
+
+	config, _, err := image.DecodeConfig(blob)
+	if err != nil {
+		return 0, 0
+	}
+	return config.Width, config.Height
+}
+
+func (uc *uploadAttachmentUsecase) deleteBlob(ctx context.Context, key string) {
+	if err := uc.blobStore.Delete(ctx, key); err != nil {
+		log.Printf("error deleting attachment %s: %v", key, err)
+	}
+}
+
+// sanitizeFileName оставляет только имя файла без пути
+func sanitizeFileName(fileName string) string {
+	fileName = path.Base(strings.ReplaceAll(strings.TrimSpace(fileName), `\`, "/"))
+	if fileName == "." || fileName == "/" || fileName == "" {
+		return defaultFileName
+	}
+	if len(fileName) > maxFileNameLen {
+		fileName = strings.ToValidUTF8(fileName[:maxFileNameLen], "")
+	}
+	return fileName
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
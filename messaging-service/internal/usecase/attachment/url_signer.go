@@ -0,0 +1,64 @@
+package attachment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// URLSigner выдает ссылки на скачивание, подписанные HMAC.
+// Токен содержит время окончания действия и подпись идентификатора вложения с этим временем.
+type URLSigner struct {
+	secret  []byte
+	ttl     time.Duration
+	baseURL string
+}
+
+func NewURLSigner(secret []byte, ttl time.Duration, baseURL string) *URLSigner {
+	return &URLSigner{
+		secret:  secret,
+		ttl:     ttl,
+		baseURL: baseURL,
+	}
+}
+
+// SignURL возвращает ссылку на скачивание и время окончания ее действия
+func (s *URLSigner) SignURL(attachmentID gocql.UUID) (string, time.Time) {
+	expiresAt := time.Now().Add(s.ttl).Truncate(time.Second)
+
+	buf := binary.BigEndian.AppendUint64(nil, uint64(expiresAt.Unix()))
+	buf = append(buf, s.sign(attachmentID, expiresAt)...)
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return fmt.Sprintf("%s/%s/download?token=%s", s.baseURL, attachmentID, url.QueryEscape(token)), expiresAt
+}
+
+// Verify проверяет подпись токена и срок его действия
+func (s *URLSigner) Verify(attachmentID gocql.UUID, token string) error {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != 8+sha256.Size {
+		return ErrInvalidDownloadToken
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(buf[:8])), 0)
+	if !hmac.Equal(buf[8:], s.sign(attachmentID, expiresAt)) {
+		return ErrInvalidDownloadToken
+	}
+	if time.Now().After(expiresAt) {
+		return ErrInvalidDownloadToken
+	}
+	return nil
+}
+
+func (s *URLSigner) sign(attachmentID gocql.UUID, expiresAt time.Time) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(attachmentID.Bytes())
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(expiresAt.Unix())))
+	return mac.Sum(nil)
+}
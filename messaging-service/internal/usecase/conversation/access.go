@@ -0,0 +1,57 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// requireGroupAdmin проверяет, что беседа групповая и пользователь является ее администратором
+func requireGroupAdmin(ctx context.Context, repo repositories.ConversationRepository, conversationID, userID gocql.UUID) (*models.Conversation, error) {
+	conversation, err := repo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation == nil {
+		return nil, ErrConversationNotFound
+	}
+	if conversation.Type != models.ConversationTypeGroup {
+		return nil, ErrDirectConversation
+	}
+
+	member, err := repo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrNotConversationMember
+	}
+	if member.Role != models.RoleAdmin {
+		return nil, ErrNotConversationAdmin
+	}
+	return conversation, nil
+}
+
+// requireMember проверяет, что пользователь состоит в беседе
+func requireMember(ctx context.Context, repo repositories.ConversationRepository, conversationID, userID gocql.UUID) error {
+	member, err := repo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrNotConversationMember
+	}
+	return nil
+}
+
+func countAdmins(members []*models.ConversationMember) int {
+	count := 0
+	for _, member := range members {
+		if member.Role == models.RoleAdmin {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,76 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type AddMembersUsecase interface {
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, memberIDs []gocql.UUID) ([]*models.ConversationMember, error)
+}
+
+type addMembersUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	systemEvents     PostSystemEventUsecase
+}
+
+func NewAddMembersUsecase(conversationRepo repositories.ConversationRepository, systemEvents PostSystemEventUsecase) AddMembersUsecase {
+	return &addMembersUsecase{
+		conversationRepo: conversationRepo,
+		systemEvents:     systemEvents,
+	}
+}
+
+func (uc *addMembersUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, memberIDs []gocql.UUID) ([]*models.ConversationMember, error) {
+	if _, err := requireGroupAdmin(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	added := make(map[gocql.UUID]bool, len(existing)+len(memberIDs))
+	for _, member := range existing {
+		added[member.UserID] = true
+	}
+
+	// Уже состоящие в беседе пользователи пропускаются, чтобы не сбросить их роль
+	now := time.Now()
+	var members []*models.ConversationMember
+	for _, memberID := range memberIDs {
+		if added[memberID] {
+			continue
+		}
+		added[memberID] = true
+		members = append(members, &models.ConversationMember{
+			ConversationID: conversationID,
+			UserID:         memberID,
+			Role:           models.RoleMember,
+			JoinedAt:       now,
+		})
+	}
+
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipAdded, members)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.conversationRepo.SaveMembers(ctx, members, event); err != nil {
+		return nil, err
+	}
+	postSystemEvent(ctx, uc.systemEvents, conversationID, &models.SystemEvent{
+		Type:      models.SystemEventMembersAdded,
+		ActorID:   userID,
+		MemberIDs: memberUserIDs(members),
+	})
+	return members, nil
+}
@@ -0,0 +1,73 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type CreateConversationUsecase interface {
+	Execute(ctx context.Context, creatorID gocql.UUID, title string, memberIDs []gocql.UUID) (*models.Conversation, error)
+}
+
+type createConversationUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	systemEvents     PostSystemEventUsecase
+}
+
+func NewCreateConversationUsecase(conversationRepo repositories.ConversationRepository, systemEvents PostSystemEventUsecase) CreateConversationUsecase {
+	return &createConversationUsecase{
+		conversationRepo: conversationRepo,
+		systemEvents:     systemEvents,
+	}
+}
+
+func (uc *createConversationUsecase) Execute(ctx context.Context, creatorID gocql.UUID, title string, memberIDs []gocql.UUID) (*models.Conversation, error) {
+	now := time.Now()
+	conversation := &models.Conversation{
+		ConversationID: gocql.TimeUUID(),
+		Type:           models.ConversationTypeGroup,
+		Title:          title,
+		CreatorID:      creatorID,
+		CreatedAt:      now,
+	}
+
+	// Создатель становится администратором, повторы в списке участников игнорируются
+	conversation.Members = []*models.ConversationMember{{
+		ConversationID: conversation.ConversationID,
+		UserID:         creatorID,
+		Role:           models.RoleAdmin,
+		JoinedAt:       now,
+	}}
+	added := map[gocql.UUID]bool{creatorID: true}
+	for _, memberID := range memberIDs {
+		if added[memberID] {
+			continue
+		}
+		added[memberID] = true
+		conversation.Members = append(conversation.Members, &models.ConversationMember{
+			ConversationID: conversation.ConversationID,
+			UserID:         memberID,
+			Role:           models.RoleMember,
+			JoinedAt:       now,
+		})
+	}
+
+	event, err := events.NewConversationMembersChangedEvent(conversation.ConversationID, creatorID, models.MembershipAdded, conversation.Members)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.conversationRepo.CreateConversation(ctx, conversation, conversation.Members, event); err != nil {
+		return nil, err
+	}
+	postSystemEvent(ctx, uc.systemEvents, conversation.ConversationID, &models.SystemEvent{
+		Type:      models.SystemEventConversationCreated,
+		ActorID:   creatorID,
+		MemberIDs: memberUserIDs(conversation.Members[1:]),
+	})
+	return conversation, nil
+}
@@ -0,0 +1,15 @@
+package conversation
+
+import "errors"
+
+var (
+	ErrConversationNotFound  = errors.New("conversation not found")
+	ErrNotConversationMember = errors.New("user is not a member of the conversation")
+	ErrNotConversationAdmin  = errors.New("user is not an admin of the conversation")
+	ErrDirectConversation    = errors.New("operation is not allowed in a direct conversation")
+	ErrRemoveSelf            = errors.New("use leave conversation to remove yourself")
+	ErrLastAdmin             = errors.New("conversation must have at least one admin")
+	ErrMemberNotFound        = errors.New("member not found")
+	ErrInvalidMessageTTL     = errors.New("message ttl must be zero or at least one minute")
+	ErrInvalidMuteUntil      = errors.New("mute end must be in the future")
+)
@@ -0,0 +1,52 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetConversationUsecase interface {
+	Execute(ctx context.Context, conversationID, userID gocql.UUID) (*models.Conversation, error)
+}
+
+type getConversationUsecase struct {
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewGetConversationUsecase(conversationRepo repositories.ConversationRepository) GetConversationUsecase {
+	return &getConversationUsecase{
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *getConversationUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID) (*models.Conversation, error) {
+	conversation, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation == nil {
+		return nil, ErrConversationNotFound
+	}
+
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := false
+	for _, member := range members {
+		if member.UserID == userID {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return nil, ErrNotConversationMember
+	}
+
+	conversation.Members = members
+	return conversation, nil
+}
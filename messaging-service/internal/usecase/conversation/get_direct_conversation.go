@@ -0,0 +1,74 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// GetDirectConversationUsecase возвращает личную переписку двух пользователей,
+// создавая ее при первом обращении. Идентификатор переписки детерминирован,
+// поэтому история, накопленная до появления бесед, остается доступной.
+type GetDirectConversationUsecase interface {
+	Execute(ctx context.Context, userID, peerID gocql.UUID) (*models.Conversation, error)
+}
+
+type getDirectConversationUsecase struct {
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewGetDirectConversationUsecase(conversationRepo repositories.ConversationRepository) GetDirectConversationUsecase {
+	return &getDirectConversationUsecase{
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *getDirectConversationUsecase) Execute(ctx context.Context, userID, peerID gocql.UUID) (*models.Conversation, error) {
+	conversationID, err := models.DirectConversationID(userID, peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation != nil {
+		return conversation, nil
+	}
+
+	now := time.Now()
+	conversation = &models.Conversation{
+		ConversationID: conversationID,
+		Type:           models.ConversationTypeDirect,
+		CreatorID:      userID,
+		CreatedAt:      now,
+	}
+	members := []*models.ConversationMember{
+		{ConversationID: conversationID, UserID: userID, Role: models.RoleMember, JoinedAt: now},
+		{ConversationID: conversationID, UserID: peerID, Role: models.RoleMember, JoinedAt: now},
+	}
+
+	event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipAdded, members)
+	if err != nil {
+		return nil, err
+	}
+
+	// Участники сохраняются первыми: при сбое между запросами следующий вызов повторит создание
+	if err := uc.conversationRepo.SaveMembers(ctx, members, event); err != nil {
+		return nil, err
+	}
+	applied, err := uc.conversationRepo.CreateConversationIfNotExists(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		// Переписку одновременно создал другой запрос
+		return uc.conversationRepo.GetConversation(ctx, conversationID)
+	}
+	return conversation, nil
+}
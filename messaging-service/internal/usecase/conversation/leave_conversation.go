@@ -0,0 +1,87 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type LeaveConversationUsecase interface {
+	Execute(ctx context.Context, conversationID, userID gocql.UUID) error
+}
+
+type leaveConversationUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	systemEvents     PostSystemEventUsecase
+}
+
+func NewLeaveConversationUsecase(conversationRepo repositories.ConversationRepository, systemEvents PostSystemEventUsecase) LeaveConversationUsecase {
+	return &leaveConversationUsecase{
+		conversationRepo: conversationRepo,
+		systemEvents:     systemEvents,
+	}
+}
+
+func (uc *leaveConversationUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID) error {
+	conversation, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if conversation == nil {
+		return ErrConversationNotFound
+	}
+	if conversation.Type != models.ConversationTypeGroup {
+		return ErrDirectConversation
+	}
+
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	var leaving *models.ConversationMember
+	var successor *models.ConversationMember
+	for _, member := range members {
+		if member.UserID == userID {
+			leaving = member
+			continue
+		}
+		// Преемником становится участник, состоящий в беседе дольше остальных
+		if successor == nil || member.JoinedAt.Before(successor.JoinedAt) {
+			successor = member
+		}
+	}
+	if leaving == nil {
+		return ErrNotConversationMember
+	}
+
+	// Последний администратор передает права, чтобы беседа не осталась без управления
+	if leaving.Role == models.RoleAdmin && countAdmins(members) == 1 && successor != nil {
+		promoted := *successor
+		promoted.Role = models.RoleAdmin
+		event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipRoleChanged, []*models.ConversationMember{&promoted})
+		if err != nil {
+			return err
+		}
+		if err := uc.conversationRepo.UpdateMemberRole(ctx, conversationID, successor.UserID, models.RoleAdmin, event); err != nil {
+			return err
+		}
+	}
+
+	event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipRemoved, []*models.ConversationMember{leaving})
+	if err != nil {
+		return err
+	}
+	if err := uc.conversationRepo.RemoveMember(ctx, conversationID, userID, event); err != nil {
+		return err
+	}
+	postSystemEvent(ctx, uc.systemEvents, conversationID, &models.SystemEvent{
+		Type:      models.SystemEventMemberLeft,
+		ActorID:   userID,
+		MemberIDs: []gocql.UUID{userID},
+	})
+	return nil
+}
@@ -0,0 +1,140 @@
+package conversation
+
+import (
+	"context"
+	"log"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+const (
+	// Размер страницы списка бесед, если клиент его не указал
+	defaultInboxPageSize = 20
+	// Максимальный размер страницы списка бесед
+	maxInboxPageSize = 100
+	// Больше непрочитанных не считается, клиент показывает их как «99+»
+	MaxUnreadCount = 100
+)
+
+type ListConversationsUsecase interface {
+	// Execute возвращает страницу бесед из основного списка или из архива
+	Execute(ctx context.Context, userID, cursor gocql.UUID, folder models.InboxFolder, limit int) (*models.ConversationPage, error)
+}
+
+type listConversationsUsecase struct {
+	inboxRepo        repositories.InboxRepository
+	conversationRepo repositories.ConversationRepository
+	messageRepo      repositories.MessageRepository
+	users            clients.UserDirectory
+}
+
+func NewListConversationsUsecase(
+	inboxRepo repositories.InboxRepository,
+	conversationRepo repositories.ConversationRepository,
+	messageRepo repositories.MessageRepository,
+	users clients.UserDirectory,
+) ListConversationsUsecase {
+	return &listConversationsUsecase{
+		inboxRepo:        inboxRepo,
+		conversationRepo: conversationRepo,
+		messageRepo:      messageRepo,
+		users:            users,
+	}
+}
+
+func (uc *listConversationsUsecase) Execute(ctx context.Context, userID, cursor gocql.UUID, folder models.InboxFolder, limit int) (*models.ConversationPage, error) {
+	if limit <= 0 {
+		limit = defaultInboxPageSize
+	}
+	if limit > maxInboxPageSize {
+		limit = maxInboxPageSize
+	}
+
+	// Лишняя беседа показывает, есть ли следующая страница
+	entries, err := uc.inboxRepo.GetInbox(ctx, userID, cursor, folder, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.ConversationPage{}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		page.HasMore = true
+	}
+	if len(entries) > 0 {
+		page.NextCursor = entries[len(entries)-1].ActivityID
+	}
+
+	var peerIDs []gocql.UUID
+	for _, entry := range entries {
+		conv, err := uc.conversationRepo.GetConversation(ctx, entry.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		// Участники сохраняются раньше беседы, незавершенное создание пропускается
+		if conv == nil {
+			continue
+		}
+
+		summary := &models.ConversationSummary{
+			Conversation: conv,
+			Entry:        entry,
+		}
+		if entry.HasUnread() {
+			summary.UnreadCount, err = uc.messageRepo.CountUnread(ctx, conv.ConversationID, userID, entry.LastReadMessageID, MaxUnreadCount)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if conv.Type == models.ConversationTypeDirect {
+			peerID, err := uc.directPeer(ctx, conv.ConversationID, userID)
+			if err != nil {
+				return nil, err
+			}
+			if peerID != (gocql.UUID{}) {
+				summary.Peer = &models.UserProfile{UserID: peerID}
+				peerIDs = append(peerIDs, peerID)
+			}
+		}
+		page.Conversations = append(page.Conversations, summary)
+	}
+
+	if len(peerIDs) > 0 {
+		uc.attachProfiles(ctx, page.Conversations, peerIDs)
+	}
+	return page, nil
+}
+
+// directPeer возвращает собеседника в личной переписке
+func (uc *listConversationsUsecase) directPeer(ctx context.Context, conversationID, userID gocql.UUID) (gocql.UUID, error) {
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return gocql.UUID{}, err
+	}
+	for _, member := range members {
+		if member.UserID != userID {
+			return member.UserID, nil
+		}
+	}
+	return gocql.UUID{}, nil
+}
+
+// attachProfiles дополняет собеседников профилями. Недоступность user-service не мешает
+// показать список, клиент получит только идентификаторы собеседников
+func (uc *listConversationsUsecase) attachProfiles(ctx context.Context, summaries []*models.ConversationSummary, peerIDs []gocql.UUID) {
+	profiles, err := uc.users.GetProfiles(ctx, peerIDs)
+	if err != nil {
+		log.Printf("error getting peer profiles: %v", err)
+	}
+	for _, summary := range summaries {
+		if summary.Peer == nil {
+			continue
+		}
+		if profile, ok := profiles[summary.Peer.UserID]; ok {
+			summary.Peer = profile
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// UserDirectory is an autogenerated mock type for the UserDirectory type
+type UserDirectory struct {
+	mock.Mock
+}
+
+// GetProfiles provides a mock function with given fields: ctx, userIDs
+func (_m *UserDirectory) GetProfiles(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.UserProfile, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfiles")
+	}
+
+	var r0 map[gocql.UUID]*models.UserProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) (map[gocql.UUID]*models.UserProfile, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) map[gocql.UUID]*models.UserProfile); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]*models.UserProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []gocql.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProfilesByNicknames provides a mock function with given fields: ctx, nicknames
+func (_m *UserDirectory) GetProfilesByNicknames(ctx context.Context, nicknames []string) (map[string]*models.UserProfile, error) {
+	ret := _m.Called(ctx, nicknames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfilesByNicknames")
+	}
+
+	var r0 map[string]*models.UserProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*models.UserProfile, error)); ok {
+		return rf(ctx, nicknames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*models.UserProfile); ok {
+		r0 = rf(ctx, nicknames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.UserProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, nicknames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserDirectory creates a new instance of UserDirectory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserDirectory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserDirectory {
+	mock := &UserDirectory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
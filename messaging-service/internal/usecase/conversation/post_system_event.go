@@ -0,0 +1,99 @@
+package conversation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// PostSystemEventUsecase сохраняет системное событие беседы сообщением в истории
+type PostSystemEventUsecase interface {
+	// Execute сохраняет сообщение от имени совершившего действие. Сообщение получают
+	// текущие участники и участники, которых касается событие, в том числе покинувшие беседу
+	Execute(ctx context.Context, conversationID gocql.UUID, event *models.SystemEvent) (*models.Message, error)
+}
+
+type postSystemEventUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	messageRepo      repositories.MessageRepository
+	hub              events.Hub
+}
+
+func NewPostSystemEventUsecase(
+	conversationRepo repositories.ConversationRepository,
+	messageRepo repositories.MessageRepository,
+	hub events.Hub,
+) PostSystemEventUsecase {
+	return &postSystemEventUsecase{
+		conversationRepo: conversationRepo,
+		messageRepo:      messageRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *postSystemEventUsecase) Execute(ctx context.Context, conversationID gocql.UUID, event *models.SystemEvent) (*models.Message, error) {
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	recipientIDs := make([]gocql.UUID, 0, len(members)+len(event.MemberIDs))
+	seen := make(map[gocql.UUID]bool, cap(recipientIDs))
+	for _, member := range members {
+		seen[member.UserID] = true
+		recipientIDs = append(recipientIDs, member.UserID)
+	}
+	for _, memberID := range event.MemberIDs {
+		if !seen[memberID] {
+			seen[memberID] = true
+			recipientIDs = append(recipientIDs, memberID)
+		}
+	}
+
+	message := &models.Message{
+		MessageID:      gocql.TimeUUID(),
+		ConversationID: conversationID,
+		SenderID:       event.ActorID,
+		Timestamp:      time.Now(),
+		Status:         models.StatusSent,
+		ContentType:    models.ContentSystemEvent,
+		SystemEvent:    event,
+	}
+	outboxEvent, err := events.NewMessageSentEvent(message, recipientIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.messageRepo.SaveMessage(ctx, message, outboxEvent); err != nil {
+		return nil, err
+	}
+
+	published := &models.MessageEvent{
+		Type:      models.EventMessageCreated,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+	if err := uc.hub.Publish(ctx, published, recipientIDs...); err != nil {
+		log.Printf("error publishing system event message %s: %v", message.MessageID, err)
+	}
+	return message, nil
+}
+
+// postSystemEvent сохраняет системное сообщение после изменения беседы.
+// Изменение уже применено, поэтому сбой сохранения сообщения только журналируется
+func postSystemEvent(ctx context.Context, uc PostSystemEventUsecase, conversationID gocql.UUID, event *models.SystemEvent) {
+	if _, err := uc.Execute(ctx, conversationID, event); err != nil {
+		log.Printf("error posting system event %d to conversation %s: %v", event.Type, conversationID, err)
+	}
+}
+
+func memberUserIDs(members []*models.ConversationMember) []gocql.UUID {
+	ids := make([]gocql.UUID, len(members))
+	for i, member := range members {
+		ids[i] = member.UserID
+	}
+	return ids
+}
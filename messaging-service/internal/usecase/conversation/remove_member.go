@@ -0,0 +1,58 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type RemoveMemberUsecase interface {
+	Execute(ctx context.Context, conversationID, userID, memberID gocql.UUID) error
+}
+
+type removeMemberUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	systemEvents     PostSystemEventUsecase
+}
+
+func NewRemoveMemberUsecase(conversationRepo repositories.ConversationRepository, systemEvents PostSystemEventUsecase) RemoveMemberUsecase {
+	return &removeMemberUsecase{
+		conversationRepo: conversationRepo,
+		systemEvents:     systemEvents,
+	}
+}
+
+func (uc *removeMemberUsecase) Execute(ctx context.Context, conversationID, userID, memberID gocql.UUID) error {
+	if userID == memberID {
+		return ErrRemoveSelf
+	}
+
+	if _, err := requireGroupAdmin(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+		return err
+	}
+
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, memberID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrMemberNotFound
+	}
+
+	event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipRemoved, []*models.ConversationMember{member})
+	if err != nil {
+		return err
+	}
+	if err := uc.conversationRepo.RemoveMember(ctx, conversationID, memberID, event); err != nil {
+		return err
+	}
+	postSystemEvent(ctx, uc.systemEvents, conversationID, &models.SystemEvent{
+		Type:      models.SystemEventMemberRemoved,
+		ActorID:   userID,
+		MemberIDs: []gocql.UUID{memberID},
+	})
+	return nil
+}
@@ -0,0 +1,52 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type SetArchivedUsecase interface {
+	// Execute переносит беседу в архив пользователя или возвращает из него
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, archived bool) (*models.InboxEntry, error)
+}
+
+type setArchivedUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+}
+
+func NewSetArchivedUsecase(
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+) SetArchivedUsecase {
+	return &setArchivedUsecase{
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *setArchivedUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, archived bool) (*models.InboxEntry, error) {
+	if err := requireMember(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.inboxRepo.SetArchived(ctx, userID, conversationID, archived); err != nil {
+		return nil, err
+	}
+	return getSettings(ctx, uc.inboxRepo, conversationID, userID)
+}
+
+// getSettings возвращает беседу из списка пользователя после изменения ее настроек
+func getSettings(ctx context.Context, inboxRepo repositories.InboxRepository, conversationID, userID gocql.UUID) (*models.InboxEntry, error) {
+	entry, err := inboxRepo.GetEntry(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	// Строка списка создается изменением настроек, пустая запись возможна только при чтении с отставшей реплики
+	if entry == nil {
+		entry = &models.InboxEntry{UserID: userID, ConversationID: conversationID}
+	}
+	return entry, nil
+}
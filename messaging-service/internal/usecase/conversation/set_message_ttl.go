@@ -0,0 +1,70 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// MinMessageTTL — наименьший срок жизни исчезающих сообщений
+const MinMessageTTL = time.Minute
+
+type SetMessageTTLUsecase interface {
+	// Execute задает срок жизни новых сообщений беседы, нулевой срок отключает исчезновение
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, ttl time.Duration) (*models.Conversation, error)
+}
+
+type setMessageTTLUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	systemEvents     PostSystemEventUsecase
+}
+
+func NewSetMessageTTLUsecase(conversationRepo repositories.ConversationRepository, systemEvents PostSystemEventUsecase) SetMessageTTLUsecase {
+	return &setMessageTTLUsecase{
+		conversationRepo: conversationRepo,
+		systemEvents:     systemEvents,
+	}
+}
+
+func (uc *setMessageTTLUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, ttl time.Duration) (*models.Conversation, error) {
+	if ttl != 0 && ttl < MinMessageTTL {
+		return nil, ErrInvalidMessageTTL
+	}
+
+	conversation, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation == nil {
+		return nil, ErrConversationNotFound
+	}
+
+	// В личной переписке таймер меняет любой из собеседников, в группе — только администратор
+	if conversation.Type == models.ConversationTypeGroup {
+		if _, err := requireGroupAdmin(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+			return nil, err
+		}
+	} else {
+		member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if member == nil {
+			return nil, ErrNotConversationMember
+		}
+	}
+
+	if err := uc.conversationRepo.SetMessageTTL(ctx, conversationID, ttl); err != nil {
+		return nil, err
+	}
+	conversation.MessageTTL = ttl
+	postSystemEvent(ctx, uc.systemEvents, conversationID, &models.SystemEvent{
+		Type:       models.SystemEventMessageTTLChanged,
+		ActorID:    userID,
+		MessageTTL: int(ttl / time.Second),
+	})
+	return conversation, nil
+}
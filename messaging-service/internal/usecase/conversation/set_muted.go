@@ -0,0 +1,48 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type SetMutedUsecase interface {
+	// Execute отключает уведомления беседы до mutedUntil, models.MutedForever — навсегда,
+	// нулевое время включает уведомления
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, mutedUntil time.Time) (*models.InboxEntry, error)
+}
+
+type setMutedUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+}
+
+func NewSetMutedUsecase(
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+) SetMutedUsecase {
+	return &setMutedUsecase{
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *setMutedUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, mutedUntil time.Time) (*models.InboxEntry, error) {
+	if !mutedUntil.IsZero() && !mutedUntil.After(time.Now()) {
+		return nil, ErrInvalidMuteUntil
+	}
+	if mutedUntil.After(models.MutedForever) {
+		mutedUntil = models.MutedForever
+	}
+
+	if err := requireMember(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.inboxRepo.SetMutedUntil(ctx, userID, conversationID, mutedUntil); err != nil {
+		return nil, err
+	}
+	return getSettings(ctx, uc.inboxRepo, conversationID, userID)
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockSettingsMember(ctx context.Context, repo *mocks.ConversationRepository, conversationID, userID gocql.UUID) {
+	repo.On("GetMember", ctx, conversationID, userID).Return(&models.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Role:           models.RoleMember,
+	}, nil)
+}
+
+func TestSetArchivedUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockSettingsMember(ctx, mockRepo, conversationID, userID)
+	mockInbox.On("SetArchived", ctx, userID, conversationID, true).Return(nil)
+	mockInbox.On("GetEntry", ctx, userID, conversationID).Return(&models.InboxEntry{
+		UserID:         userID,
+		ConversationID: conversationID,
+		Archived:       true,
+	}, nil)
+
+	usecase := conversation.NewSetArchivedUsecase(mockRepo, mockInbox)
+	entry, err := usecase.Execute(ctx, conversationID, userID, true)
+
+	require.NoError(t, err)
+	assert.True(t, entry.Archived)
+	mockInbox.AssertExpectations(t)
+}
+
+func TestSetArchivedUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := conversation.NewSetArchivedUsecase(mockRepo, mockInbox)
+	entry, err := usecase.Execute(ctx, conversationID, userID, true)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	assert.Nil(t, entry)
+	mockInbox.AssertNotCalled(t, "SetArchived", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetMutedUsecaseExecuteUntil(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+	until := time.Now().Add(time.Hour)
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockSettingsMember(ctx, mockRepo, conversationID, userID)
+	mockInbox.On("SetMutedUntil", ctx, userID, conversationID, until).Return(nil)
+	mockInbox.On("GetEntry", ctx, userID, conversationID).Return(&models.InboxEntry{
+		UserID:         userID,
+		ConversationID: conversationID,
+		MutedUntil:     until,
+	}, nil)
+
+	usecase := conversation.NewSetMutedUsecase(mockRepo, mockInbox)
+	entry, err := usecase.Execute(ctx, conversationID, userID, until)
+
+	require.NoError(t, err)
+	assert.True(t, entry.Muted(time.Now()))
+	assert.False(t, entry.Muted(until.Add(time.Second)))
+	mockInbox.AssertExpectations(t)
+}
+
+func TestSetMutedUsecaseExecuteForever(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockSettingsMember(ctx, mockRepo, conversationID, userID)
+	mockInbox.On("SetMutedUntil", ctx, userID, conversationID, models.MutedForever).Return(nil)
+	mockInbox.On("GetEntry", ctx, userID, conversationID).Return(nil, nil)
+
+	usecase := conversation.NewSetMutedUsecase(mockRepo, mockInbox)
+	// Время за пределами «навсегда» ограничивается им
+	entry, err := usecase.Execute(ctx, conversationID, userID, models.MutedForever.AddDate(1, 0, 0))
+
+	require.NoError(t, err)
+	assert.Equal(t, conversationID, entry.ConversationID)
+	mockInbox.AssertExpectations(t)
+}
+
+func TestSetMutedUsecaseExecuteUnmute(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockSettingsMember(ctx, mockRepo, conversationID, userID)
+	mockInbox.On("SetMutedUntil", ctx, userID, conversationID, time.Time{}).Return(nil)
+	mockInbox.On("GetEntry", ctx, userID, conversationID).Return(&models.InboxEntry{
+		UserID:         userID,
+		ConversationID: conversationID,
+	}, nil)
+
+	usecase := conversation.NewSetMutedUsecase(mockRepo, mockInbox)
+	entry, err := usecase.Execute(ctx, conversationID, userID, time.Time{})
+
+	require.NoError(t, err)
+	assert.False(t, entry.Muted(time.Now()))
+}
+
+func TestSetMutedUsecaseExecutePastTime(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+
+	usecase := conversation.NewSetMutedUsecase(mockRepo, mockInbox)
+	entry, err := usecase.Execute(ctx, conversationID, userID, time.Now().Add(-time.Minute))
+
+	assert.ErrorIs(t, err, conversation.ErrInvalidMuteUntil)
+	assert.Nil(t, entry)
+	mockInbox.AssertNotCalled(t, "SetMutedUntil", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
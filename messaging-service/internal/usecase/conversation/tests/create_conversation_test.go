@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateConversationUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	creatorID := gocql.TimeUUID()
+	memberID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("CreateConversation", ctx, mock.AnythingOfType("*models.Conversation"), mock.AnythingOfType("[]*models.ConversationMember"), mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, mock.AnythingOfType("gocql.UUID"), &models.SystemEvent{
+		Type:      models.SystemEventConversationCreated,
+		ActorID:   creatorID,
+		MemberIDs: []gocql.UUID{memberID},
+	}).Return(&models.Message{}, nil)
+
+	usecase := conversation.NewCreateConversationUsecase(mockRepo, mockSystemEvents)
+	conv, err := usecase.Execute(ctx, creatorID, "team", []gocql.UUID{memberID, creatorID, memberID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ConversationTypeGroup, conv.Type)
+	assert.Equal(t, "team", conv.Title)
+	assert.Equal(t, creatorID, conv.CreatorID)
+	assert.Len(t, conv.Members, 2)
+	assert.Equal(t, creatorID, conv.Members[0].UserID)
+	assert.Equal(t, models.RoleAdmin, conv.Members[0].Role)
+	assert.Equal(t, memberID, conv.Members[1].UserID)
+	assert.Equal(t, models.RoleMember, conv.Members[1].Role)
+	mockRepo.AssertExpectations(t)
+	mockSystemEvents.AssertExpectations(t)
+}
+
+func TestCreateConversationUsecaseExecuteError(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("CreateConversation", ctx, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+
+	usecase := conversation.NewCreateConversationUsecase(mockRepo, mockSystemEvents)
+	conv, err := usecase.Execute(ctx, gocql.TimeUUID(), "team", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, conv)
+	mockRepo.AssertExpectations(t)
+	mockSystemEvents.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+}
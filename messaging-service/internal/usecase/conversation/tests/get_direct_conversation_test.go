@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetDirectConversationUsecaseExecuteExisting(t *testing.T) {
+	ctx := context.Background()
+	userID, peerID := gocql.TimeUUID(), gocql.TimeUUID()
+	conversationID, _ := models.DirectConversationID(userID, peerID)
+	existing := &models.Conversation{ConversationID: conversationID, Type: models.ConversationTypeDirect}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetConversation", ctx, conversationID).Return(existing, nil)
+
+	usecase := conversation.NewGetDirectConversationUsecase(mockRepo)
+	conv, err := usecase.Execute(ctx, userID, peerID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, existing, conv)
+	mockRepo.AssertNotCalled(t, "SaveMembers", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateConversationIfNotExists", mock.Anything, mock.Anything)
+}
+
+func TestGetDirectConversationUsecaseExecuteCreates(t *testing.T) {
+	ctx := context.Background()
+	userID, peerID := gocql.TimeUUID(), gocql.TimeUUID()
+	conversationID, _ := models.DirectConversationID(userID, peerID)
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetConversation", ctx, conversationID).Return(nil, nil)
+	mockRepo.On("SaveMembers", ctx, mock.MatchedBy(func(members []*models.ConversationMember) bool {
+		return len(members) == 2 && members[0].UserID == userID && members[1].UserID == peerID
+	}), mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockRepo.On("CreateConversationIfNotExists", ctx, mock.AnythingOfType("*models.Conversation")).Return(true, nil)
+
+	usecase := conversation.NewGetDirectConversationUsecase(mockRepo)
+	conv, err := usecase.Execute(ctx, userID, peerID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, conversationID, conv.ConversationID)
+	assert.Equal(t, models.ConversationTypeDirect, conv.Type)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDirectConversationIDIsSymmetric(t *testing.T) {
+	userID, peerID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	id1, err := models.DirectConversationID(userID, peerID)
+	assert.NoError(t, err)
+	id2, err := models.DirectConversationID(peerID, userID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, id1, id2)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaveConversationUsecaseExecutePromotesSuccessor(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	now := time.Now()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin, JoinedAt: now.Add(-2 * time.Hour)}
+	oldest := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember, JoinedAt: now.Add(-time.Hour)}
+	newest := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember, JoinedAt: now}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, newest, oldest)
+	mockRepo.On("UpdateMemberRole", ctx, conversationID, oldest.UserID, models.RoleAdmin, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockRepo.On("RemoveMember", ctx, conversationID, admin.UserID, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, conversationID, &models.SystemEvent{
+		Type:      models.SystemEventMemberLeft,
+		ActorID:   admin.UserID,
+		MemberIDs: []gocql.UUID{admin.UserID},
+	}).Return(&models.Message{}, nil)
+
+	usecase := conversation.NewLeaveConversationUsecase(mockRepo, mockSystemEvents)
+	err := usecase.Execute(ctx, conversationID, admin.UserID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockSystemEvents.AssertExpectations(t)
+}
+
+func TestLeaveConversationUsecaseExecuteMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, member)
+	mockRepo.On("RemoveMember", ctx, conversationID, member.UserID, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, conversationID, mock.AnythingOfType("*models.SystemEvent")).Return(nil, errors.New("database error"))
+
+	usecase := conversation.NewLeaveConversationUsecase(mockRepo, mockSystemEvents)
+	err := usecase.Execute(ctx, conversationID, member.UserID)
+
+	// Участник уже вышел, поэтому сбой системного сообщения не возвращается
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLeaveConversationUsecaseExecuteDirect(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetConversation", ctx, conversationID).Return(&models.Conversation{
+		ConversationID: conversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+
+	usecase := conversation.NewLeaveConversationUsecase(mockRepo, new(mocks.PostSystemEventUsecase))
+	err := usecase.Execute(ctx, conversationID, gocql.TimeUUID())
+
+	assert.ErrorIs(t, err, conversation.ErrDirectConversation)
+	mockRepo.AssertNotCalled(t, "RemoveMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
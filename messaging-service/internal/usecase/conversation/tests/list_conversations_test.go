@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// inboxFixture — список бесед пользователя: личная переписка с непрочитанным сообщением и группа без сообщений
+type inboxFixture struct {
+	userID   gocql.UUID
+	peerID   gocql.UUID
+	direct   *models.InboxEntry
+	group    *models.InboxEntry
+	convRepo *mocks.ConversationRepository
+}
+
+func newInboxFixture(ctx context.Context) *inboxFixture {
+	f := &inboxFixture{
+		userID:   gocql.TimeUUID(),
+		peerID:   gocql.TimeUUID(),
+		convRepo: new(mocks.ConversationRepository),
+	}
+	readID := gocql.TimeUUID()
+	lastID := gocql.TimeUUID()
+	f.direct = &models.InboxEntry{
+		UserID:             f.userID,
+		ConversationID:     gocql.TimeUUID(),
+		ActivityID:         lastID,
+		LastMessageID:      lastID,
+		LastSenderID:       f.peerID,
+		LastMessagePreview: "hi",
+		LastReadMessageID:  readID,
+	}
+	f.group = &models.InboxEntry{
+		UserID:         f.userID,
+		ConversationID: gocql.TimeUUID(),
+		ActivityID:     gocql.TimeUUID(),
+	}
+
+	f.convRepo.On("GetConversation", ctx, f.direct.ConversationID).Return(&models.Conversation{
+		ConversationID: f.direct.ConversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	f.convRepo.On("GetMembers", ctx, f.direct.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: f.direct.ConversationID, UserID: f.userID},
+		{ConversationID: f.direct.ConversationID, UserID: f.peerID},
+	}, nil)
+	f.convRepo.On("GetConversation", ctx, f.group.ConversationID).Return(&models.Conversation{
+		ConversationID: f.group.ConversationID,
+		Type:           models.ConversationTypeGroup,
+		Title:          "team",
+	}, nil)
+	return f
+}
+
+func TestListConversationsUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	f := newInboxFixture(ctx)
+
+	mockInbox := new(mocks.InboxRepository)
+	mockInbox.On("GetInbox", ctx, f.userID, gocql.UUID{}, models.InboxMain, 21).Return([]*models.InboxEntry{f.direct, f.group}, nil)
+	mockMessages := new(mocks.MessageRepository)
+	mockMessages.On("CountUnread", ctx, f.direct.ConversationID, f.userID, f.direct.LastReadMessageID, conversation.MaxUnreadCount).Return(3, nil)
+	mockUsers := new(mocks.UserDirectory)
+	mockUsers.On("GetProfiles", ctx, []gocql.UUID{f.peerID}).Return(map[gocql.UUID]*models.UserProfile{
+		f.peerID: {UserID: f.peerID, Nickname: "bob"},
+	}, nil)
+
+	usecase := conversation.NewListConversationsUsecase(mockInbox, f.convRepo, mockMessages, mockUsers)
+	page, err := usecase.Execute(ctx, f.userID, gocql.UUID{}, models.InboxMain, 0)
+
+	require.NoError(t, err)
+	require.Len(t, page.Conversations, 2)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, f.group.ActivityID, page.NextCursor)
+
+	direct := page.Conversations[0]
+	assert.Equal(t, 3, direct.UnreadCount)
+	require.NotNil(t, direct.Peer)
+	assert.Equal(t, "bob", direct.Peer.Nickname)
+
+	group := page.Conversations[1]
+	assert.Zero(t, group.UnreadCount)
+	assert.Nil(t, group.Peer)
+	assert.Equal(t, "team", group.Conversation.Title)
+	mockMessages.AssertNumberOfCalls(t, "CountUnread", 1)
+}
+
+func TestListConversationsUsecaseExecuteHasMore(t *testing.T) {
+	ctx := context.Background()
+	f := newInboxFixture(ctx)
+	cursor := gocql.TimeUUID()
+
+	mockInbox := new(mocks.InboxRepository)
+	mockInbox.On("GetInbox", ctx, f.userID, cursor, models.InboxMain, 2).Return([]*models.InboxEntry{f.group, f.direct}, nil)
+
+	usecase := conversation.NewListConversationsUsecase(mockInbox, f.convRepo, new(mocks.MessageRepository), new(mocks.UserDirectory))
+	page, err := usecase.Execute(ctx, f.userID, cursor, models.InboxMain, 1)
+
+	require.NoError(t, err)
+	require.Len(t, page.Conversations, 1)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, f.group.ActivityID, page.NextCursor)
+}
+
+func TestListConversationsUsecaseExecuteWithoutProfiles(t *testing.T) {
+	ctx := context.Background()
+	f := newInboxFixture(ctx)
+	// Собеседник уже прочитал все, что отправил сам
+	f.direct.LastSenderID = f.userID
+
+	mockInbox := new(mocks.InboxRepository)
+	mockInbox.On("GetInbox", ctx, f.userID, gocql.UUID{}, models.InboxMain, 21).Return([]*models.InboxEntry{f.direct}, nil)
+	mockUsers := new(mocks.UserDirectory)
+	mockUsers.On("GetProfiles", ctx, mock.Anything).Return(map[gocql.UUID]*models.UserProfile{}, assert.AnError)
+
+	usecase := conversation.NewListConversationsUsecase(mockInbox, f.convRepo, new(mocks.MessageRepository), mockUsers)
+	page, err := usecase.Execute(ctx, f.userID, gocql.UUID{}, models.InboxMain, 0)
+
+	// Недоступность user-service не мешает показать список
+	require.NoError(t, err)
+	require.Len(t, page.Conversations, 1)
+	require.NotNil(t, page.Conversations[0].Peer)
+	assert.Equal(t, f.peerID, page.Conversations[0].Peer.UserID)
+	assert.Empty(t, page.Conversations[0].Peer.Nickname)
+	assert.Zero(t, page.Conversations[0].UnreadCount)
+}
+
+func TestListConversationsUsecaseExecuteSkipsUnfinishedConversation(t *testing.T) {
+	ctx := context.Background()
+	f := newInboxFixture(ctx)
+	pending := &models.InboxEntry{UserID: f.userID, ConversationID: gocql.TimeUUID(), ActivityID: gocql.TimeUUID()}
+	f.convRepo.On("GetConversation", ctx, pending.ConversationID).Return(nil, nil)
+
+	mockInbox := new(mocks.InboxRepository)
+	mockInbox.On("GetInbox", ctx, f.userID, gocql.UUID{}, models.InboxMain, 21).Return([]*models.InboxEntry{pending, f.group}, nil)
+
+	usecase := conversation.NewListConversationsUsecase(mockInbox, f.convRepo, new(mocks.MessageRepository), new(mocks.UserDirectory))
+	page, err := usecase.Execute(ctx, f.userID, gocql.UUID{}, models.InboxMain, 0)
+
+	require.NoError(t, err)
+	require.Len(t, page.Conversations, 1)
+	assert.Equal(t, f.group.ConversationID, page.Conversations[0].Conversation.ConversationID)
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPostSystemEventUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+	removedID := gocql.TimeUUID()
+	event := &models.SystemEvent{Type: models.SystemEventMemberRemoved, ActorID: admin.UserID, MemberIDs: []gocql.UUID{removedID}}
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockMessageRepo := new(mocks.MessageRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetMembers", ctx, conversationID).Return([]*models.ConversationMember{admin, member}, nil)
+	var sent pb.MessageSent
+	mockMessageRepo.On("SaveMessage", ctx, mock.AnythingOfType("*models.Message"), mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, proto.Unmarshal(args.Get(2).(*models.OutboxEvent).Payload, &sent))
+		}).Return(nil)
+	// Удаленный участник тоже видит событие о себе
+	mockHub.On("Publish", ctx, mock.AnythingOfType("*models.MessageEvent"), admin.UserID, member.UserID, removedID).Return(nil)
+
+	usecase := conversation.NewPostSystemEventUsecase(mockConvRepo, mockMessageRepo, mockHub)
+	msg, err := usecase.Execute(ctx, conversationID, event)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.ContentSystemEvent, msg.ContentType)
+	assert.Equal(t, admin.UserID, msg.SenderID)
+	assert.Equal(t, event, msg.SystemEvent)
+	assert.True(t, sent.GetSystem())
+	assert.ElementsMatch(t, []string{member.UserID.String(), removedID.String()}, sent.GetRecipientIds())
+	mockMessageRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestPostSystemEventUsecaseExecuteSaveError(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockMessageRepo := new(mocks.MessageRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetMembers", ctx, conversationID).Return([]*models.ConversationMember{admin}, nil)
+	mockMessageRepo.On("SaveMessage", ctx, mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	usecase := conversation.NewPostSystemEventUsecase(mockConvRepo, mockMessageRepo, mockHub)
+	_, err := usecase.Execute(ctx, conversationID, &models.SystemEvent{Type: models.SystemEventMessageTTLChanged, ActorID: admin.UserID})
+
+	assert.Error(t, err)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMessageTTLUsecaseExecuteDirect(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetConversation", ctx, conversationID).Return(&models.Conversation{
+		ConversationID: conversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	mockRepo.On("GetMember", ctx, conversationID, userID).Return(&models.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Role:           models.RoleMember,
+	}, nil)
+	mockRepo.On("SetMessageTTL", ctx, conversationID, 24*time.Hour).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, conversationID, &models.SystemEvent{
+		Type:       models.SystemEventMessageTTLChanged,
+		ActorID:    userID,
+		MessageTTL: 24 * 60 * 60,
+	}).Return(&models.Message{}, nil)
+
+	usecase := conversation.NewSetMessageTTLUsecase(mockRepo, mockSystemEvents)
+	conv, err := usecase.Execute(ctx, conversationID, userID, 24*time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, conv.MessageTTL)
+	mockRepo.AssertExpectations(t)
+	mockSystemEvents.AssertExpectations(t)
+}
+
+func TestSetMessageTTLUsecaseExecuteGroupNotAdmin(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, member)
+	mockRepo.On("SetMessageTTL", ctx, conversationID, time.Duration(0)).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, conversationID, mock.AnythingOfType("*models.SystemEvent")).Return(&models.Message{}, nil)
+
+	usecase := conversation.NewSetMessageTTLUsecase(mockRepo, mockSystemEvents)
+	_, err := usecase.Execute(ctx, conversationID, member.UserID, time.Hour)
+	assert.ErrorIs(t, err, conversation.ErrNotConversationAdmin)
+	mockRepo.AssertNotCalled(t, "SetMessageTTL", mock.Anything, mock.Anything, time.Hour)
+
+	// Администратор отключает таймер
+	conv, err := usecase.Execute(ctx, conversationID, admin.UserID, 0)
+	require.NoError(t, err)
+	assert.Zero(t, conv.MessageTTL)
+}
+
+func TestSetMessageTTLUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetConversation", ctx, conversationID).Return(&models.Conversation{
+		ConversationID: conversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	mockRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := conversation.NewSetMessageTTLUsecase(mockRepo, new(mocks.PostSystemEventUsecase))
+	_, err := usecase.Execute(ctx, conversationID, userID, time.Hour)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockRepo.AssertNotCalled(t, "SetMessageTTL", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetMessageTTLUsecaseExecuteTooShort(t *testing.T) {
+	mockRepo := new(mocks.ConversationRepository)
+
+	usecase := conversation.NewSetMessageTTLUsecase(mockRepo, new(mocks.PostSystemEventUsecase))
+	_, err := usecase.Execute(context.Background(), gocql.TimeUUID(), gocql.TimeUUID(), 30*time.Second)
+
+	assert.ErrorIs(t, err, conversation.ErrInvalidMessageTTL)
+	mockRepo.AssertNotCalled(t, "GetConversation", mock.Anything, mock.Anything)
+}
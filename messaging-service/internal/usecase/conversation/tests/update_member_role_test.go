@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockGroup настраивает групповую беседу с указанными участниками
+func mockGroup(ctx context.Context, repo *mocks.ConversationRepository, conversationID gocql.UUID, members ...*models.ConversationMember) {
+	repo.On("GetConversation", ctx, conversationID).Return(&models.Conversation{
+		ConversationID: conversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	repo.On("GetMembers", ctx, conversationID).Return(members, nil)
+	for _, member := range members {
+		repo.On("GetMember", ctx, conversationID, member.UserID).Return(member, nil).Maybe()
+	}
+}
+
+func TestUpdateMemberRoleUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, member)
+	mockRepo.On("UpdateMemberRole", ctx, conversationID, member.UserID, models.RoleAdmin, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+
+	usecase := conversation.NewUpdateMemberRoleUsecase(mockRepo)
+	err := usecase.Execute(ctx, conversationID, admin.UserID, member.UserID, models.RoleAdmin)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateMemberRoleUsecaseExecuteNotAdmin(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, member)
+
+	usecase := conversation.NewUpdateMemberRoleUsecase(mockRepo)
+	err := usecase.Execute(ctx, conversationID, member.UserID, member.UserID, models.RoleAdmin)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationAdmin)
+	mockRepo.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMemberRoleUsecaseExecuteLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	admin := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleAdmin}
+	member := &models.ConversationMember{ConversationID: conversationID, UserID: gocql.TimeUUID(), Role: models.RoleMember}
+
+	mockRepo := new(mocks.ConversationRepository)
+	mockGroup(ctx, mockRepo, conversationID, admin, member)
+
+	usecase := conversation.NewUpdateMemberRoleUsecase(mockRepo)
+	err := usecase.Execute(ctx, conversationID, admin.UserID, admin.UserID, models.RoleMember)
+
+	assert.ErrorIs(t, err, conversation.ErrLastAdmin)
+	mockRepo.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,62 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type UpdateMemberRoleUsecase interface {
+	Execute(ctx context.Context, conversationID, userID, memberID gocql.UUID, role models.MemberRole) error
+}
+
+type updateMemberRoleUsecase struct {
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewUpdateMemberRoleUsecase(conversationRepo repositories.ConversationRepository) UpdateMemberRoleUsecase {
+	return &updateMemberRoleUsecase{
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *updateMemberRoleUsecase) Execute(ctx context.Context, conversationID, userID, memberID gocql.UUID, role models.MemberRole) error {
+	if _, err := requireGroupAdmin(ctx, uc.conversationRepo, conversationID, userID); err != nil {
+		return err
+	}
+
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	var member *models.ConversationMember
+	for _, m := range members {
+		if m.UserID == memberID {
+			member = m
+			break
+		}
+	}
+	if member == nil {
+		return ErrMemberNotFound
+	}
+	if member.Role == role {
+		return nil
+	}
+
+	// Беседа не должна остаться без администратора
+	if member.Role == models.RoleAdmin && countAdmins(members) == 1 {
+		return ErrLastAdmin
+	}
+
+	updated := *member
+	updated.Role = role
+	event, err := events.NewConversationMembersChangedEvent(conversationID, userID, models.MembershipRoleChanged, []*models.ConversationMember{&updated})
+	if err != nil {
+		return err
+	}
+	return uc.conversationRepo.UpdateMemberRole(ctx, conversationID, memberID, role, event)
+}
@@ -0,0 +1,9 @@
+package keys
+
+import "errors"
+
+var (
+	ErrDeviceNotFound = errors.New("device not found")
+	ErrIncompleteKeys = errors.New("identity key and signed pre-key are required for a new device")
+	ErrTooManyPreKeys = errors.New("too many one-time pre-keys for the device")
+)
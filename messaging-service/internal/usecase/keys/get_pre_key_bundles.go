@@ -0,0 +1,75 @@
+package keys
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetPreKeyBundlesUsecase interface {
+	// Execute выдает наборы ключей всех устройств пользователя, расходуя по одноразовому ключу каждого
+	Execute(ctx context.Context, userID gocql.UUID) ([]*models.PreKeyBundle, error)
+}
+
+type getPreKeyBundlesUsecase struct {
+	keyRepo repositories.KeyRepository
+	hub     events.Hub
+}
+
+func NewGetPreKeyBundlesUsecase(
+	keyRepo repositories.KeyRepository,
+	hub events.Hub,
+) GetPreKeyBundlesUsecase {
+	return &getPreKeyBundlesUsecase{
+		keyRepo: keyRepo,
+		hub:     hub,
+	}
+}
+
+func (uc *getPreKeyBundlesUsecase) Execute(ctx context.Context, userID gocql.UUID) ([]*models.PreKeyBundle, error) {
+	devices, err := uc.keyRepo.GetDevices(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make([]*models.PreKeyBundle, 0, len(devices))
+	for _, device := range devices {
+		preKey, err := uc.keyRepo.ConsumeOneTimePreKey(ctx, userID, device.DeviceID)
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, &models.PreKeyBundle{
+			Device:        device,
+			OneTimePreKey: preKey,
+		})
+		uc.warnIfLow(ctx, device)
+	}
+	return bundles, nil
+}
+
+// warnIfLow напоминает владельцу устройства пополнить одноразовые ключи.
+// Ключи уже выданы, поэтому ошибки только записываются в журнал
+func (uc *getPreKeyBundlesUsecase) warnIfLow(ctx context.Context, device *models.DeviceKeys) {
+	remaining, err := uc.keyRepo.CountOneTimePreKeys(ctx, device.UserID, device.DeviceID)
+	if err != nil {
+		log.Printf("error counting pre-keys of device %s: %v", device.DeviceID, err)
+		return
+	}
+	status := preKeyStatus(device.UserID, device.DeviceID, remaining)
+	if !status.Replenish {
+		return
+	}
+	event := &models.MessageEvent{
+		Type:      models.EventPreKeysLow,
+		PreKeys:   status,
+		Timestamp: time.Now(),
+	}
+	if err := uc.hub.Publish(ctx, event, device.UserID); err != nil {
+		log.Printf("error publishing pre-key warning for device %s: %v", device.DeviceID, err)
+	}
+}
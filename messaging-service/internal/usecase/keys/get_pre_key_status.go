@@ -0,0 +1,39 @@
+package keys
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetPreKeyStatusUsecase interface {
+	Execute(ctx context.Context, userID, deviceID gocql.UUID) (*models.PreKeyStatus, error)
+}
+
+type getPreKeyStatusUsecase struct {
+	keyRepo repositories.KeyRepository
+}
+
+func NewGetPreKeyStatusUsecase(keyRepo repositories.KeyRepository) GetPreKeyStatusUsecase {
+	return &getPreKeyStatusUsecase{
+		keyRepo: keyRepo,
+	}
+}
+
+func (uc *getPreKeyStatusUsecase) Execute(ctx context.Context, userID, deviceID gocql.UUID) (*models.PreKeyStatus, error) {
+	device, err := uc.keyRepo.GetDevice(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if device == nil {
+		return nil, ErrDeviceNotFound
+	}
+
+	remaining, err := uc.keyRepo.CountOneTimePreKeys(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	return preKeyStatus(userID, deviceID, remaining), nil
+}
@@ -0,0 +1,22 @@
+package keys
+
+import (
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+const (
+	// MaxOneTimePreKeys — сколько одноразовых ключей может хранить одно устройство
+	MaxOneTimePreKeys = 500
+	// LowPreKeyThreshold — при меньшем остатке устройство получает предупреждение о пополнении ключей
+	LowPreKeyThreshold = 10
+)
+
+func preKeyStatus(userID, deviceID gocql.UUID, remaining int) *models.PreKeyStatus {
+	return &models.PreKeyStatus{
+		UserID:    userID,
+		DeviceID:  deviceID,
+		Remaining: remaining,
+		Replenish: remaining < LowPreKeyThreshold,
+	}
+}
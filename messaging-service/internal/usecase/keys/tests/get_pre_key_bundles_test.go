@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreKeyBundlesUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	phone := &models.DeviceKeys{UserID: userID, DeviceID: gocql.TimeUUID()}
+	laptop := &models.DeviceKeys{UserID: userID, DeviceID: gocql.TimeUUID()}
+	preKey := &models.OneTimePreKey{KeyID: 7, PublicKey: []byte("key")}
+
+	mockRepo := new(mocks.KeyRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetDevices", ctx, userID).Return([]*models.DeviceKeys{phone, laptop}, nil)
+	mockRepo.On("ConsumeOneTimePreKey", ctx, userID, phone.DeviceID).Return(preKey, nil)
+	// У ноутбука ключи закончились, набор выдается без одноразового ключа
+	mockRepo.On("ConsumeOneTimePreKey", ctx, userID, laptop.DeviceID).Return(nil, nil)
+	mockRepo.On("CountOneTimePreKeys", ctx, userID, phone.DeviceID).Return(keys.LowPreKeyThreshold, nil)
+	mockRepo.On("CountOneTimePreKeys", ctx, userID, laptop.DeviceID).Return(0, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventPreKeysLow && event.PreKeys.DeviceID == laptop.DeviceID && event.PreKeys.Replenish
+	}), userID).Return(nil).Once()
+
+	usecase := keys.NewGetPreKeyBundlesUsecase(mockRepo, mockHub)
+	bundles, err := usecase.Execute(ctx, userID)
+
+	require.NoError(t, err)
+	require.Len(t, bundles, 2)
+	assert.Equal(t, phone, bundles[0].Device)
+	assert.Equal(t, preKey, bundles[0].OneTimePreKey)
+	assert.Nil(t, bundles[1].OneTimePreKey)
+	mockHub.AssertExpectations(t)
+}
+
+func TestGetPreKeyBundlesUsecaseExecuteNoDevices(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevices", ctx, userID).Return(nil, nil)
+
+	usecase := keys.NewGetPreKeyBundlesUsecase(mockRepo, new(mocks.Hub))
+	bundles, err := usecase.Execute(ctx, userID)
+
+	require.NoError(t, err)
+	assert.Empty(t, bundles)
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreKeyStatusUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID, deviceID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, userID, deviceID).Return(&models.DeviceKeys{UserID: userID, DeviceID: deviceID}, nil)
+	mockRepo.On("CountOneTimePreKeys", ctx, userID, deviceID).Return(42, nil)
+
+	usecase := keys.NewGetPreKeyStatusUsecase(mockRepo)
+	status, err := usecase.Execute(ctx, userID, deviceID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, status.Remaining)
+	assert.False(t, status.Replenish)
+}
+
+func TestGetPreKeyStatusUsecaseExecuteDeviceNotFound(t *testing.T) {
+	ctx := context.Background()
+	userID, deviceID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, userID, deviceID).Return(nil, nil)
+
+	usecase := keys.NewGetPreKeyStatusUsecase(mockRepo)
+	_, err := usecase.Execute(ctx, userID, deviceID)
+
+	assert.ErrorIs(t, err, keys.ErrDeviceNotFound)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/keys/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUpload(preKeys int) *models.KeyUpload {
+	upload := &models.KeyUpload{
+		UserID:      gocql.TimeUUID(),
+		DeviceID:    gocql.TimeUUID(),
+		IdentityKey: bytes.Repeat([]byte{1}, 32),
+		SignedPreKey: &models.SignedPreKey{
+			KeyID:     1,
+			PublicKey: bytes.Repeat([]byte{2}, 32),
+			Signature: bytes.Repeat([]byte{3}, 64),
+		},
+	}
+	for i := 0; i < preKeys; i++ {
+		upload.OneTimePreKeys = append(upload.OneTimePreKeys, &models.OneTimePreKey{
+			KeyID:     uint32(i + 1),
+			PublicKey: bytes.Repeat([]byte{4}, 32),
+		})
+	}
+	return upload
+}
+
+func TestUploadKeysUsecaseExecuteNewDevice(t *testing.T) {
+	ctx := context.Background()
+	upload := newTestUpload(20)
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, upload.UserID, upload.DeviceID).Return(nil, nil)
+	mockRepo.On("SaveDevice", ctx, mock.MatchedBy(func(device *models.DeviceKeys) bool {
+		return device.DeviceID == upload.DeviceID && device.SignedPreKey == upload.SignedPreKey
+	}), false).Return(nil)
+	mockRepo.On("AddOneTimePreKeys", ctx, upload.UserID, upload.DeviceID, upload.OneTimePreKeys).Return(nil)
+
+	usecase := keys.NewUploadKeysUsecase(mockRepo)
+	status, err := usecase.Execute(ctx, upload)
+
+	require.NoError(t, err)
+	assert.Equal(t, 20, status.Remaining)
+	assert.False(t, status.Replenish)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUploadKeysUsecaseExecuteNewDeviceIncomplete(t *testing.T) {
+	ctx := context.Background()
+	upload := newTestUpload(5)
+	upload.SignedPreKey = nil
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, upload.UserID, upload.DeviceID).Return(nil, nil)
+
+	usecase := keys.NewUploadKeysUsecase(mockRepo)
+	_, err := usecase.Execute(ctx, upload)
+
+	assert.ErrorIs(t, err, keys.ErrIncompleteKeys)
+	mockRepo.AssertNotCalled(t, "AddOneTimePreKeys", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadKeysUsecaseExecuteReplenish(t *testing.T) {
+	ctx := context.Background()
+	upload := newTestUpload(5)
+	device := &models.DeviceKeys{
+		UserID:       upload.UserID,
+		DeviceID:     upload.DeviceID,
+		IdentityKey:  upload.IdentityKey,
+		SignedPreKey: upload.SignedPreKey,
+	}
+	// Пополнение известного устройства передает только одноразовые ключи
+	upload.IdentityKey = nil
+	upload.SignedPreKey = nil
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, upload.UserID, upload.DeviceID).Return(device, nil)
+	mockRepo.On("CountOneTimePreKeys", ctx, upload.UserID, upload.DeviceID).Return(3, nil)
+	mockRepo.On("AddOneTimePreKeys", ctx, upload.UserID, upload.DeviceID, upload.OneTimePreKeys).Return(nil)
+
+	usecase := keys.NewUploadKeysUsecase(mockRepo)
+	status, err := usecase.Execute(ctx, upload)
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, status.Remaining)
+	assert.True(t, status.Replenish)
+	mockRepo.AssertNotCalled(t, "SaveDevice", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadKeysUsecaseExecuteNewIdentityKey(t *testing.T) {
+	ctx := context.Background()
+	upload := newTestUpload(10)
+	device := &models.DeviceKeys{
+		UserID:      upload.UserID,
+		DeviceID:    upload.DeviceID,
+		IdentityKey: bytes.Repeat([]byte{9}, 32),
+	}
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, upload.UserID, upload.DeviceID).Return(device, nil)
+	// Прежние одноразовые ключи удаляются, поэтому остаток не учитывается
+	mockRepo.On("SaveDevice", ctx, mock.AnythingOfType("*models.DeviceKeys"), true).Return(nil)
+	mockRepo.On("AddOneTimePreKeys", ctx, upload.UserID, upload.DeviceID, upload.OneTimePreKeys).Return(nil)
+
+	usecase := keys.NewUploadKeysUsecase(mockRepo)
+	status, err := usecase.Execute(ctx, upload)
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, status.Remaining)
+	assert.Equal(t, upload.IdentityKey, device.IdentityKey)
+	mockRepo.AssertNotCalled(t, "CountOneTimePreKeys", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadKeysUsecaseExecuteTooManyPreKeys(t *testing.T) {
+	ctx := context.Background()
+	upload := newTestUpload(100)
+	upload.IdentityKey = nil
+	upload.SignedPreKey = nil
+
+	mockRepo := new(mocks.KeyRepository)
+	mockRepo.On("GetDevice", ctx, upload.UserID, upload.DeviceID).Return(&models.DeviceKeys{
+		UserID:   upload.UserID,
+		DeviceID: upload.DeviceID,
+	}, nil)
+	mockRepo.On("CountOneTimePreKeys", ctx, upload.UserID, upload.DeviceID).Return(keys.MaxOneTimePreKeys-50, nil)
+
+	usecase := keys.NewUploadKeysUsecase(mockRepo)
+	_, err := usecase.Execute(ctx, upload)
+
+	assert.ErrorIs(t, err, keys.ErrTooManyPreKeys)
+	mockRepo.AssertNotCalled(t, "AddOneTimePreKeys", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
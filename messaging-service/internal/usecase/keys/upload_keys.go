@@ -0,0 +1,73 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type UploadKeysUsecase interface {
+	// Execute сохраняет ключи устройства и возвращает остаток его одноразовых ключей
+	Execute(ctx context.Context, upload *models.KeyUpload) (*models.PreKeyStatus, error)
+}
+
+type uploadKeysUsecase struct {
+	keyRepo repositories.KeyRepository
+}
+
+func NewUploadKeysUsecase(keyRepo repositories.KeyRepository) UploadKeysUsecase {
+	return &uploadKeysUsecase{
+		keyRepo: keyRepo,
+	}
+}
+
+func (uc *uploadKeysUsecase) Execute(ctx context.Context, upload *models.KeyUpload) (*models.PreKeyStatus, error) {
+	device, err := uc.keyRepo.GetDevice(ctx, upload.UserID, upload.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Новый ключ идентичности означает переустановку: прежние ключи подписаны другим ключом
+	reset := device != nil && len(upload.IdentityKey) > 0 && !bytes.Equal(upload.IdentityKey, device.IdentityKey)
+	if (device == nil || reset) && (len(upload.IdentityKey) == 0 || upload.SignedPreKey == nil) {
+		return nil, ErrIncompleteKeys
+	}
+
+	remaining := 0
+	if device != nil && !reset {
+		remaining, err = uc.keyRepo.CountOneTimePreKeys(ctx, upload.UserID, upload.DeviceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if remaining+len(upload.OneTimePreKeys) > MaxOneTimePreKeys {
+		return nil, ErrTooManyPreKeys
+	}
+
+	if device == nil || reset || upload.SignedPreKey != nil {
+		if device == nil {
+			device = &models.DeviceKeys{
+				UserID:   upload.UserID,
+				DeviceID: upload.DeviceID,
+			}
+		}
+		if len(upload.IdentityKey) > 0 {
+			device.IdentityKey = upload.IdentityKey
+		}
+		if upload.SignedPreKey != nil {
+			device.SignedPreKey = upload.SignedPreKey
+		}
+		device.UpdatedAt = time.Now()
+		if err := uc.keyRepo.SaveDevice(ctx, device, reset); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.keyRepo.AddOneTimePreKeys(ctx, upload.UserID, upload.DeviceID, upload.OneTimePreKeys); err != nil {
+		return nil, err
+	}
+	return preKeyStatus(upload.UserID, upload.DeviceID, remaining+len(upload.OneTimePreKeys)), nil
+}
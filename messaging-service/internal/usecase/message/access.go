@@ -0,0 +1,35 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+// getMessageForMember возвращает сообщение, если пользователь состоит в его беседе
+func getMessageForMember(
+	ctx context.Context,
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	messageID, userID gocql.UUID,
+) (*models.Message, error) {
+	message, err := messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, ErrMessageNotFound
+	}
+
+	member, err := conversationRepo.GetMember(ctx, message.ConversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+	return message, nil
+}
@@ -0,0 +1,88 @@
+package message
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type AddReactionUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID, emoji string) ([]*models.ReactionSummary, error)
+}
+
+type addReactionUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	reactionRepo     repositories.ReactionRepository
+	hub              events.Hub
+}
+
+func NewAddReactionUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	reactionRepo repositories.ReactionRepository,
+	hub events.Hub,
+) AddReactionUsecase {
+	return &addReactionUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reactionRepo:     reactionRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *addReactionUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, emoji string) ([]*models.ReactionSummary, error) {
+	if !validEmoji(emoji) {
+		return nil, ErrInvalidEmoji
+	}
+
+	message, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if message.Deleted {
+		return nil, ErrMessageDeleted
+	}
+
+	reaction := &models.Reaction{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}
+	added, err := uc.reactionRepo.AddReaction(ctx, reaction, message.TTL(reaction.CreatedAt))
+	if err != nil {
+		return nil, err
+	}
+
+	// Повторная реакция того же вида ничего не меняет и не рассылается
+	if added {
+		publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+			Type:     models.EventReactionAdded,
+			Message:  message,
+			Reaction: reaction,
+		})
+	}
+
+	summaries, err := uc.reactionRepo.GetSummaries(ctx, []gocql.UUID{messageID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return summaries[messageID], nil
+}
+
+// Реакция — короткая последовательность символов без пробелов и управляющих символов
+func validEmoji(emoji string) bool {
+	if emoji == "" {
+		return false
+	}
+	return !strings.ContainsFunc(emoji, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	})
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type CancelScheduledMessageUsecase interface {
+	// Execute отменяет отправку. Сообщение, которое уже отправляется, отменить нельзя
+	Execute(ctx context.Context, userID, scheduledID gocql.UUID) error
+}
+
+type cancelScheduledMessageUsecase struct {
+	scheduledRepo repositories.ScheduledMessageRepository
+}
+
+func NewCancelScheduledMessageUsecase(scheduledRepo repositories.ScheduledMessageRepository) CancelScheduledMessageUsecase {
+	return &cancelScheduledMessageUsecase{
+		scheduledRepo: scheduledRepo,
+	}
+}
+
+func (uc *cancelScheduledMessageUsecase) Execute(ctx context.Context, userID, scheduledID gocql.UUID) error {
+	// Сообщения хранятся по отправителю, поэтому чужое сообщение просто не находится
+	scheduled, err := uc.scheduledRepo.GetScheduledMessage(ctx, userID, scheduledID)
+	if err != nil {
+		return err
+	}
+	if scheduled == nil {
+		return ErrScheduledNotFound
+	}
+
+	cancelled, err := uc.scheduledRepo.CancelScheduledMessage(ctx, scheduled)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrScheduledSending
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package message
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+const (
+	// MaxTextEntities — сколько оформленных фрагментов может быть в тексте сообщения
+	MaxTextEntities = 100
+	// Максимальная длина языка блока кода
+	maxEntityLanguageLength = 32
+)
+
+// validateContent проверяет содержимое сообщения по его типу. Сообщение без типа считается текстовым.
+// Вложение изображения или файла проверяется при привязке вложений
+func validateContent(message *models.Message) error {
+	if message.ContentType == models.ContentTypeUnspecified {
+		message.ContentType = models.ContentText
+	}
+	// Содержимое зашифрованного сообщения сервису недоступно, поэтому его тип не различается
+	if message.Encrypted() && (message.ContentType != models.ContentText || len(message.Entities) > 0) {
+		return ErrInvalidContent
+	}
+
+	switch message.ContentType {
+	case models.ContentText:
+		return validateEntities(message.Content, message.Entities)
+	case models.ContentImage, models.ContentFile:
+		if len(message.Entities) > 0 {
+			return ErrInvalidEntities
+		}
+		if message.ContentAttachmentID == (gocql.UUID{}) || message.ContentAttachment() == nil {
+			return ErrContentAttachment
+		}
+		return nil
+	case models.ContentLocation:
+		if !emptyText(message) {
+			return ErrInvalidContent
+		}
+		location := message.Location
+		if location == nil ||
+			location.Latitude < -90 || location.Latitude > 90 ||
+			location.Longitude < -180 || location.Longitude > 180 {
+			return ErrInvalidLocation
+		}
+		return nil
+	case models.ContentContact:
+		if !emptyText(message) {
+			return ErrInvalidContent
+		}
+		contact := message.Contact
+		if contact == nil || strings.TrimSpace(contact.Name) == "" ||
+			(contact.PhoneNumber == "" && contact.Email == "" && contact.UserID == (gocql.UUID{})) {
+			return ErrInvalidContact
+		}
+		return nil
+	case models.ContentSystemEvent:
+		return ErrSystemContent
+	}
+	return ErrInvalidContent
+}
+
+// emptyText сообщает, что у сообщения нет текста, оформления и вложений
+func emptyText(message *models.Message) bool {
+	return message.Content == "" && len(message.Entities) == 0 && len(message.Attachments) == 0
+}
+
+// validateEntities проверяет, что оформленные фрагменты лежат внутри текста.
+// Фрагменты могут вкладываться друг в друга, адрес есть только у ссылки, язык — только у блока кода
+func validateEntities(text string, entities []*models.TextEntity) error {
+	if len(entities) > MaxTextEntities {
+		return ErrInvalidEntities
+	}
+	textLength := utf8.RuneCountInString(text)
+	for _, entity := range entities {
+		if entity.Type < models.TextEntityBold || entity.Type > models.TextEntitySpoiler ||
+			entity.Offset < 0 || entity.Length <= 0 || entity.Offset+entity.Length > textLength {
+			return ErrInvalidEntities
+		}
+		if (entity.Type == models.TextEntityTextLink) != (entity.URL != "") || !validLinkURL(entity) {
+			return ErrInvalidEntities
+		}
+		if entity.Language != "" && (entity.Type != models.TextEntityPre || len(entity.Language) > maxEntityLanguageLength) {
+			return ErrInvalidEntities
+		}
+	}
+	return nil
+}
+
+// validLinkURL допускает в ссылках только адреса http и https
+func validLinkURL(entity *models.TextEntity) bool {
+	if entity.URL == "" {
+		return true
+	}
+	parsed, err := url.Parse(entity.URL)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// checkContentAttachment проверяет, что вложение сообщения с изображением — изображение
+func checkContentAttachment(message *models.Message, attachments []*models.Attachment) error {
+	if message.ContentType != models.ContentImage {
+		return nil
+	}
+	for _, found := range attachments {
+		if found.AttachmentID == message.ContentAttachmentID {
+			if !strings.HasPrefix(found.MimeType, "image/") {
+				return ErrAttachmentNotImage
+			}
+			return nil
+		}
+	}
+	return ErrContentAttachment
+}
@@ -0,0 +1,95 @@
+package message
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type DeleteMessageUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID, mode models.DeleteMode) error
+}
+
+type deleteMessageUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+	pinRepo          repositories.PinRepository
+	hub              events.Hub
+}
+
+func NewDeleteMessageUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	pinRepo repositories.PinRepository,
+	hub events.Hub,
+) DeleteMessageUsecase {
+	return &deleteMessageUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+		pinRepo:          pinRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *deleteMessageUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, mode models.DeleteMode) error {
+	message, err := uc.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return ErrMessageNotFound
+	}
+	if message.SenderID != userID {
+		return ErrNotMessageSender
+	}
+
+	// Сообщение удаляется как для всех, его текст больше не доступен
+	message.Content = ""
+	message.Mentions = nil
+	message.Deleted = true
+
+	if mode == models.DeleteModeForMe {
+		if err := uc.messageRepo.HideMessage(ctx, userID, message); err != nil {
+			return err
+		}
+
+		// Остальные устройства пользователя скрывают сообщение, у собеседников оно остается
+		event := &models.MessageEvent{
+			Type:      models.EventMessageDeleted,
+			Message:   message,
+			Timestamp: time.Now(),
+		}
+		if err := uc.hub.Publish(ctx, event, userID); err != nil {
+			log.Printf("error publishing deletion of message %s: %v", messageID, err)
+		}
+		return nil
+	}
+
+	outboxEvent, err := events.NewMessageDeletedEvent(message)
+	if err != nil {
+		return err
+	}
+	if err := uc.messageRepo.DeleteMessage(ctx, message, outboxEvent); err != nil {
+		return err
+	}
+	// Удаленное сообщение не занимает место среди закрепленных, клиенты убирают его по событию удаления
+	if _, err := uc.pinRepo.UnpinMessage(ctx, message.ConversationID, messageID); err != nil {
+		log.Printf("error unpinning deleted message %s: %v", messageID, err)
+	}
+
+	memberIDs := publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+		Type:    models.EventMessageDeleted,
+		Message: message,
+	})
+	updateInboxPreview(ctx, uc.inboxRepo, memberIDs, message)
+
+	return nil
+}
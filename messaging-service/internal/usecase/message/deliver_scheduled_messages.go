@@ -0,0 +1,155 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+const (
+	// ScheduleLookback — за какой срок планировщик просматривает пропущенные отправки,
+	// например после простоя сервиса
+	ScheduleLookback = 7 * 24 * time.Hour
+	// ScheduledClaimTimeout — через сколько отправку, захваченную упавшей репликой, можно захватить снова
+	ScheduledClaimTimeout = time.Minute
+	// scheduleBucketGrace — запас на расхождение часов реплик, после которого прошедший час очереди
+	// считается закрытым для новых отправок
+	scheduleBucketGrace = time.Minute
+)
+
+// DeliverScheduledMessagesUsecase отправляет запланированные сообщения, время которых наступило.
+// Планировщик работает на всех репликах, захват отправки гарантирует, что сообщение отправится один раз
+type DeliverScheduledMessagesUsecase interface {
+	// Execute отправляет сообщения, запланированные до момента now, и возвращает их количество.
+	// Прошедшие часы очереди, оказавшиеся пустыми, при следующих вызовах не просматриваются
+	Execute(ctx context.Context, now time.Time) (int, error)
+}
+
+type deliverScheduledMessagesUsecase struct {
+	scheduledRepo repositories.ScheduledMessageRepository
+	messageRepo   repositories.MessageRepository
+	sendMessage   SendMessageUsecase
+
+	mu sync.Mutex
+	// sweptUntil — первый час очереди, который еще может содержать отправки. Более ранние часы
+	// прошли и пусты, а новые отправки планируются только на будущее
+	sweptUntil time.Time
+}
+
+func NewDeliverScheduledMessagesUsecase(
+	scheduledRepo repositories.ScheduledMessageRepository,
+	messageRepo repositories.MessageRepository,
+	sendMessage SendMessageUsecase,
+) DeliverScheduledMessagesUsecase {
+	return &deliverScheduledMessagesUsecase{
+		scheduledRepo: scheduledRepo,
+		messageRepo:   messageRepo,
+		sendMessage:   sendMessage,
+	}
+}
+
+func (uc *deliverScheduledMessagesUsecase) Execute(ctx context.Context, now time.Time) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	first := models.ScheduleBucket(now.Add(-ScheduleLookback))
+	if first.Before(uc.sweptUntil) {
+		first = uc.sweptUntil
+	}
+	last := models.ScheduleBucket(now)
+
+	delivered := 0
+	swept := true
+	for bucket := first; !bucket.After(last); bucket = bucket.Add(time.Hour) {
+		entries, err := uc.scheduledRepo.GetDueEntries(ctx, bucket, now)
+		if err != nil {
+			return delivered, err
+		}
+		// Отметка сдвигается только по непрерывному началу пустых прошедших часов. Час с записями
+		// просматривается снова, пока все его отправки не завершатся
+		if swept && len(entries) == 0 && !bucket.Add(time.Hour+scheduleBucketGrace).After(now) {
+			uc.sweptUntil = bucket.Add(time.Hour)
+		} else {
+			swept = false
+		}
+		for _, entry := range entries {
+			// Ошибка одного сообщения не задерживает остальные, его отправку повторят после истечения захвата
+			sent, err := uc.deliver(ctx, entry, now)
+			if err != nil {
+				log.Printf("error delivering scheduled message %s: %v", entry.ScheduledID, err)
+				continue
+			}
+			if sent {
+				delivered++
+			}
+		}
+	}
+	return delivered, nil
+}
+
+// deliver отправляет сообщение записи очереди и сообщает, было ли оно отправлено этой репликой
+func (uc *deliverScheduledMessagesUsecase) deliver(ctx context.Context, entry *models.ScheduledEntry, now time.Time) (bool, error) {
+	scheduled, err := uc.scheduledRepo.GetScheduledMessage(ctx, entry.SenderID, entry.ScheduledID)
+	if err != nil {
+		return false, err
+	}
+	// Сообщение отменено, перенесено или уже не отправилось
+	if scheduled == nil || !scheduled.SendAt.Equal(entry.SendAt) || scheduled.State == models.ScheduledFailed {
+		return false, uc.scheduledRepo.DeleteEntry(ctx, entry)
+	}
+	// Отправку ведет другая реплика
+	if scheduled.State == models.ScheduledSending && now.Sub(scheduled.ClaimedAt) < ScheduledClaimTimeout {
+		return false, nil
+	}
+
+	// Идентификатор сообщения сохраняется при захвате: если реплика упала после отправки,
+	// следующая найдет сообщение и не отправит его второй раз
+	if scheduled.MessageID == (gocql.UUID{}) {
+		scheduled.MessageID = gocql.TimeUUID()
+	}
+	claimed, err := uc.scheduledRepo.ClaimScheduledMessage(ctx, scheduled, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	existing, err := uc.messageRepo.GetMessageByID(ctx, scheduled.MessageID)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil {
+		return false, uc.scheduledRepo.CompleteScheduledMessage(ctx, scheduled)
+	}
+
+	if err := uc.sendMessage.Execute(ctx, scheduled.Message(now)); err != nil {
+		if !permanentSendError(err) {
+			return false, err
+		}
+		log.Printf("scheduled message %s was not sent: %v", scheduled.ScheduledID, err)
+		return false, uc.scheduledRepo.FailScheduledMessage(ctx, scheduled, err.Error())
+	}
+	return true, uc.scheduledRepo.CompleteScheduledMessage(ctx, scheduled)
+}
+
+// permanentSendError сообщает, что повторная отправка не поможет: отправитель потерял доступ к беседе,
+// получатель не принимает сообщения, исходное сообщение ответа удалено или вложения больше недоступны
+func permanentSendError(err error) bool {
+	return errors.Is(err, conversation.ErrConversationNotFound) ||
+		errors.Is(err, conversation.ErrNotConversationMember) ||
+		errors.Is(err, ErrBlockedByRecipient) ||
+		errors.Is(err, ErrRecipientFriendsOnly) ||
+		errors.Is(err, ErrRecipientNotAccepting) ||
+		errors.Is(err, ErrReplyToNotFound) ||
+		errors.Is(err, ErrReplyToForeign) ||
+		errors.Is(err, ErrMessageDeleted) ||
+		errors.Is(err, attachment.ErrAttachmentNotFound) ||
+		errors.Is(err, attachment.ErrNotAttachmentUploader) ||
+		errors.Is(err, attachment.ErrAttachmentAlreadySent)
+}
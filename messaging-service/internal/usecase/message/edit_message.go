@@ -0,0 +1,144 @@
+package message
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type EditMessageUsecase interface {
+	// Execute заменяет текст сообщения и его оформление, у изображения и файла — подпись
+	Execute(ctx context.Context, messageID, userID gocql.UUID, content string, entities []*models.TextEntity) (*models.Message, error)
+}
+
+type editMessageUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+	users            clients.UserDirectory
+	hub              events.Hub
+}
+
+func NewEditMessageUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	users clients.UserDirectory,
+	hub events.Hub,
+) EditMessageUsecase {
+	return &editMessageUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+		users:            users,
+		hub:              hub,
+	}
+}
+
+func (uc *editMessageUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, content string, entities []*models.TextEntity) (*models.Message, error) {
+	message, err := uc.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, ErrMessageNotFound
+	}
+	if message.SenderID != userID {
+		return nil, ErrNotMessageSender
+	}
+	if message.Deleted {
+		return nil, ErrMessageDeleted
+	}
+	// Сервер не может зашифровать новый текст, а открытый текст раскрыл бы переписку
+	if message.Encrypted() {
+		return nil, ErrMessageEncrypted
+	}
+	if err := validateEdit(message, content, entities); err != nil {
+		return nil, err
+	}
+	if message.Content == content && sameEntities(message.Entities, entities) {
+		return message, nil
+	}
+
+	now := time.Now()
+	previous := &models.MessageEdit{
+		MessageID: messageID,
+		Content:   message.Content,
+		EditedAt:  now,
+	}
+	message.Content = content
+	message.Entities = entities
+	message.EditedAt = now
+	message.Mentions = uc.resolveMentions(ctx, message)
+
+	outboxEvent, err := events.NewMessageEditedEvent(message)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.messageRepo.EditMessage(ctx, message, previous, outboxEvent); err != nil {
+		return nil, err
+	}
+
+	memberIDs := publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+		Type:    models.EventMessageEdited,
+		Message: message,
+	})
+	updateInboxPreview(ctx, uc.inboxRepo, memberIDs, message)
+
+	return message, nil
+}
+
+// resolveMentions пересчитывает упоминания, чтобы их позиции совпадали с новым текстом.
+// Уведомления об упоминаниях отправляются только при отправке сообщения
+func (uc *editMessageUsecase) resolveMentions(ctx context.Context, message *models.Message) []*models.Mention {
+	if len(parseMentions(message.Content)) == 0 {
+		return nil
+	}
+	members, err := uc.conversationRepo.GetMembers(ctx, message.ConversationID)
+	if err != nil {
+		log.Printf("error getting members of conversation %s: %v", message.ConversationID, err)
+		return nil
+	}
+	memberIDs := make([]gocql.UUID, len(members))
+	for i, member := range members {
+		memberIDs[i] = member.UserID
+	}
+	mentions, err := resolveMentions(ctx, uc.users, message.Content, memberIDs)
+	if err != nil {
+		log.Printf("error resolving mentions in message %s: %v", message.MessageID, err)
+	}
+	return mentions
+}
+
+// validateEdit проверяет правку по типу сообщения: оформление есть только у текста,
+// а геопозицию, контакт и системное событие не правят
+func validateEdit(message *models.Message, content string, entities []*models.TextEntity) error {
+	switch message.ContentType {
+	case models.ContentTypeUnspecified, models.ContentText:
+		return validateEntities(content, entities)
+	case models.ContentImage, models.ContentFile:
+		if len(entities) > 0 {
+			return ErrInvalidEntities
+		}
+		return nil
+	}
+	return ErrContentNotEditable
+}
+
+func sameEntities(a, b []*models.TextEntity) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package message
+
+import "errors"
+
+var (
+	ErrMessageNotFound       = errors.New("message not found")
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrNotMessageSender      = errors.New("only the sender can change the message")
+	ErrMessageDeleted        = errors.New("message is deleted")
+	ErrInvalidEmoji          = errors.New("invalid emoji")
+	ErrReplyToNotFound       = errors.New("replied message not found")
+	ErrReplyToForeign        = errors.New("replied message belongs to another conversation")
+	ErrGroupEncryption       = errors.New("encrypted messages are supported only in direct conversations")
+	ErrDeviceMismatch        = errors.New("encrypted payloads do not match devices of conversation members")
+	ErrMessageEncrypted      = errors.New("encrypted message cannot be edited")
+	ErrBlockedByRecipient    = errors.New("recipient has blocked the sender")
+	ErrRecipientFriendsOnly  = errors.New("recipient accepts messages only from friends")
+	ErrRecipientNotAccepting = errors.New("recipient does not accept direct messages")
+	ErrNotMessageRecipient   = errors.New("only the recipient can change the message status")
+	ErrInvalidStatus         = errors.New("message status cannot move backwards")
+	ErrTooManyPins           = errors.New("too many pinned messages in the conversation")
+	ErrScheduledNotFound     = errors.New("scheduled message not found")
+	ErrScheduledSending      = errors.New("scheduled message is already being sent")
+	ErrScheduledEncrypted    = errors.New("encrypted messages cannot be scheduled")
+	ErrInvalidSendAt         = errors.New("send time must be in the future and at most a year ahead")
+	ErrTooManyScheduled      = errors.New("too many scheduled messages")
+	ErrInvalidExportFormat   = errors.New("unsupported export format")
+	ErrSendInProgress        = errors.New("message with this client_message_id is still being sent")
+	ErrInvalidContent        = errors.New("message content does not match its type")
+	ErrInvalidEntities       = errors.New("invalid text formatting")
+	ErrContentAttachment     = errors.New("image or file must be one of the message attachments")
+	ErrAttachmentNotImage    = errors.New("attachment is not an image")
+	ErrInvalidLocation       = errors.New("invalid location coordinates")
+	ErrInvalidContact        = errors.New("contact needs a name and a phone number, email or user")
+	ErrSystemContent         = errors.New("system events are created only by the server")
+	ErrContentNotEditable    = errors.New("only text, image and file messages can be edited")
+	ErrScheduledContentType  = errors.New("only text messages can be scheduled")
+)
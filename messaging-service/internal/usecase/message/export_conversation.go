@@ -0,0 +1,139 @@
+package message
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/export"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+// Сколько сообщений выгрузка читает за один запрос
+const exportPageSize = 100
+
+// ExportConversationUsecase выгружает историю беседы участнику или сотруднику поддержки
+type ExportConversationUsecase interface {
+	// Execute записывает историю от старых сообщений к новым, читая ее постранично.
+	// Участник получает историю такой, какой видит ее сам, сотрудник поддержки — целиком
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, format models.ExportFormat, w io.Writer) error
+}
+
+type exportConversationUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	supportUsers     map[gocql.UUID]bool
+}
+
+func NewExportConversationUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	supportUserIDs []gocql.UUID,
+) ExportConversationUsecase {
+	supportUsers := make(map[gocql.UUID]bool, len(supportUserIDs))
+	for _, userID := range supportUserIDs {
+		supportUsers[userID] = true
+	}
+	return &exportConversationUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		supportUsers:     supportUsers,
+	}
+}
+
+func (uc *exportConversationUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, format models.ExportFormat, w io.Writer) error {
+	if format != models.ExportFormatJSONL && format != models.ExportFormatHTML {
+		return ErrInvalidExportFormat
+	}
+
+	conv, err := uc.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if conv == nil {
+		return conversation.ErrConversationNotFound
+	}
+	support := uc.supportUsers[userID]
+	if !support {
+		member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return conversation.ErrNotConversationMember
+		}
+	}
+
+	writer, err := export.NewWriter(format, w, conv, time.Now())
+	if err != nil {
+		return err
+	}
+
+	page := models.PageQuery{
+		Direction: models.PageDirectionNewer,
+		Limit:     exportPageSize,
+	}
+	for {
+		messages, err := uc.messageRepo.GetMessages(ctx, conversationID, page)
+		if err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			break
+		}
+		page.Cursor = messages[len(messages)-1].MessageID
+
+		if err := uc.writePage(ctx, writer, conversationID, userID, support, messages); err != nil {
+			return err
+		}
+		if len(messages) < exportPageSize {
+			break
+		}
+	}
+
+	return writer.Close()
+}
+
+// writePage записывает страницу сообщений, пропуская скрытые участником у себя
+func (uc *exportConversationUsecase) writePage(
+	ctx context.Context,
+	writer export.Writer,
+	conversationID, userID gocql.UUID,
+	support bool,
+	messages []*models.Message,
+) error {
+	var hidden map[gocql.UUID]bool
+	if !support {
+		messageIDs := make([]gocql.UUID, len(messages))
+		for i, msg := range messages {
+			messageIDs[i] = msg.MessageID
+		}
+		var err error
+		hidden, err = uc.messageRepo.GetHiddenMessageIDs(ctx, userID, conversationID, messageIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msg := range messages {
+		if hidden[msg.MessageID] {
+			continue
+		}
+		// У удаленного сообщения история правок не хранится
+		var edits []*models.MessageEdit
+		if msg.Edited() && !msg.Deleted {
+			var err error
+			edits, err = uc.messageRepo.GetMessageEdits(ctx, msg.MessageID)
+			if err != nil {
+				return err
+			}
+		}
+		if err := writer.WriteMessage(msg, edits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
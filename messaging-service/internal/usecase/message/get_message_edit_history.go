@@ -0,0 +1,39 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetMessageEditHistoryUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID) ([]*models.MessageEdit, error)
+}
+
+type getMessageEditHistoryUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewGetMessageEditHistoryUsecase(messageRepo repositories.MessageRepository, conversationRepo repositories.ConversationRepository) GetMessageEditHistoryUsecase {
+	return &getMessageEditHistoryUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *getMessageEditHistoryUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID) ([]*models.MessageEdit, error) {
+	message, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// У удаленного сообщения история правок не хранится
+	if message.Deleted {
+		return nil, nil
+	}
+
+	return uc.messageRepo.GetMessageEdits(ctx, messageID)
+}
@@ -6,22 +6,147 @@ import (
 	"github.com/gocql/gocql"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+const (
+	// Размер страницы, если клиент его не указал
+	defaultPageSize = 50
+	// Максимальный размер страницы
+	maxPageSize = 100
 )
 
 type GetMessagesUsecase interface {
-	Execute(ctx context.Context, conversationID gocql.UUID, limit int) ([]*models.Message, error)
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, page models.PageQuery) (*models.MessagePage, error)
 }
 
 type getMessagesUsecase struct {
-	messageRepo repositories.MessageRepository
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	reactionRepo     repositories.ReactionRepository
+	inboxRepo        repositories.InboxRepository
 }
 
-func NewGetMessagesUsecase(messageRepo repositories.MessageRepository) GetMessagesUsecase {
+func NewGetMessagesUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	reactionRepo repositories.ReactionRepository,
+	inboxRepo repositories.InboxRepository,
+) GetMessagesUsecase {
 	return &getMessagesUsecase{
-		messageRepo: messageRepo,
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reactionRepo:     reactionRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *getMessagesUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, page models.PageQuery) (*models.MessagePage, error) {
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+
+	if page.Limit <= 0 {
+		page.Limit = defaultPageSize
+	}
+	if page.Limit > maxPageSize {
+		page.Limit = maxPageSize
+	}
+	if page.Direction != models.PageDirectionNewer {
+		page.Direction = models.PageDirectionOlder
+	}
+
+	// Лишнее сообщение показывает, есть ли следующая страница
+	limit := page.Limit
+	page.Limit++
+	messages, err := uc.messageRepo.GetMessages(ctx, conversationID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.MessagePage{
+		NextCursor: page.Cursor,
+	}
+	if len(messages) > limit {
+		messages = messages[:limit]
+		result.HasMore = true
 	}
+	// Курсор продолжает листание с последнего отданного сообщения
+	if len(messages) > 0 {
+		result.NextCursor = messages[len(messages)-1].MessageID
+	}
+
+	messages, err = decorateMessages(ctx, uc.messageRepo, uc.reactionRepo, conversationID, userID, messages)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyReadMarks(ctx, uc.conversationRepo, uc.inboxRepo, conversationID, messages); err != nil {
+		return nil, err
+	}
+
+	// Клиент всегда получает сообщения от новых к старым
+	if page.Direction == models.PageDirectionNewer {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+	result.Messages = messages
+
+	return result, nil
 }
 
-func (uc *getMessagesUsecase) Execute(ctx context.Context, conversationID gocql.UUID, limit int) ([]*models.Message, error) {
-	return uc.messageRepo.GetMessages(ctx, conversationID, limit)
+// decorateMessages скрывает сообщения, удаленные пользователем только у себя,
+// и дополняет остальные сводкой реакций и количеством ответов в ветке
+func decorateMessages(
+	ctx context.Context,
+	messageRepo repositories.MessageRepository,
+	reactionRepo repositories.ReactionRepository,
+	conversationID, userID gocql.UUID,
+	messages []*models.Message,
+) ([]*models.Message, error) {
+	messageIDs := make([]gocql.UUID, len(messages))
+	for i, msg := range messages {
+		messageIDs[i] = msg.MessageID
+	}
+	hidden, err := messageRepo.GetHiddenMessageIDs(ctx, userID, conversationID, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(hidden) > 0 {
+		visible := messages[:0]
+		for _, msg := range messages {
+			if !hidden[msg.MessageID] {
+				visible = append(visible, msg)
+			}
+		}
+		messages = visible
+	}
+
+	// Реакции есть только у неудаленных сообщений, ветки — только у сообщений вне веток
+	var reactedIDs, rootIDs []gocql.UUID
+	for _, msg := range messages {
+		if !msg.Deleted {
+			reactedIDs = append(reactedIDs, msg.MessageID)
+		}
+		if !msg.IsReply() {
+			rootIDs = append(rootIDs, msg.MessageID)
+		}
+	}
+	summaries, err := reactionRepo.GetSummaries(ctx, reactedIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	replyCounts, err := messageRepo.GetReplyCounts(ctx, rootIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range messages {
+		msg.Reactions = summaries[msg.MessageID]
+		msg.ReplyCount = replyCounts[msg.MessageID]
+	}
+	return messages, nil
 }
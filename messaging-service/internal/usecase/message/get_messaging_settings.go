@@ -0,0 +1,32 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetMessagingSettingsUsecase interface {
+	// Execute возвращает действующую политику, для пользователя без настроек — политику по умолчанию
+	Execute(ctx context.Context, userID gocql.UUID) (models.MessagingPolicy, error)
+}
+
+type getMessagingSettingsUsecase struct {
+	settingsRepo repositories.MessagingSettingsRepository
+}
+
+func NewGetMessagingSettingsUsecase(settingsRepo repositories.MessagingSettingsRepository) GetMessagingSettingsUsecase {
+	return &getMessagingSettingsUsecase{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (uc *getMessagingSettingsUsecase) Execute(ctx context.Context, userID gocql.UUID) (models.MessagingPolicy, error) {
+	policy, err := uc.settingsRepo.GetMessagingPolicy(ctx, userID)
+	if err != nil {
+		return models.MessagingPolicyUnspecified, err
+	}
+	return policy.OrDefault(), nil
+}
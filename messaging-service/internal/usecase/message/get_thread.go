@@ -0,0 +1,92 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetThreadUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID, page models.PageQuery) (*models.Thread, error)
+}
+
+type getThreadUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	reactionRepo     repositories.ReactionRepository
+	inboxRepo        repositories.InboxRepository
+}
+
+func NewGetThreadUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	reactionRepo repositories.ReactionRepository,
+	inboxRepo repositories.InboxRepository,
+) GetThreadUsecase {
+	return &getThreadUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reactionRepo:     reactionRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *getThreadUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, page models.PageQuery) (*models.Thread, error) {
+	root, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Для ответа возвращается ветка, в которую он входит
+	if root.IsReply() {
+		root, err = uc.messageRepo.GetMessageByID(ctx, root.ThreadRootID)
+		if err != nil {
+			return nil, err
+		}
+		if root == nil {
+			return nil, ErrMessageNotFound
+		}
+	}
+
+	if page.Limit <= 0 {
+		page.Limit = defaultPageSize
+	}
+	if page.Limit > maxPageSize {
+		page.Limit = maxPageSize
+	}
+
+	// Лишний ответ показывает, есть ли следующая страница
+	replies, err := uc.messageRepo.GetReplies(ctx, root.ConversationID, root.MessageID, page.Cursor, page.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	thread := &models.Thread{
+		NextCursor: page.Cursor,
+	}
+	if len(replies) > page.Limit {
+		replies = replies[:page.Limit]
+		thread.HasMore = true
+	}
+	if len(replies) > 0 {
+		thread.NextCursor = replies[len(replies)-1].MessageID
+	}
+
+	// Корень дополняется вместе с ответами, скрытый пользователем корень не возвращается
+	decorated, err := decorateMessages(ctx, uc.messageRepo, uc.reactionRepo, root.ConversationID, userID, append([]*models.Message{root}, replies...))
+	if err != nil {
+		return nil, err
+	}
+	if err := applyReadMarks(ctx, uc.conversationRepo, uc.inboxRepo, root.ConversationID, decorated); err != nil {
+		return nil, err
+	}
+	if len(decorated) > 0 && decorated[0] == root {
+		thread.Root = root
+		decorated = decorated[1:]
+	}
+	thread.Replies = decorated
+
+	return thread, nil
+}
@@ -0,0 +1,69 @@
+package message
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+type ListPinnedMessagesUsecase interface {
+	// Execute возвращает закрепленные сообщения беседы, последние закрепленные первыми
+	Execute(ctx context.Context, conversationID, userID gocql.UUID) ([]*models.PinnedMessage, error)
+}
+
+type listPinnedMessagesUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	pinRepo          repositories.PinRepository
+}
+
+func NewListPinnedMessagesUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	pinRepo repositories.PinRepository,
+) ListPinnedMessagesUsecase {
+	return &listPinnedMessagesUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		pinRepo:          pinRepo,
+	}
+}
+
+func (uc *listPinnedMessagesUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID) ([]*models.PinnedMessage, error) {
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+
+	pins, err := uc.pinRepo.GetPins(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Закреплений в беседе немного, поэтому сообщения читаются по одному.
+	// Исчезнувшие и удаленные сообщения не показываются
+	result := make([]*models.PinnedMessage, 0, len(pins))
+	for _, pin := range pins {
+		message, err := uc.messageRepo.GetMessageByID(ctx, pin.MessageID)
+		if err != nil {
+			return nil, err
+		}
+		if message == nil || message.Deleted {
+			continue
+		}
+		pin.Message = message
+		result = append(result, pin)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PinnedAt.After(result[j].PinnedAt)
+	})
+	return result, nil
+}
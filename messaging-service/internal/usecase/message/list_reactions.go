@@ -0,0 +1,57 @@
+package message
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type ListReactionsUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID, emoji, pageToken string, limit int) ([]*models.Reaction, string, error)
+}
+
+type listReactionsUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	reactionRepo     repositories.ReactionRepository
+}
+
+func NewListReactionsUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	reactionRepo repositories.ReactionRepository,
+) ListReactionsUsecase {
+	return &listReactionsUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reactionRepo:     reactionRepo,
+	}
+}
+
+func (uc *listReactionsUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, emoji, pageToken string, limit int) ([]*models.Reaction, string, error) {
+	// Токен — состояние страницы Cassandra, клиенту оно непрозрачно
+	pageState, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, "", ErrInvalidPageToken
+	}
+
+	if _, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID); err != nil {
+		return nil, "", err
+	}
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	reactions, nextPageState, err := uc.reactionRepo.GetReactions(ctx, messageID, emoji, pageState, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	return reactions, base64.RawURLEncoding.EncodeToString(nextPageState), nil
+}
@@ -0,0 +1,36 @@
+package message
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type ListScheduledMessagesUsecase interface {
+	// Execute возвращает запланированные сообщения пользователя в порядке отправки
+	Execute(ctx context.Context, userID gocql.UUID) ([]*models.ScheduledMessage, error)
+}
+
+type listScheduledMessagesUsecase struct {
+	scheduledRepo repositories.ScheduledMessageRepository
+}
+
+func NewListScheduledMessagesUsecase(scheduledRepo repositories.ScheduledMessageRepository) ListScheduledMessagesUsecase {
+	return &listScheduledMessagesUsecase{
+		scheduledRepo: scheduledRepo,
+	}
+}
+
+func (uc *listScheduledMessagesUsecase) Execute(ctx context.Context, userID gocql.UUID) ([]*models.ScheduledMessage, error) {
+	scheduled, err := uc.scheduledRepo.GetScheduledMessages(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return scheduled[i].SendAt.Before(scheduled[j].SendAt)
+	})
+	return scheduled, nil
+}
@@ -0,0 +1,160 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+type MarkConversationReadUsecase interface {
+	// Execute отмечает беседу прочитанной до сообщения, нулевой messageID — до последнего сообщения
+	Execute(ctx context.Context, conversationID, userID, messageID gocql.UUID) (*models.ReadState, error)
+}
+
+type markConversationReadUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+	hub              events.Hub
+}
+
+func NewMarkConversationReadUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	hub events.Hub,
+) MarkConversationReadUsecase {
+	return &markConversationReadUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *markConversationReadUsecase) Execute(ctx context.Context, conversationID, userID, messageID gocql.UUID) (*models.ReadState, error) {
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+
+	entry, err := uc.inboxRepo.GetEntry(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	state := &models.ReadState{}
+	if entry != nil {
+		state.LastReadMessageID = entry.LastReadMessageID
+	}
+
+	if messageID == (gocql.UUID{}) {
+		if entry != nil {
+			messageID = entry.LastMessageID
+		}
+	} else {
+		message, err := uc.messageRepo.GetMessageByID(ctx, messageID)
+		if err != nil {
+			return nil, err
+		}
+		if message == nil || message.ConversationID != conversationID {
+			return nil, ErrMessageNotFound
+		}
+	}
+
+	if messageID != (gocql.UUID{}) {
+		moved, err := uc.inboxRepo.MarkRead(ctx, userID, conversationID, messageID)
+		if err != nil {
+			return nil, err
+		}
+		// Отметка не сдвигается назад, поэтому при более поздней отметке остается она
+		if moved || state.LastReadMessageID == (gocql.UUID{}) {
+			state.LastReadMessageID = messageID
+		}
+		if moved {
+			uc.publishReceipt(ctx, conversationID, userID, messageID)
+		}
+	}
+
+	// Прочтение снимает отметку непрочитанной, даже если отметка прочтения не сдвинулась
+	if entry != nil && entry.MarkedUnread {
+		if err := uc.inboxRepo.SetMarkedUnread(ctx, userID, conversationID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	state.UnreadCount, err = uc.messageRepo.CountUnread(ctx, conversationID, userID, state.LastReadMessageID, conversation.MaxUnreadCount)
+	if err != nil {
+		return nil, err
+	}
+	state.UnreadConversations, err = uc.inboxRepo.CountUnreadConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// publishReceipt сообщает участникам беседы, до какого сообщения ее прочитал пользователь
+func (uc *markConversationReadUsecase) publishReceipt(ctx context.Context, conversationID, userID, messageID gocql.UUID) {
+	now := time.Now()
+	publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+		Type: models.EventConversationRead,
+		Receipt: &models.ReadReceipt{
+			ConversationID:    conversationID,
+			UserID:            userID,
+			LastReadMessageID: messageID,
+			ReadAt:            now,
+		},
+		Timestamp: now,
+	})
+}
+
+// applyReadMarks выводит статус READ из отметок прочтения: сообщение прочитано,
+// когда отметки всех остальных участников беседы не раньше него
+func applyReadMarks(
+	ctx context.Context,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	conversationID gocql.UUID,
+	messages []*models.Message,
+) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	members, err := conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	marks, err := inboxRepo.GetReadMarks(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		if msg.Status < models.StatusRead && readByOthers(msg, members, marks) {
+			msg.Status = models.StatusRead
+		}
+	}
+	return nil
+}
+
+func readByOthers(msg *models.Message, members []*models.ConversationMember, marks map[gocql.UUID]gocql.UUID) bool {
+	others := 0
+	for _, member := range members {
+		if member.UserID == msg.SenderID {
+			continue
+		}
+		others++
+		mark, ok := marks[member.UserID]
+		if !ok || mark.Time().Before(msg.MessageID.Time()) {
+			return false
+		}
+	}
+	return others > 0
+}
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+type MarkConversationUnreadUsecase interface {
+	// Execute отмечает беседу непрочитанной до ее следующего прочтения и возвращает
+	// количество бесед пользователя с непрочитанными сообщениями
+	Execute(ctx context.Context, conversationID, userID gocql.UUID) (int, error)
+}
+
+type markConversationUnreadUsecase struct {
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+}
+
+func NewMarkConversationUnreadUsecase(
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+) MarkConversationUnreadUsecase {
+	return &markConversationUnreadUsecase{
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *markConversationUnreadUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID) (int, error) {
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if member == nil {
+		return 0, conversation.ErrNotConversationMember
+	}
+
+	// Отметка прочтения не сдвигается назад, поэтому непрочитанность хранится отдельным флагом
+	if err := uc.inboxRepo.SetMarkedUnread(ctx, userID, conversationID, true); err != nil {
+		return 0, err
+	}
+	return uc.inboxRepo.CountUnreadConversations(ctx, userID)
+}
@@ -0,0 +1,100 @@
+package message
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// MaxMentions — сколько разных никнеймов сообщения проверяется в user-service, остальные не упоминаются
+const MaxMentions = 50
+
+// Длина никнейма ограничена профилем пользователя
+const (
+	minNicknameLength = 3
+	maxNicknameLength = 50
+)
+
+// mentionPattern находит @ и следующие за ним символы никнейма
+var mentionPattern = regexp.MustCompile(`@[\p{L}\p{N}_.\-]+`)
+
+// mentionCandidate — упоминание в тексте до проверки никнейма
+type mentionCandidate struct {
+	nickname string
+	offset   int
+	length   int
+}
+
+// parseMentions находит упоминания @никнейм, позиции считаются в символах Unicode.
+// Упоминание начинается в начале текста или после символа вне никнейма, поэтому адреса почты
+// не считаются упоминаниями. Точки и дефисы в конце относятся к тексту, например к концу предложения
+func parseMentions(content string) []mentionCandidate {
+	var candidates []mentionCandidate
+	for _, loc := range mentionPattern.FindAllStringIndex(content, -1) {
+		start, end := loc[0], loc[1]
+		if previous, _ := utf8.DecodeLastRuneInString(content[:start]); start > 0 && isNicknameRune(previous) {
+			continue
+		}
+		nickname := strings.TrimRight(content[start+1:end], ".-")
+		length := utf8.RuneCountInString(nickname)
+		if length < minNicknameLength || length > maxNicknameLength {
+			continue
+		}
+		candidates = append(candidates, mentionCandidate{
+			nickname: nickname,
+			offset:   utf8.RuneCountInString(content[:start]),
+			length:   length + 1,
+		})
+	}
+	return candidates
+}
+
+func isNicknameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-@", r)
+}
+
+// resolveMentions сопоставляет упоминания в тексте с участниками беседы. Упоминания пользователей
+// вне беседы не сохраняются, чтобы не раскрывать им переписку
+func resolveMentions(ctx context.Context, users clients.UserDirectory, content string, memberIDs []gocql.UUID) ([]*models.Mention, error) {
+	candidates := parseMentions(content)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	var nicknames []string
+	requested := make(map[string]bool)
+	for _, candidate := range candidates {
+		if !requested[candidate.nickname] && len(nicknames) < MaxMentions {
+			requested[candidate.nickname] = true
+			nicknames = append(nicknames, candidate.nickname)
+		}
+	}
+	profiles, err := users.GetProfilesByNicknames(ctx, nicknames)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[gocql.UUID]bool, len(memberIDs))
+	for _, memberID := range memberIDs {
+		members[memberID] = true
+	}
+	var mentions []*models.Mention
+	for _, candidate := range candidates {
+		profile := profiles[candidate.nickname]
+		if profile == nil || !members[profile.UserID] {
+			continue
+		}
+		mentions = append(mentions, &models.Mention{
+			UserID: profile.UserID,
+			Offset: candidate.offset,
+			Length: candidate.length,
+		})
+	}
+	return mentions, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// BindAttachment provides a mock function with given fields: ctx, attachmentID, conversationID, messageID
+func (_m *AttachmentRepository) BindAttachment(ctx context.Context, attachmentID gocql.UUID, conversationID gocql.UUID, messageID gocql.UUID) (bool, error) {
+	ret := _m.Called(ctx, attachmentID, conversationID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindAttachment")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) (bool, error)); ok {
+		return rf(ctx, attachmentID, conversationID, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) bool); ok {
+		r0 = rf(ctx, attachmentID, conversationID, messageID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, attachmentID, conversationID, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, expiration
+func (_m *AttachmentRepository) DeleteExpired(ctx context.Context, expiration *models.AttachmentExpiration) error {
+	ret := _m.Called(ctx, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AttachmentExpiration) error); ok {
+		r0 = rf(ctx, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAttachment provides a mock function with given fields: ctx, attachmentID
+func (_m *AttachmentRepository) GetAttachment(ctx context.Context, attachmentID gocql.UUID) (*models.Attachment, error) {
+	ret := _m.Called(ctx, attachmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (*models.Attachment, error)); ok {
+		return rf(ctx, attachmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) *models.Attachment); ok {
+		r0 = rf(ctx, attachmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, attachmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpirations provides a mock function with given fields: ctx, bucket, before
+func (_m *AttachmentRepository) GetExpirations(ctx context.Context, bucket time.Time, before time.Time) ([]*models.AttachmentExpiration, error) {
+	ret := _m.Called(ctx, bucket, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpirations")
+	}
+
+	var r0 []*models.AttachmentExpiration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*models.AttachmentExpiration, error)); ok {
+		return rf(ctx, bucket, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*models.AttachmentExpiration); ok {
+		r0 = rf(ctx, bucket, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AttachmentExpiration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, bucket, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveAttachment provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) SaveAttachment(ctx context.Context, attachment *models.Attachment) error {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Attachment) error); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnbindAttachment provides a mock function with given fields: ctx, attachmentID, messageID
+func (_m *AttachmentRepository) UnbindAttachment(ctx context.Context, attachmentID gocql.UUID, messageID gocql.UUID) error {
+	ret := _m.Called(ctx, attachmentID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbindAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r0 = rf(ctx, attachmentID, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
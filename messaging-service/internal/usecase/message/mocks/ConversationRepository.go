@@ -0,0 +1,270 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// ConversationRepository is an autogenerated mock type for the ConversationRepository type
+type ConversationRepository struct {
+	mock.Mock
+}
+
+// CreateConversation provides a mock function with given fields: ctx, conversation, members, events
+func (_m *ConversationRepository) CreateConversation(ctx context.Context, conversation *models.Conversation, members []*models.ConversationMember, events ...*models.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, conversation, members)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Conversation, []*models.ConversationMember, ...*models.OutboxEvent) error); ok {
+		r0 = rf(ctx, conversation, members, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateConversationIfNotExists provides a mock function with given fields: ctx, conversation
+func (_m *ConversationRepository) CreateConversationIfNotExists(ctx context.Context, conversation *models.Conversation) (bool, error) {
+	ret := _m.Called(ctx, conversation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversationIfNotExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Conversation) (bool, error)); ok {
+		return rf(ctx, conversation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Conversation) bool); ok {
+		r0 = rf(ctx, conversation)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Conversation) error); ok {
+		r1 = rf(ctx, conversation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConversation provides a mock function with given fields: ctx, conversationID
+func (_m *ConversationRepository) GetConversation(ctx context.Context, conversationID gocql.UUID) (*models.Conversation, error) {
+	ret := _m.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversation")
+	}
+
+	var r0 *models.Conversation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (*models.Conversation, error)); ok {
+		return rf(ctx, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) *models.Conversation); ok {
+		r0 = rf(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Conversation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMember provides a mock function with given fields: ctx, conversationID, userID
+func (_m *ConversationRepository) GetMember(ctx context.Context, conversationID gocql.UUID, userID gocql.UUID) (*models.ConversationMember, error) {
+	ret := _m.Called(ctx, conversationID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMember")
+	}
+
+	var r0 *models.ConversationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.ConversationMember, error)); ok {
+		return rf(ctx, conversationID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.ConversationMember); ok {
+		r0 = rf(ctx, conversationID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConversationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMembers provides a mock function with given fields: ctx, conversationID
+func (_m *ConversationRepository) GetMembers(ctx context.Context, conversationID gocql.UUID) ([]*models.ConversationMember, error) {
+	ret := _m.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 []*models.ConversationMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]*models.ConversationMember, error)); ok {
+		return rf(ctx, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []*models.ConversationMember); ok {
+		r0 = rf(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ConversationMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: ctx, conversationID, userID, events
+func (_m *ConversationRepository) RemoveMember(ctx context.Context, conversationID gocql.UUID, userID gocql.UUID, events ...*models.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, conversationID, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, ...*models.OutboxEvent) error); ok {
+		r0 = rf(ctx, conversationID, userID, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveMembers provides a mock function with given fields: ctx, members, events
+func (_m *ConversationRepository) SaveMembers(ctx context.Context, members []*models.ConversationMember, events ...*models.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, members)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ConversationMember, ...*models.OutboxEvent) error); ok {
+		r0 = rf(ctx, members, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMessageTTL provides a mock function with given fields: ctx, conversationID, ttl
+func (_m *ConversationRepository) SetMessageTTL(ctx context.Context, conversationID gocql.UUID, ttl time.Duration) error {
+	ret := _m.Called(ctx, conversationID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMessageTTL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, time.Duration) error); ok {
+		r0 = rf(ctx, conversationID, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMemberRole provides a mock function with given fields: ctx, conversationID, userID, role, events
+func (_m *ConversationRepository) UpdateMemberRole(ctx context.Context, conversationID gocql.UUID, userID gocql.UUID, role models.MemberRole, events ...*models.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, conversationID, userID, role)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, models.MemberRole, ...*models.OutboxEvent) error); ok {
+		r0 = rf(ctx, conversationID, userID, role, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewConversationRepository creates a new instance of ConversationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConversationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConversationRepository {
+	mock := &ConversationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
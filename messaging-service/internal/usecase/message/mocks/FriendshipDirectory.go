@@ -0,0 +1,62 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// FriendshipDirectory is an autogenerated mock type for the FriendshipDirectory type
+type FriendshipDirectory struct {
+	mock.Mock
+}
+
+// GetRelationship provides a mock function with given fields: ctx, userID, targetUserID
+func (_m *FriendshipDirectory) GetRelationship(ctx context.Context, userID gocql.UUID, targetUserID gocql.UUID) (*models.Relationship, error) {
+	ret := _m.Called(ctx, userID, targetUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationship")
+	}
+
+	var r0 *models.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.Relationship, error)); ok {
+		return rf(ctx, userID, targetUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.Relationship); ok {
+		r0 = rf(ctx, userID, targetUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, targetUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFriendshipDirectory creates a new instance of FriendshipDirectory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFriendshipDirectory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FriendshipDirectory {
+	mock := &FriendshipDirectory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
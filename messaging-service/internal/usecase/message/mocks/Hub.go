@@ -0,0 +1,87 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// Hub is an autogenerated mock type for the Hub type
+type Hub struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: ctx, event, userIDs
+func (_m *Hub) Publish(ctx context.Context, event *models.MessageEvent, userIDs ...gocql.UUID) error {
+	_va := make([]interface{}, len(userIDs))
+	for _i := range userIDs {
+		_va[_i] = userIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, event)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.MessageEvent, ...gocql.UUID) error); ok {
+		r0 = rf(ctx, event, userIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: ctx, userID, lastEventID
+func (_m *Hub) Subscribe(ctx context.Context, userID gocql.UUID, lastEventID string) (<-chan *models.MessageEvent, error) {
+	ret := _m.Called(ctx, userID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *models.MessageEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string) (<-chan *models.MessageEvent, error)); ok {
+		return rf(ctx, userID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string) <-chan *models.MessageEvent); ok {
+		r0 = rf(ctx, userID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.MessageEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, string) error); ok {
+		r1 = rf(ctx, userID, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewHub creates a new instance of Hub. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHub(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Hub {
+	mock := &Hub{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
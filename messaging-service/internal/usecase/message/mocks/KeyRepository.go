@@ -0,0 +1,186 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// KeyRepository is an autogenerated mock type for the KeyRepository type
+type KeyRepository struct {
+	mock.Mock
+}
+
+// AddOneTimePreKeys provides a mock function with given fields: ctx, userID, deviceID, keys
+func (_m *KeyRepository) AddOneTimePreKeys(ctx context.Context, userID gocql.UUID, deviceID gocql.UUID, keys []*models.OneTimePreKey) error {
+	ret := _m.Called(ctx, userID, deviceID, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOneTimePreKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, []*models.OneTimePreKey) error); ok {
+		r0 = rf(ctx, userID, deviceID, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsumeOneTimePreKey provides a mock function with given fields: ctx, userID, deviceID
+func (_m *KeyRepository) ConsumeOneTimePreKey(ctx context.Context, userID gocql.UUID, deviceID gocql.UUID) (*models.OneTimePreKey, error) {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeOneTimePreKey")
+	}
+
+	var r0 *models.OneTimePreKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.OneTimePreKey, error)); ok {
+		return rf(ctx, userID, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.OneTimePreKey); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OneTimePreKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountOneTimePreKeys provides a mock function with given fields: ctx, userID, deviceID
+func (_m *KeyRepository) CountOneTimePreKeys(ctx context.Context, userID gocql.UUID, deviceID gocql.UUID) (int, error) {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOneTimePreKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (int, error)); ok {
+		return rf(ctx, userID, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) int); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDevice provides a mock function with given fields: ctx, userID, deviceID
+func (_m *KeyRepository) GetDevice(ctx context.Context, userID gocql.UUID, deviceID gocql.UUID) (*models.DeviceKeys, error) {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevice")
+	}
+
+	var r0 *models.DeviceKeys
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.DeviceKeys, error)); ok {
+		return rf(ctx, userID, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.DeviceKeys); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeviceKeys)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDevices provides a mock function with given fields: ctx, userID
+func (_m *KeyRepository) GetDevices(ctx context.Context, userID gocql.UUID) ([]*models.DeviceKeys, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevices")
+	}
+
+	var r0 []*models.DeviceKeys
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]*models.DeviceKeys, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []*models.DeviceKeys); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DeviceKeys)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveDevice provides a mock function with given fields: ctx, device, resetPreKeys
+func (_m *KeyRepository) SaveDevice(ctx context.Context, device *models.DeviceKeys, resetPreKeys bool) error {
+	ret := _m.Called(ctx, device, resetPreKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDevice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.DeviceKeys, bool) error); ok {
+		r0 = rf(ctx, device, resetPreKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewKeyRepository creates a new instance of KeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeyRepository {
+	mock := &KeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
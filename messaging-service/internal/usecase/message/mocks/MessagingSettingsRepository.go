@@ -0,0 +1,78 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// MessagingSettingsRepository is an autogenerated mock type for the MessagingSettingsRepository type
+type MessagingSettingsRepository struct {
+	mock.Mock
+}
+
+// GetMessagingPolicy provides a mock function with given fields: ctx, userID
+func (_m *MessagingSettingsRepository) GetMessagingPolicy(ctx context.Context, userID gocql.UUID) (models.MessagingPolicy, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessagingPolicy")
+	}
+
+	var r0 models.MessagingPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (models.MessagingPolicy, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) models.MessagingPolicy); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(models.MessagingPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetMessagingPolicy provides a mock function with given fields: ctx, userID, policy
+func (_m *MessagingSettingsRepository) SetMessagingPolicy(ctx context.Context, userID gocql.UUID, policy models.MessagingPolicy) error {
+	ret := _m.Called(ctx, userID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMessagingPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, models.MessagingPolicy) error); ok {
+		r0 = rf(ctx, userID, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMessagingSettingsRepository creates a new instance of MessagingSettingsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMessagingSettingsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MessagingSettingsRepository {
+	mock := &MessagingSettingsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,178 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// PinRepository is an autogenerated mock type for the PinRepository type
+type PinRepository struct {
+	mock.Mock
+}
+
+// CountPins provides a mock function with given fields: ctx, conversationID
+func (_m *PinRepository) CountPins(ctx context.Context, conversationID gocql.UUID) (int, error) {
+	ret := _m.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPins")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (int, error)); ok {
+		return rf(ctx, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) int); ok {
+		r0 = rf(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPin provides a mock function with given fields: ctx, conversationID, messageID
+func (_m *PinRepository) GetPin(ctx context.Context, conversationID gocql.UUID, messageID gocql.UUID) (*models.PinnedMessage, error) {
+	ret := _m.Called(ctx, conversationID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPin")
+	}
+
+	var r0 *models.PinnedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.PinnedMessage, error)); ok {
+		return rf(ctx, conversationID, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.PinnedMessage); ok {
+		r0 = rf(ctx, conversationID, messageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PinnedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPins provides a mock function with given fields: ctx, conversationID
+func (_m *PinRepository) GetPins(ctx context.Context, conversationID gocql.UUID) ([]*models.PinnedMessage, error) {
+	ret := _m.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPins")
+	}
+
+	var r0 []*models.PinnedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]*models.PinnedMessage, error)); ok {
+		return rf(ctx, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []*models.PinnedMessage); ok {
+		r0 = rf(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.PinnedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PinMessage provides a mock function with given fields: ctx, pin, ttl
+func (_m *PinRepository) PinMessage(ctx context.Context, pin *models.PinnedMessage, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, pin, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PinMessage")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PinnedMessage, time.Duration) (bool, error)); ok {
+		return rf(ctx, pin, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PinnedMessage, time.Duration) bool); ok {
+		r0 = rf(ctx, pin, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.PinnedMessage, time.Duration) error); ok {
+		r1 = rf(ctx, pin, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnpinMessage provides a mock function with given fields: ctx, conversationID, messageID
+func (_m *PinRepository) UnpinMessage(ctx context.Context, conversationID gocql.UUID, messageID gocql.UUID) (bool, error) {
+	ret := _m.Called(ctx, conversationID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnpinMessage")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (bool, error)); ok {
+		return rf(ctx, conversationID, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) bool); ok {
+		r0 = rf(ctx, conversationID, messageID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPinRepository creates a new instance of PinRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPinRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PinRepository {
+	mock := &PinRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
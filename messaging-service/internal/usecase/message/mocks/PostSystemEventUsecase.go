@@ -0,0 +1,62 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// PostSystemEventUsecase is an autogenerated mock type for the PostSystemEventUsecase type
+type PostSystemEventUsecase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, conversationID, event
+func (_m *PostSystemEventUsecase) Execute(ctx context.Context, conversationID gocql.UUID, event *models.SystemEvent) (*models.Message, error) {
+	ret := _m.Called(ctx, conversationID, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, *models.SystemEvent) (*models.Message, error)); ok {
+		return rf(ctx, conversationID, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, *models.SystemEvent) *models.Message); ok {
+		r0 = rf(ctx, conversationID, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, *models.SystemEvent) error); ok {
+		r1 = rf(ctx, conversationID, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPostSystemEventUsecase creates a new instance of PostSystemEventUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPostSystemEventUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PostSystemEventUsecase {
+	mock := &PostSystemEventUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,159 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// ReactionRepository is an autogenerated mock type for the ReactionRepository type
+type ReactionRepository struct {
+	mock.Mock
+}
+
+// AddReaction provides a mock function with given fields: ctx, reaction, ttl
+func (_m *ReactionRepository) AddReaction(ctx context.Context, reaction *models.Reaction, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, reaction, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Reaction, time.Duration) (bool, error)); ok {
+		return rf(ctx, reaction, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Reaction, time.Duration) bool); ok {
+		r0 = rf(ctx, reaction, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Reaction, time.Duration) error); ok {
+		r1 = rf(ctx, reaction, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReactions provides a mock function with given fields: ctx, messageID, emoji, pageState, limit
+func (_m *ReactionRepository) GetReactions(ctx context.Context, messageID gocql.UUID, emoji string, pageState []byte, limit int) ([]*models.Reaction, []byte, error) {
+	ret := _m.Called(ctx, messageID, emoji, pageState, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReactions")
+	}
+
+	var r0 []*models.Reaction
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string, []byte, int) ([]*models.Reaction, []byte, error)); ok {
+		return rf(ctx, messageID, emoji, pageState, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string, []byte, int) []*models.Reaction); ok {
+		r0 = rf(ctx, messageID, emoji, pageState, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Reaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, string, []byte, int) []byte); ok {
+		r1 = rf(ctx, messageID, emoji, pageState, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, gocql.UUID, string, []byte, int) error); ok {
+		r2 = rf(ctx, messageID, emoji, pageState, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSummaries provides a mock function with given fields: ctx, messageIDs, userID
+func (_m *ReactionRepository) GetSummaries(ctx context.Context, messageIDs []gocql.UUID, userID gocql.UUID) (map[gocql.UUID][]*models.ReactionSummary, error) {
+	ret := _m.Called(ctx, messageIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummaries")
+	}
+
+	var r0 map[gocql.UUID][]*models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID, gocql.UUID) (map[gocql.UUID][]*models.ReactionSummary, error)); ok {
+		return rf(ctx, messageIDs, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID, gocql.UUID) map[gocql.UUID][]*models.ReactionSummary); ok {
+		r0 = rf(ctx, messageIDs, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID][]*models.ReactionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, messageIDs, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, messageID, emoji, userID
+func (_m *ReactionRepository) RemoveReaction(ctx context.Context, messageID gocql.UUID, emoji string, userID gocql.UUID) (bool, error) {
+	ret := _m.Called(ctx, messageID, emoji, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string, gocql.UUID) (bool, error)); ok {
+		return rf(ctx, messageID, emoji, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, string, gocql.UUID) bool); ok {
+		r0 = rf(ctx, messageID, emoji, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, string, gocql.UUID) error); ok {
+		r1 = rf(ctx, messageID, emoji, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReactionRepository creates a new instance of ReactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReactionRepository {
+	mock := &ReactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,280 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// ScheduledMessageRepository is an autogenerated mock type for the ScheduledMessageRepository type
+type ScheduledMessageRepository struct {
+	mock.Mock
+}
+
+// CancelScheduledMessage provides a mock function with given fields: ctx, scheduled
+func (_m *ScheduledMessageRepository) CancelScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) (bool, error) {
+	ret := _m.Called(ctx, scheduled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduledMessage")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage) (bool, error)); ok {
+		return rf(ctx, scheduled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage) bool); ok {
+		r0 = rf(ctx, scheduled)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ScheduledMessage) error); ok {
+		r1 = rf(ctx, scheduled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimScheduledMessage provides a mock function with given fields: ctx, scheduled, claimedAt
+func (_m *ScheduledMessageRepository) ClaimScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, claimedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, scheduled, claimedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimScheduledMessage")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage, time.Time) (bool, error)); ok {
+		return rf(ctx, scheduled, claimedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage, time.Time) bool); ok {
+		r0 = rf(ctx, scheduled, claimedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ScheduledMessage, time.Time) error); ok {
+		r1 = rf(ctx, scheduled, claimedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteScheduledMessage provides a mock function with given fields: ctx, scheduled
+func (_m *ScheduledMessageRepository) CompleteScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error {
+	ret := _m.Called(ctx, scheduled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteScheduledMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage) error); ok {
+		r0 = rf(ctx, scheduled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEntry provides a mock function with given fields: ctx, entry
+func (_m *ScheduledMessageRepository) DeleteEntry(ctx context.Context, entry *models.ScheduledEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailScheduledMessage provides a mock function with given fields: ctx, scheduled, reason
+func (_m *ScheduledMessageRepository) FailScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage, reason string) error {
+	ret := _m.Called(ctx, scheduled, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailScheduledMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage, string) error); ok {
+		r0 = rf(ctx, scheduled, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDueEntries provides a mock function with given fields: ctx, bucket, before
+func (_m *ScheduledMessageRepository) GetDueEntries(ctx context.Context, bucket time.Time, before time.Time) ([]*models.ScheduledEntry, error) {
+	ret := _m.Called(ctx, bucket, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueEntries")
+	}
+
+	var r0 []*models.ScheduledEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*models.ScheduledEntry, error)); ok {
+		return rf(ctx, bucket, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*models.ScheduledEntry); ok {
+		r0 = rf(ctx, bucket, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScheduledEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, bucket, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScheduledMessage provides a mock function with given fields: ctx, senderID, scheduledID
+func (_m *ScheduledMessageRepository) GetScheduledMessage(ctx context.Context, senderID gocql.UUID, scheduledID gocql.UUID) (*models.ScheduledMessage, error) {
+	ret := _m.Called(ctx, senderID, scheduledID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScheduledMessage")
+	}
+
+	var r0 *models.ScheduledMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.ScheduledMessage, error)); ok {
+		return rf(ctx, senderID, scheduledID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.ScheduledMessage); ok {
+		r0 = rf(ctx, senderID, scheduledID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ScheduledMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, senderID, scheduledID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScheduledMessages provides a mock function with given fields: ctx, senderID
+func (_m *ScheduledMessageRepository) GetScheduledMessages(ctx context.Context, senderID gocql.UUID) ([]*models.ScheduledMessage, error) {
+	ret := _m.Called(ctx, senderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScheduledMessages")
+	}
+
+	var r0 []*models.ScheduledMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]*models.ScheduledMessage, error)); ok {
+		return rf(ctx, senderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []*models.ScheduledMessage); ok {
+		r0 = rf(ctx, senderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScheduledMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, senderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RescheduleMessage provides a mock function with given fields: ctx, scheduled, sendAt
+func (_m *ScheduledMessageRepository) RescheduleMessage(ctx context.Context, scheduled *models.ScheduledMessage, sendAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, scheduled, sendAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleMessage")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage, time.Time) (bool, error)); ok {
+		return rf(ctx, scheduled, sendAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage, time.Time) bool); ok {
+		r0 = rf(ctx, scheduled, sendAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ScheduledMessage, time.Time) error); ok {
+		r1 = rf(ctx, scheduled, sendAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveScheduledMessage provides a mock function with given fields: ctx, scheduled
+func (_m *ScheduledMessageRepository) SaveScheduledMessage(ctx context.Context, scheduled *models.ScheduledMessage) error {
+	ret := _m.Called(ctx, scheduled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveScheduledMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ScheduledMessage) error); ok {
+		r0 = rf(ctx, scheduled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewScheduledMessageRepository creates a new instance of ScheduledMessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduledMessageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScheduledMessageRepository {
+	mock := &ScheduledMessageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// SendMessageUsecase is an autogenerated mock type for the SendMessageUsecase type
+type SendMessageUsecase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, _a1
+func (_m *SendMessageUsecase) Execute(ctx context.Context, _a1 *models.Message) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Message) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSendMessageUsecase creates a new instance of SendMessageUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSendMessageUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SendMessageUsecase {
+	mock := &SendMessageUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
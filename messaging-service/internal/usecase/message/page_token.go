@@ -0,0 +1,37 @@
+package message
+
+import (
+	"encoding/base64"
+
+	"github.com/gocql/gocql"
+)
+
+// Версия формата токена страницы, чтобы его можно было менять без поломки клиентов
+const pageTokenVersion = 1
+
+// EncodePageToken кодирует курсор страницы в непрозрачный для клиента токен
+func EncodePageToken(cursor gocql.UUID) string {
+	if cursor == (gocql.UUID{}) {
+		return ""
+	}
+	buf := make([]byte, 0, 1+len(cursor))
+	buf = append(buf, pageTokenVersion)
+	buf = append(buf, cursor.Bytes()...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// DecodePageToken восстанавливает курсор из токена. Пустой токен означает первую страницу.
+func DecodePageToken(token string) (gocql.UUID, error) {
+	if token == "" {
+		return gocql.UUID{}, nil
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != 17 || buf[0] != pageTokenVersion {
+		return gocql.UUID{}, ErrInvalidPageToken
+	}
+	cursor, err := gocql.UUIDFromBytes(buf[1:])
+	if err != nil || cursor.Version() != 1 {
+		return gocql.UUID{}, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
@@ -0,0 +1,120 @@
+package message
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+// Сколько сообщений можно закрепить в одной беседе по умолчанию
+const DefaultMaxPinnedMessages = 50
+
+type PinMessageUsecase interface {
+	// Execute закрепляет сообщение, повторное закрепление возвращает прежнее закрепление
+	Execute(ctx context.Context, messageID, userID gocql.UUID) (*models.PinnedMessage, error)
+}
+
+type pinMessageUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	pinRepo          repositories.PinRepository
+	systemEvents     conversation.PostSystemEventUsecase
+	hub              events.Hub
+	maxPins          int
+}
+
+func NewPinMessageUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	pinRepo repositories.PinRepository,
+	systemEvents conversation.PostSystemEventUsecase,
+	hub events.Hub,
+	maxPins int,
+) PinMessageUsecase {
+	if maxPins <= 0 {
+		maxPins = DefaultMaxPinnedMessages
+	}
+	return &pinMessageUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		pinRepo:          pinRepo,
+		systemEvents:     systemEvents,
+		hub:              hub,
+		maxPins:          maxPins,
+	}
+}
+
+func (uc *pinMessageUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID) (*models.PinnedMessage, error) {
+	message, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if message.Deleted {
+		return nil, ErrMessageDeleted
+	}
+
+	existing, err := uc.pinRepo.GetPin(ctx, message.ConversationID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Message = message
+		return existing, nil
+	}
+
+	// Параллельные закрепления могут ненадолго превысить предел, это допустимо
+	count, err := uc.pinRepo.CountPins(ctx, message.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= uc.maxPins {
+		return nil, ErrTooManyPins
+	}
+
+	pin := &models.PinnedMessage{
+		ConversationID: message.ConversationID,
+		MessageID:      messageID,
+		PinnedBy:       userID,
+		PinnedAt:       time.Now(),
+		Message:        message,
+	}
+	added, err := uc.pinRepo.PinMessage(ctx, pin, message.TTL(pin.PinnedAt))
+	if err != nil {
+		return nil, err
+	}
+	// Сообщение успел закрепить другой участник
+	if !added {
+		existing, err := uc.pinRepo.GetPin(ctx, message.ConversationID, messageID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			existing.Message = message
+			return existing, nil
+		}
+		return pin, nil
+	}
+
+	publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+		Type:    models.EventMessagePinned,
+		Message: message,
+		Pin:     pin,
+	})
+
+	// Системное сообщение остается в истории беседы: участники видят, кто и что закрепил.
+	// Закрепление уже сохранено, поэтому сбой только журналируется
+	if _, err := uc.systemEvents.Execute(ctx, message.ConversationID, &models.SystemEvent{
+		Type:      models.SystemEventMessagePinned,
+		ActorID:   userID,
+		MessageID: messageID,
+	}); err != nil {
+		log.Printf("error posting pin of message %s: %v", messageID, err)
+	}
+	return pin, nil
+}
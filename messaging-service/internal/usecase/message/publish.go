@@ -0,0 +1,51 @@
+package message
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// publishToConversation рассылает событие всем участникам беседы
+// и возвращает их идентификаторы. Изменение к этому моменту уже сохранено,
+// поэтому ошибки рассылки только логируются.
+func publishToConversation(
+	ctx context.Context,
+	conversationRepo repositories.ConversationRepository,
+	hub events.Hub,
+	event *models.MessageEvent,
+) []gocql.UUID {
+	conversationID := event.ConversationID()
+	members, err := conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		log.Printf("error getting members of conversation %s: %v", conversationID, err)
+		return nil
+	}
+	memberIDs := make([]gocql.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID)
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if err := hub.Publish(ctx, event, memberIDs...); err != nil {
+		log.Printf("error publishing %s to conversation %s: %v", event.Type, conversationID, err)
+	}
+	return memberIDs
+}
+
+// updateInboxPreview обновляет текст сообщения в списках бесед, где оно последнее
+func updateInboxPreview(ctx context.Context, inboxRepo repositories.InboxRepository, memberIDs []gocql.UUID, message *models.Message) {
+	if len(memberIDs) == 0 {
+		return
+	}
+	if err := inboxRepo.UpdatePreview(ctx, memberIDs, message); err != nil {
+		log.Printf("error updating inbox preview for message %s: %v", message.MessageID, err)
+	}
+}
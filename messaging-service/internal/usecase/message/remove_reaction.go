@@ -0,0 +1,67 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type RemoveReactionUsecase interface {
+	Execute(ctx context.Context, messageID, userID gocql.UUID, emoji string) ([]*models.ReactionSummary, error)
+}
+
+type removeReactionUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	reactionRepo     repositories.ReactionRepository
+	hub              events.Hub
+}
+
+func NewRemoveReactionUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	reactionRepo repositories.ReactionRepository,
+	hub events.Hub,
+) RemoveReactionUsecase {
+	return &removeReactionUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		reactionRepo:     reactionRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *removeReactionUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, emoji string) ([]*models.ReactionSummary, error) {
+	message, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := uc.reactionRepo.RemoveReaction(ctx, messageID, emoji, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if removed {
+		publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+			Type:    models.EventReactionRemoved,
+			Message: message,
+			Reaction: &models.Reaction{
+				MessageID: messageID,
+				UserID:    userID,
+				Emoji:     emoji,
+				CreatedAt: time.Now(),
+			},
+		})
+	}
+
+	summaries, err := uc.reactionRepo.GetSummaries(ctx, []gocql.UUID{messageID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return summaries[messageID], nil
+}
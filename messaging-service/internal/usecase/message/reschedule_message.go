@@ -0,0 +1,54 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type RescheduleMessageUsecase interface {
+	// Execute переносит отправку на sendAt. Неотправленное сообщение снова ставится в очередь
+	Execute(ctx context.Context, userID, scheduledID gocql.UUID, sendAt time.Time) (*models.ScheduledMessage, error)
+}
+
+type rescheduleMessageUsecase struct {
+	scheduledRepo repositories.ScheduledMessageRepository
+}
+
+func NewRescheduleMessageUsecase(scheduledRepo repositories.ScheduledMessageRepository) RescheduleMessageUsecase {
+	return &rescheduleMessageUsecase{
+		scheduledRepo: scheduledRepo,
+	}
+}
+
+func (uc *rescheduleMessageUsecase) Execute(ctx context.Context, userID, scheduledID gocql.UUID, sendAt time.Time) (*models.ScheduledMessage, error) {
+	if !validSendAt(sendAt, time.Now()) {
+		return nil, ErrInvalidSendAt
+	}
+
+	scheduled, err := uc.scheduledRepo.GetScheduledMessage(ctx, userID, scheduledID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled == nil {
+		return nil, ErrScheduledNotFound
+	}
+
+	rescheduled, err := uc.scheduledRepo.RescheduleMessage(ctx, scheduled, sendAt)
+	if err != nil {
+		return nil, err
+	}
+	if !rescheduled {
+		return nil, ErrScheduledSending
+	}
+
+	scheduled.SendAt = sendAt
+	scheduled.State = models.ScheduledPending
+	scheduled.MessageID = gocql.UUID{}
+	scheduled.ClaimedAt = time.Time{}
+	scheduled.FailureReason = ""
+	return scheduled, nil
+}
@@ -0,0 +1,96 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+const (
+	// MaxScheduleAhead — насколько вперед можно запланировать сообщение
+	MaxScheduleAhead = 365 * 24 * time.Hour
+	// MaxScheduledMessages — сколько запланированных сообщений может быть у пользователя одновременно
+	MaxScheduledMessages = 100
+)
+
+type ScheduleMessageUsecase interface {
+	// Execute откладывает отправку сообщения до sendAt. Доступ к беседе проверяется сразу,
+	// настройки получателя и вложения — в момент отправки
+	Execute(ctx context.Context, message *models.Message, sendAt time.Time) (*models.ScheduledMessage, error)
+}
+
+type scheduleMessageUsecase struct {
+	scheduledRepo    repositories.ScheduledMessageRepository
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewScheduleMessageUsecase(
+	scheduledRepo repositories.ScheduledMessageRepository,
+	conversationRepo repositories.ConversationRepository,
+) ScheduleMessageUsecase {
+	return &scheduleMessageUsecase{
+		scheduledRepo:    scheduledRepo,
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *scheduleMessageUsecase) Execute(ctx context.Context, message *models.Message, sendAt time.Time) (*models.ScheduledMessage, error) {
+	// Набор устройств собеседника к моменту отправки может измениться
+	if message.Encrypted() {
+		return nil, ErrScheduledEncrypted
+	}
+	// Запланированное сообщение хранит только текст с оформлением
+	if message.ContentType != models.ContentTypeUnspecified && message.ContentType != models.ContentText {
+		return nil, ErrScheduledContentType
+	}
+	if err := validateEntities(message.Content, message.Entities); err != nil {
+		return nil, err
+	}
+	if !validSendAt(sendAt, time.Now()) {
+		return nil, ErrInvalidSendAt
+	}
+
+	member, err := uc.conversationRepo.GetMember(ctx, message.ConversationID, message.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+
+	existing, err := uc.scheduledRepo.GetScheduledMessages(ctx, message.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxScheduledMessages {
+		return nil, ErrTooManyScheduled
+	}
+
+	scheduled := &models.ScheduledMessage{
+		ScheduledID:      gocql.TimeUUID(),
+		SenderID:         message.SenderID,
+		ConversationID:   message.ConversationID,
+		RecipientID:      message.RecipientID,
+		Content:          message.Content,
+		Entities:         message.Entities,
+		ReplyToMessageID: message.ReplyToMessageID,
+		SendAt:           sendAt,
+		State:            models.ScheduledPending,
+	}
+	for _, attachment := range message.Attachments {
+		scheduled.AttachmentIDs = append(scheduled.AttachmentIDs, attachment.AttachmentID)
+	}
+	if err := uc.scheduledRepo.SaveScheduledMessage(ctx, scheduled); err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+// validSendAt проверяет, что время отправки в будущем, но не дальше MaxScheduleAhead
+func validSendAt(sendAt, now time.Time) bool {
+	return sendAt.After(now) && !sendAt.After(now.Add(MaxScheduleAhead))
+}
@@ -2,10 +2,23 @@ package message
 
 import (
 	"context"
+	"log"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/clients"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+const (
+	// ClientMessageIDTTL — сколько повторы отправки с тем же client_message_id возвращают исходное сообщение
+	ClientMessageIDTTL = 7 * 24 * time.Hour
+	// pendingClientMessageTTL — сколько идентификатор клиента закреплен за еще не сохраненным сообщением
+	pendingClientMessageTTL = time.Minute
 )
 
 type SendMessageUsecase interface {
@@ -13,17 +26,398 @@ type SendMessageUsecase interface {
 }
 
 type sendMessageUsecase struct {
-	messageRepo repositories.MessageRepository
-	producer    *kafka.Producer
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+	attachmentRepo   repositories.AttachmentRepository
+	keyRepo          repositories.KeyRepository
+	settingsRepo     repositories.MessagingSettingsRepository
+	friendships      clients.FriendshipDirectory
+	users            clients.UserDirectory
+	hub              events.Hub
 }
 
-func NewSendMessageUsecase(messageRepo repositories.MessageRepository, producer *kafka.Producer) SendMessageUsecase {
+func NewSendMessageUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	attachmentRepo repositories.AttachmentRepository,
+	keyRepo repositories.KeyRepository,
+	settingsRepo repositories.MessagingSettingsRepository,
+	friendships clients.FriendshipDirectory,
+	users clients.UserDirectory,
+	hub events.Hub,
+) SendMessageUsecase {
 	return &sendMessageUsecase{
-		messageRepo: messageRepo,
-		producer:    producer,
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+		attachmentRepo:   attachmentRepo,
+		keyRepo:          keyRepo,
+		settingsRepo:     settingsRepo,
+		friendships:      friendships,
+		users:            users,
+		hub:              hub,
 	}
 }
 
 func (uc *sendMessageUsecase) Execute(ctx context.Context, message *models.Message) error {
-	return uc.messageRepo.SaveMessage(ctx, message)
+	// Повтор отправки с тем же идентификатором клиента возвращает уже отправленное сообщение
+	if message.ClientMessageID != (gocql.UUID{}) {
+		originalID, reserved, err := uc.messageRepo.ReserveClientMessageID(ctx, message.SenderID, message.ClientMessageID, message.MessageID, pendingClientMessageTTL)
+		if err != nil {
+			return err
+		}
+		if !reserved {
+			return uc.useOriginal(ctx, message, originalID)
+		}
+	}
+
+	memberIDs, err := uc.prepare(ctx, message)
+	if err != nil {
+		uc.releaseClientMessageID(ctx, message)
+		return err
+	}
+
+	outboxEvents, err := newSentEvents(message, memberIDs, uc.mutedRecipients(ctx, message, memberIDs))
+	if err != nil {
+		uc.unbindAttachments(ctx, message)
+		uc.releaseClientMessageID(ctx, message)
+		return err
+	}
+	// При ошибке сохранения закрепление не снимается: запись могла примениться несмотря на таймаут,
+	// а несохраненное сообщение освободит идентификатор по истечении pendingClientMessageTTL
+	if err := uc.messageRepo.SaveMessage(ctx, message, outboxEvents...); err != nil {
+		uc.unbindUnsavedAttachments(ctx, message)
+		return err
+	}
+	uc.confirmClientMessageID(ctx, message)
+
+	// Сообщение уже сохранено, поэтому ошибки обновления списков бесед и рассылки
+	// не должны приводить к повторной отправке
+	if err := uc.inboxRepo.RecordMessage(ctx, memberIDs, message); err != nil {
+		log.Printf("error updating inbox for message %s: %v", message.MessageID, err)
+	}
+
+	event := &models.MessageEvent{
+		Type:      models.EventMessageCreated,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+	if err := uc.hub.Publish(ctx, event, memberIDs...); err != nil {
+		log.Printf("error publishing message %s: %v", message.MessageID, err)
+	}
+
+	return nil
+}
+
+// prepare проверяет доступ к беседе и дополняет сообщение перед сохранением. Возвращает участников беседы
+func (uc *sendMessageUsecase) prepare(ctx context.Context, message *models.Message) ([]gocql.UUID, error) {
+	if err := validateContent(message); err != nil {
+		return nil, err
+	}
+
+	conv, err := uc.conversationRepo.GetConversation(ctx, message.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conv == nil {
+		return nil, conversation.ErrConversationNotFound
+	}
+
+	members, err := uc.conversationRepo.GetMembers(ctx, message.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]gocql.UUID, 0, len(members))
+	isMember := false
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID)
+		if member.UserID == message.SenderID {
+			isMember = true
+		} else if conv.Type == models.ConversationTypeDirect {
+			// В личной переписке получатель известен, даже если сообщение адресовано по conversation_id
+			message.RecipientID = member.UserID
+		}
+	}
+	if !isMember {
+		return nil, conversation.ErrNotConversationMember
+	}
+
+	// Участников группы выбирают администраторы, поэтому настройки личных сообщений на группы не действуют
+	if conv.Type == models.ConversationTypeDirect && message.RecipientID != (gocql.UUID{}) {
+		if err := uc.checkPolicy(ctx, message.SenderID, message.RecipientID); err != nil {
+			return nil, err
+		}
+	}
+
+	if message.Encrypted() {
+		if conv.Type != models.ConversationTypeDirect {
+			return nil, ErrGroupEncryption
+		}
+		if err := uc.checkDevices(ctx, message, memberIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Таймер беседы действует на сообщения, отправленные после его установки
+	if conv.MessageTTL > 0 {
+		message.ExpiresAt = message.Timestamp.Add(conv.MessageTTL)
+	}
+
+	if message.ReplyToMessageID != (gocql.UUID{}) {
+		if err := uc.attachToThread(ctx, message); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(message.Attachments) > 0 {
+		if err := uc.bindAttachments(ctx, message); err != nil {
+			return nil, err
+		}
+	}
+
+	// Упоминания не обязательны для отправки, поэтому недоступность user-service ее не прерывает
+	mentions, err := resolveMentions(ctx, uc.users, message.Content, memberIDs)
+	if err != nil {
+		log.Printf("error resolving mentions in message %s: %v", message.MessageID, err)
+	}
+	message.Mentions = mentions
+
+	return memberIDs, nil
+}
+
+// useOriginal подменяет повторно отправленное сообщение исходным
+func (uc *sendMessageUsecase) useOriginal(ctx context.Context, message *models.Message, originalID gocql.UUID) error {
+	original, err := uc.messageRepo.GetMessageByID(ctx, originalID)
+	if err != nil {
+		return err
+	}
+	// Идентификатор закреплен, но сообщение еще не сохранено другой попыткой отправки
+	if original == nil {
+		return ErrSendInProgress
+	}
+	clientMessageID := message.ClientMessageID
+	*message = *original
+	message.ClientMessageID = clientMessageID
+	return nil
+}
+
+// confirmClientMessageID продлевает закрепление идентификатора клиента, но не дольше жизни сообщения
+func (uc *sendMessageUsecase) confirmClientMessageID(ctx context.Context, message *models.Message) {
+	if message.ClientMessageID == (gocql.UUID{}) {
+		return
+	}
+	ttl := ClientMessageIDTTL
+	if messageTTL := message.TTL(time.Now()); message.Expires() && messageTTL < ttl {
+		ttl = messageTTL
+	}
+	if err := uc.messageRepo.ConfirmClientMessageID(ctx, message.SenderID, message.ClientMessageID, message.MessageID, ttl); err != nil {
+		log.Printf("error confirming client message id of message %s: %v", message.MessageID, err)
+	}
+}
+
+// releaseClientMessageID освобождает идентификатор клиента, чтобы исправленный запрос можно было повторить
+func (uc *sendMessageUsecase) releaseClientMessageID(ctx context.Context, message *models.Message) {
+	if message.ClientMessageID == (gocql.UUID{}) {
+		return
+	}
+	if err := uc.messageRepo.ReleaseClientMessageID(ctx, message.SenderID, message.ClientMessageID, message.MessageID); err != nil {
+		log.Printf("error releasing client message id of message %s: %v", message.MessageID, err)
+	}
+}
+
+// mutedRecipients возвращает получателей, отключивших уведомления беседы. Без настроек
+// получатели уведомляются, пропущенное уведомление хуже лишнего
+func (uc *sendMessageUsecase) mutedRecipients(ctx context.Context, message *models.Message, memberIDs []gocql.UUID) []gocql.UUID {
+	recipientIDs := make([]gocql.UUID, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID != message.SenderID {
+			recipientIDs = append(recipientIDs, memberID)
+		}
+	}
+	mutedIDs, err := uc.inboxRepo.GetMutedUserIDs(ctx, message.ConversationID, recipientIDs, message.Timestamp)
+	if err != nil {
+		log.Printf("error getting muted recipients of message %s: %v", message.MessageID, err)
+	}
+	return mutedIDs
+}
+
+// newSentEvents готовит события отправки сообщения и отдельное событие для упомянутых участников
+func newSentEvents(message *models.Message, memberIDs, mutedIDs []gocql.UUID) ([]*models.OutboxEvent, error) {
+	sent, err := events.NewMessageSentEvent(message, memberIDs, mutedIDs)
+	if err != nil {
+		return nil, err
+	}
+	mentionedIDs := message.MentionedUserIDs()
+	if len(mentionedIDs) == 0 {
+		return []*models.OutboxEvent{sent}, nil
+	}
+	mentioned, err := events.NewMessageMentionedEvent(message, mentionedIDs)
+	if err != nil {
+		return nil, err
+	}
+	return []*models.OutboxEvent{sent, mentioned}, nil
+}
+
+// attachToThread проверяет сообщение, на которое дается ответ, и определяет корень ветки.
+// Ответ на ответ попадает в ту же ветку, вложенных веток нет.
+func (uc *sendMessageUsecase) attachToThread(ctx context.Context, message *models.Message) error {
+	parent, err := uc.messageRepo.GetMessageByID(ctx, message.ReplyToMessageID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return ErrReplyToNotFound
+	}
+	if parent.ConversationID != message.ConversationID {
+		return ErrReplyToForeign
+	}
+	if parent.Deleted {
+		return ErrMessageDeleted
+	}
+
+	message.ThreadRootID = parent.MessageID
+	if parent.IsReply() {
+		message.ThreadRootID = parent.ThreadRootID
+	}
+	return nil
+}
+
+// checkPolicy проверяет, что получатель принимает личные сообщения от отправителя
+func (uc *sendMessageUsecase) checkPolicy(ctx context.Context, senderID, recipientID gocql.UUID) error {
+	relationship, err := uc.friendships.GetRelationship(ctx, recipientID, senderID)
+	if err != nil {
+		return err
+	}
+	if relationship.Blocked {
+		return ErrBlockedByRecipient
+	}
+
+	policy, err := uc.settingsRepo.GetMessagingPolicy(ctx, recipientID)
+	if err != nil {
+		return err
+	}
+	switch policy.OrDefault() {
+	case models.MessagingPolicyNobody:
+		return ErrRecipientNotAccepting
+	case models.MessagingPolicyFriends:
+		if !relationship.Friends {
+			return ErrRecipientFriendsOnly
+		}
+	}
+	return nil
+}
+
+// checkDevices проверяет, что шифротекст есть ровно у каждого устройства участников, кроме устройства отправителя.
+// Иначе часть устройств не сможет прочитать сообщение, и клиенту нужно обновить список устройств
+func (uc *sendMessageUsecase) checkDevices(ctx context.Context, message *models.Message, memberIDs []gocql.UUID) error {
+	type deviceKey struct{ userID, deviceID gocql.UUID }
+
+	expected := make(map[deviceKey]bool)
+	senderDevice := deviceKey{message.SenderID, message.SenderDeviceID}
+	senderDeviceFound := false
+	for _, memberID := range memberIDs {
+		devices, err := uc.keyRepo.GetDevices(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if len(devices) == 0 && memberID != message.SenderID {
+			return ErrDeviceMismatch
+		}
+		for _, device := range devices {
+			key := deviceKey{device.UserID, device.DeviceID}
+			if key == senderDevice {
+				senderDeviceFound = true
+				continue
+			}
+			expected[key] = true
+		}
+	}
+	if !senderDeviceFound || len(message.EncryptedPayloads) != len(expected) {
+		return ErrDeviceMismatch
+	}
+	for _, payload := range message.EncryptedPayloads {
+		key := deviceKey{payload.UserID, payload.DeviceID}
+		if !expected[key] {
+			return ErrDeviceMismatch
+		}
+		// Повторный шифротекст для того же устройства не засчитывается дважды
+		delete(expected, key)
+	}
+	return nil
+}
+
+// bindAttachments заменяет идентификаторы вложений их сведениями и привязывает файлы к сообщению
+func (uc *sendMessageUsecase) bindAttachments(ctx context.Context, message *models.Message) error {
+	attachments := make([]*models.Attachment, len(message.Attachments))
+	for i, requested := range message.Attachments {
+		found, err := uc.attachmentRepo.GetAttachment(ctx, requested.AttachmentID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return attachment.ErrAttachmentNotFound
+		}
+		if found.UploaderID != message.SenderID {
+			return attachment.ErrNotAttachmentUploader
+		}
+		// Файл, привязанный к этому же сообщению, остался от прерванной доставки запланированного сообщения
+		if found.Attached() && found.MessageID != message.MessageID {
+			return attachment.ErrAttachmentAlreadySent
+		}
+		attachments[i] = found
+	}
+	if err := checkContentAttachment(message, attachments); err != nil {
+		return err
+	}
+
+	// Привязка выполняется после всех проверок, одновременная отправка файла отсекается транзакцией.
+	// Если какой-то файл привязать не удалось, уже привязанные освобождаются
+	message.Attachments = attachments
+	for _, found := range attachments {
+		bound, err := uc.attachmentRepo.BindAttachment(ctx, found.AttachmentID, message.ConversationID, message.MessageID)
+		if err == nil && !bound {
+			err = attachment.ErrAttachmentAlreadySent
+		}
+		if err != nil {
+			uc.unbindAttachments(ctx, message)
+			return err
+		}
+		found.ConversationID = message.ConversationID
+		found.MessageID = message.MessageID
+	}
+	return nil
+}
+
+// unbindAttachments освобождает файлы, привязанные к несохраненному сообщению, чтобы их можно было отправить повторно
+func (uc *sendMessageUsecase) unbindAttachments(ctx context.Context, message *models.Message) {
+	for _, bound := range message.Attachments {
+		if bound.MessageID != message.MessageID {
+			continue
+		}
+		if err := uc.attachmentRepo.UnbindAttachment(ctx, bound.AttachmentID, message.MessageID); err != nil {
+			log.Printf("error unbinding attachment %s from message %s: %v", bound.AttachmentID, message.MessageID, err)
+			continue
+		}
+		bound.ConversationID = gocql.UUID{}
+		bound.MessageID = gocql.UUID{}
+	}
+}
+
+// unbindUnsavedAttachments освобождает файлы после ошибки сохранения. Запись могла примениться
+// несмотря на ошибку, поэтому файлы сохраненного сообщения остаются привязанными
+func (uc *sendMessageUsecase) unbindUnsavedAttachments(ctx context.Context, message *models.Message) {
+	if len(message.Attachments) == 0 {
+		return
+	}
+	saved, err := uc.messageRepo.GetMessageByID(ctx, message.MessageID)
+	if err != nil {
+		log.Printf("error checking unsaved message %s: %v", message.MessageID, err)
+		return
+	}
+	if saved == nil {
+		uc.unbindAttachments(ctx, message)
+	}
 }
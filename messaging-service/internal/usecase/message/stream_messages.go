@@ -0,0 +1,27 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+type StreamMessagesUsecase interface {
+	Execute(ctx context.Context, userID gocql.UUID, lastEventID string) (<-chan *models.MessageEvent, error)
+}
+
+type streamMessagesUsecase struct {
+	hub events.Hub
+}
+
+func NewStreamMessagesUsecase(hub events.Hub) StreamMessagesUsecase {
+	return &streamMessagesUsecase{
+		hub: hub,
+	}
+}
+
+func (uc *streamMessagesUsecase) Execute(ctx context.Context, userID gocql.UUID, lastEventID string) (<-chan *models.MessageEvent, error) {
+	return uc.hub.Subscribe(ctx, userID, lastEventID)
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessageUsecaseExecuteReservesClientMessageID(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ClientMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("ReserveClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(msg.MessageID, true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockRepo.On("ConfirmClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, message.ClientMessageIDTTL).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteRetryReturnsOriginal(t *testing.T) {
+	ctx := context.Background()
+	original := newTestMessage()
+	retry := newTestMessage()
+	retry.SenderID = original.SenderID
+	retry.ConversationID = original.ConversationID
+	retry.ClientMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("ReserveClientMessageID", ctx, retry.SenderID, retry.ClientMessageID, retry.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(original.MessageID, false, nil)
+	mockRepo.On("GetMessageByID", ctx, original.MessageID).Return(original, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	require.NoError(t, usecase.Execute(ctx, retry))
+
+	// Повтор не создает второе сообщение и не рассылает его участникам
+	assert.Equal(t, original.MessageID, retry.MessageID)
+	assert.Equal(t, original.Timestamp, retry.Timestamp)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockConvRepo.AssertNotCalled(t, "GetConversation", mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteRetryInProgress(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ClientMessageID = gocql.TimeUUID()
+	pendingID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("ReserveClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(pendingID, false, nil)
+	mockRepo.On("GetMessageByID", ctx, pendingID).Return(nil, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrSendInProgress)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteReleasesClientMessageIDOnRejection(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ClientMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleAdmin},
+	}, nil)
+	mockRepo.On("ReserveClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(msg.MessageID, true, nil)
+	mockRepo.On("ReleaseClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteKeepsClientMessageIDOnSaveError(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ClientMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("ReserveClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(msg.MessageID, true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(assert.AnError)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Запись могла примениться несмотря на ошибку, поэтому повтор должен найти исходное сообщение
+	assert.ErrorIs(t, err, assert.AnError)
+	mockRepo.AssertNotCalled(t, "ReleaseClientMessageID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "ConfirmClientMessageID", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteClientMessageIDExpiresWithMessage(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ClientMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeDirect,
+		MessageTTL:     time.Hour,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleMember},
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleMember},
+	}, nil)
+	mockRepo.On("ReserveClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.AnythingOfType("time.Duration")).
+		Return(msg.MessageID, true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockRepo.On("ConfirmClientMessageID", ctx, msg.SenderID, msg.ClientMessageID, msg.MessageID, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 0 && ttl <= time.Hour
+	})).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	mockRepo.AssertExpectations(t)
+}
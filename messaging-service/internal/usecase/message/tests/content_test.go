@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessageUsecaseExecuteLocation(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = ""
+	msg.ContentType = models.ContentLocation
+	msg.Location = &models.Location{Latitude: 55.7558, Longitude: 37.6173, Title: "Красная площадь"}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	require.NoError(t, err)
+	// В предпросмотре беседы геопозиция показывается названием
+	assert.Equal(t, "Красная площадь", models.MessagePreview(msg))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteInvalidContent(t *testing.T) {
+	ctx := context.Background()
+
+	withEntities := func(entities ...*models.TextEntity) *models.Message {
+		msg := newTestMessage()
+		msg.Entities = entities
+		return msg
+	}
+	locationWithText := newTestMessage()
+	locationWithText.ContentType = models.ContentLocation
+	locationWithText.Location = &models.Location{Latitude: 10, Longitude: 10}
+	outOfRange := newTestMessage()
+	outOfRange.Content = ""
+	outOfRange.ContentType = models.ContentLocation
+	outOfRange.Location = &models.Location{Latitude: 91, Longitude: 10}
+	noContactInfo := newTestMessage()
+	noContactInfo.Content = ""
+	noContactInfo.ContentType = models.ContentContact
+	noContactInfo.Contact = &models.ContactCard{Name: "Иван"}
+	imageWithoutAttachment := newTestMessage()
+	imageWithoutAttachment.ContentType = models.ContentImage
+	imageWithoutAttachment.ContentAttachmentID = gocql.TimeUUID()
+	systemEvent := newTestMessage()
+	systemEvent.Content = ""
+	systemEvent.ContentType = models.ContentSystemEvent
+	systemEvent.SystemEvent = &models.SystemEvent{Type: models.SystemEventMembersAdded}
+
+	tests := []struct {
+		name    string
+		message *models.Message
+		err     error
+	}{
+		{"entity out of text", withEntities(&models.TextEntity{Type: models.TextEntityBold, Offset: 3, Length: 3}), message.ErrInvalidEntities},
+		{"entity without type", withEntities(&models.TextEntity{Offset: 0, Length: 2}), message.ErrInvalidEntities},
+		{"link without url", withEntities(&models.TextEntity{Type: models.TextEntityTextLink, Offset: 0, Length: 5}), message.ErrInvalidEntities},
+		{"link with unsafe url", withEntities(&models.TextEntity{Type: models.TextEntityTextLink, Offset: 0, Length: 5, URL: "javascript:alert(1)"}), message.ErrInvalidEntities},
+		{"url on bold", withEntities(&models.TextEntity{Type: models.TextEntityBold, Offset: 0, Length: 5, URL: "https://example.com"}), message.ErrInvalidEntities},
+		{"language on code", withEntities(&models.TextEntity{Type: models.TextEntityCode, Offset: 0, Length: 5, Language: "go"}), message.ErrInvalidEntities},
+		{"location with text", locationWithText, message.ErrInvalidContent},
+		{"location out of range", outOfRange, message.ErrInvalidLocation},
+		{"contact without phone, email or user", noContactInfo, message.ErrInvalidContact},
+		{"image without attachment", imageWithoutAttachment, message.ErrContentAttachment},
+		{"system event", systemEvent, message.ErrSystemContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MessageRepository)
+			usecase := message.NewSendMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+			err := usecase.Execute(ctx, tt.message)
+
+			assert.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSendMessageUsecaseExecuteFormattedText(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = "привет, мир"
+	msg.Entities = []*models.TextEntity{
+		{Type: models.TextEntityBold, Offset: 0, Length: 6},
+		{Type: models.TextEntityTextLink, Offset: 8, Length: 3, URL: "https://example.com"},
+	}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Смещения считаются в символах, а не в байтах
+	require.NoError(t, err)
+	assert.Equal(t, models.ContentText, msg.ContentType)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteImageNotImage(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{
+		AttachmentID: gocql.TimeUUID(),
+		UploaderID:   msg.SenderID,
+		FileName:     "report.pdf",
+		MimeType:     "application/pdf",
+	}
+	msg.ContentType = models.ContentImage
+	msg.ContentAttachmentID = stored.AttachmentID
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrAttachmentNotImage)
+	mockAttachmentRepo.AssertNotCalled(t, "BindAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEditMessageUsecaseExecuteLocation(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = ""
+	msg.ContentType = models.ContentLocation
+	msg.Location = &models.Location{Latitude: 10, Longitude: 10}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.UserDirectory), new(mocks.Hub))
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "somewhere", nil)
+
+	assert.ErrorIs(t, err, message.ErrContentNotEditable)
+	mockRepo.AssertNotCalled(t, "EditMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScheduleMessageUsecaseExecuteNotText(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = ""
+	msg.ContentType = models.ContentLocation
+	msg.Location = &models.Location{Latitude: 10, Longitude: 10}
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	usecase := message.NewScheduleMessageUsecase(mockScheduledRepo, new(mocks.ConversationRepository))
+	_, err := usecase.Execute(ctx, msg, time.Now().Add(time.Hour))
+
+	assert.ErrorIs(t, err, message.ErrScheduledContentType)
+	mockScheduledRepo.AssertNotCalled(t, "SaveScheduledMessage", mock.Anything, mock.Anything)
+}
+
+func TestParseContentType(t *testing.T) {
+	// Строки, сохраненные до появления типов содержимого, читаются как текст
+	assert.Equal(t, models.ContentText, models.ParseContentType(""))
+	for _, contentType := range []models.ContentType{
+		models.ContentText, models.ContentImage, models.ContentFile,
+		models.ContentLocation, models.ContentContact, models.ContentSystemEvent,
+	} {
+		assert.Equal(t, contentType, models.ParseContentType(contentType.String()))
+	}
+	assert.Equal(t, models.ContentTypeUnspecified, models.ParseContentType("sticker"))
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteMessageUsecaseExecuteForEveryone(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("DeleteMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessageDeleted && event.Message.Deleted && event.Message.Content == ""
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	mockPinRepo := new(mocks.PinRepository)
+	mockPinRepo.On("UnpinMessage", ctx, msg.ConversationID, msg.MessageID).Return(true, nil)
+
+	usecase := message.NewDeleteMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockPinRepo, mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, models.DeleteModeForEveryone)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockPinRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestDeleteMessageUsecaseExecuteForMe(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("HideMessage", ctx, msg.SenderID, msg).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID).Return(nil)
+
+	usecase := message.NewDeleteMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), newTestPinRepo(), mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, models.DeleteModeForMe)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestDeleteMessageUsecaseExecuteNotSender(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewDeleteMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), newTestPinRepo(), mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.DeleteModeForEveryone)
+
+	assert.ErrorIs(t, err, message.ErrNotMessageSender)
+	mockRepo.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessageUsecaseExecuteDisappearing(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeDirect,
+		MessageTTL:     time.Hour,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleMember},
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleMember},
+	}, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	require.NoError(t, err)
+	assert.Equal(t, msg.Timestamp.Add(time.Hour), msg.ExpiresAt)
+	assert.True(t, msg.Expires())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAddReactionUsecaseExecuteDisappearing(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ExpiresAt = time.Now().Add(time.Hour)
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	// Реакция исчезает вместе с сообщением
+	mockReactionRepo.On("AddReaction", ctx, mock.Anything, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 59*time.Minute && ttl <= time.Hour
+	})).Return(true, nil)
+	mockReactionRepo.On("GetSummaries", ctx, []gocql.UUID{msg.MessageID}, msg.RecipientID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockHub)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "👍")
+
+	require.NoError(t, err)
+	mockReactionRepo.AssertExpectations(t)
+}
+
+func TestMessageTTL(t *testing.T) {
+	now := time.Now()
+	msg := newTestMessage()
+	assert.Zero(t, msg.TTL(now))
+
+	msg.ExpiresAt = now.Add(90 * time.Second)
+	assert.Equal(t, 90*time.Second, msg.TTL(now))
+
+	// Истекшее, но еще не удаленное сообщение не должно получить записи без срока
+	msg.ExpiresAt = now.Add(-time.Minute)
+	assert.Equal(t, time.Second, msg.TTL(now))
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEditMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("EditMessage", ctx, msg, mock.MatchedBy(func(edit *models.MessageEdit) bool {
+		return edit.MessageID == msg.MessageID && edit.Content == "hello"
+	}), mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessageEdited && event.Message.Content == "hello, world"
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.UserDirectory), mockHub)
+	edited, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "hello, world", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "hello, world", edited.Content)
+	assert.True(t, edited.Edited())
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestEditMessageUsecaseExecuteNotSender(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.UserDirectory), mockHub)
+	edited, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "hello, world", nil)
+
+	assert.ErrorIs(t, err, message.ErrNotMessageSender)
+	assert.Nil(t, edited)
+	mockRepo.AssertNotCalled(t, "EditMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEditMessageUsecaseExecuteDeleted(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Deleted = true
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.UserDirectory), new(mocks.Hub))
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "hello, world", nil)
+
+	assert.ErrorIs(t, err, message.ErrMessageDeleted)
+	mockRepo.AssertNotCalled(t, "EditMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEditMessageUsecaseExecuteNotFound(t *testing.T) {
+	ctx := context.Background()
+	messageID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, messageID).Return(nil, nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.UserDirectory), new(mocks.Hub))
+	_, err := usecase.Execute(ctx, messageID, gocql.TimeUUID(), "hello, world", nil)
+
+	assert.ErrorIs(t, err, message.ErrMessageNotFound)
+}
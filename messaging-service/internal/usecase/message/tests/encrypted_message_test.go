@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newEncryptedMessage возвращает сообщение с шифротекстами для устройств получателя и второго устройства отправителя
+func newEncryptedMessage() (*models.Message, *mocks.KeyRepository) {
+	msg := newTestMessage()
+	msg.Content = ""
+	msg.SenderDeviceID = gocql.TimeUUID()
+	senderTablet := gocql.TimeUUID()
+	recipientPhone := gocql.TimeUUID()
+	msg.EncryptedPayloads = []*models.EncryptedPayload{
+		{UserID: msg.SenderID, DeviceID: senderTablet, Type: models.PayloadTypeMessage, Ciphertext: []byte("c1")},
+		{UserID: msg.RecipientID, DeviceID: recipientPhone, Type: models.PayloadTypePreKey, Ciphertext: []byte("c2")},
+	}
+
+	keyRepo := new(mocks.KeyRepository)
+	keyRepo.On("GetDevices", mock.Anything, msg.SenderID).Return([]*models.DeviceKeys{
+		{UserID: msg.SenderID, DeviceID: msg.SenderDeviceID},
+		{UserID: msg.SenderID, DeviceID: senderTablet},
+	}, nil)
+	keyRepo.On("GetDevices", mock.Anything, msg.RecipientID).Return([]*models.DeviceKeys{
+		{UserID: msg.RecipientID, DeviceID: recipientPhone},
+	}, nil)
+	return msg, keyRepo
+}
+
+func TestSendMessageUsecaseExecuteEncrypted(t *testing.T) {
+	ctx := context.Background()
+	msg, keyRepo := newEncryptedMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), keyRepo, newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	require.NoError(t, err)
+	assert.True(t, msg.Encrypted())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteEncryptedMissingDevice(t *testing.T) {
+	ctx := context.Background()
+	msg, keyRepo := newEncryptedMessage()
+	// Шифротекст для второго устройства отправителя не передан
+	msg.EncryptedPayloads = msg.EncryptedPayloads[1:]
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), keyRepo, newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrDeviceMismatch)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteEncryptedUnknownDevice(t *testing.T) {
+	ctx := context.Background()
+	msg, keyRepo := newEncryptedMessage()
+	msg.EncryptedPayloads[1].DeviceID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), keyRepo, newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrDeviceMismatch)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteEncryptedInGroup(t *testing.T) {
+	ctx := context.Background()
+	msg, keyRepo := newEncryptedMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleAdmin},
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleMember},
+	}, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), keyRepo, newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrGroupEncryption)
+	keyRepo.AssertNotCalled(t, "GetDevices", mock.Anything, mock.Anything)
+}
+
+func TestEditMessageUsecaseExecuteEncrypted(t *testing.T) {
+	ctx := context.Background()
+	msg, _ := newEncryptedMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.UserDirectory), new(mocks.Hub))
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "plain text", nil)
+
+	assert.ErrorIs(t, err, message.ErrMessageEncrypted)
+	mockRepo.AssertNotCalled(t, "EditMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
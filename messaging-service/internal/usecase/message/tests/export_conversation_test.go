@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newExportHistory возвращает count сообщений беседы от старых к новым
+func newExportHistory(conversationID gocql.UUID, count int) []*models.Message {
+	messages := make([]*models.Message, count)
+	for i := range messages {
+		messages[i] = &models.Message{
+			MessageID:      gocql.TimeUUID(),
+			SenderID:       gocql.TimeUUID(),
+			ConversationID: conversationID,
+			Content:        "message",
+			Status:         models.StatusSent,
+			Timestamp:      time.Now(),
+		}
+	}
+	return messages
+}
+
+// exportedIDs возвращает идентификаторы сообщений из выгрузки JSON Lines
+func exportedIDs(t *testing.T, output string) []string {
+	var ids []string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		var line struct {
+			MessageID string `json:"message_id"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		ids = append(ids, line.MessageID)
+	}
+	return ids
+}
+
+func TestExportConversationUsecaseExecutePagesHistory(t *testing.T) {
+	ctx := context.Background()
+	conv := &models.Conversation{ConversationID: gocql.TimeUUID(), Type: models.ConversationTypeGroup}
+	userID := gocql.TimeUUID()
+	// Полная первая страница и неполная вторая
+	history := newExportHistory(conv.ConversationID, 101)
+	hidden := history[3]
+	edited := history[100]
+	edited.EditedAt = time.Now()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetConversation", ctx, conv.ConversationID).Return(conv, nil)
+	mockMember(ctx, mockConvRepo, conv.ConversationID, userID)
+	mockRepo.On("GetMessages", ctx, conv.ConversationID, models.PageQuery{
+		Direction: models.PageDirectionNewer,
+		Limit:     100,
+	}).Return(history[:100], nil)
+	mockRepo.On("GetMessages", ctx, conv.ConversationID, models.PageQuery{
+		Cursor:    history[99].MessageID,
+		Direction: models.PageDirectionNewer,
+		Limit:     100,
+	}).Return(history[100:], nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conv.ConversationID, mock.Anything).
+		Return(map[gocql.UUID]bool{hidden.MessageID: true}, nil).Once()
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conv.ConversationID, mock.Anything).Return(nil, nil).Once()
+	mockRepo.On("GetMessageEdits", ctx, edited.MessageID).Return([]*models.MessageEdit{
+		{MessageID: edited.MessageID, Content: "before", EditedAt: edited.EditedAt},
+	}, nil)
+
+	var buf bytes.Buffer
+	usecase := message.NewExportConversationUsecase(mockRepo, mockConvRepo, nil)
+	err := usecase.Execute(ctx, conv.ConversationID, userID, models.ExportFormatJSONL, &buf)
+
+	require.NoError(t, err)
+	ids := exportedIDs(t, buf.String())
+	require.Len(t, ids, 100)
+	assert.Equal(t, history[0].MessageID.String(), ids[0])
+	assert.Equal(t, edited.MessageID.String(), ids[99])
+	assert.NotContains(t, ids, hidden.MessageID.String())
+	assert.Contains(t, buf.String(), `"edits":[{"content":"before"`)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExportConversationUsecaseExecuteSupport(t *testing.T) {
+	ctx := context.Background()
+	conv := &models.Conversation{ConversationID: gocql.TimeUUID(), Type: models.ConversationTypeDirect}
+	supportID := gocql.TimeUUID()
+	history := newExportHistory(conv.ConversationID, 2)
+	// Удаленное сообщение выгружается без истории правок
+	history[1].Deleted = true
+	history[1].EditedAt = time.Now()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetConversation", ctx, conv.ConversationID).Return(conv, nil)
+	mockRepo.On("GetMessages", ctx, conv.ConversationID, mock.Anything).Return(history, nil)
+
+	var buf bytes.Buffer
+	usecase := message.NewExportConversationUsecase(mockRepo, mockConvRepo, []gocql.UUID{supportID})
+	err := usecase.Execute(ctx, conv.ConversationID, supportID, models.ExportFormatHTML, &buf)
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "Direct conversation")
+	assert.Contains(t, buf.String(), "Message deleted")
+	mockConvRepo.AssertNotCalled(t, "GetMember", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "GetHiddenMessageIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "GetMessageEdits", mock.Anything, mock.Anything)
+}
+
+func TestExportConversationUsecaseExecuteDenied(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	userID := gocql.TimeUUID()
+
+	tests := []struct {
+		name   string
+		format models.ExportFormat
+		setup  func(repo *mocks.ConversationRepository)
+		err    error
+	}{
+		{
+			name:   "unsupported format",
+			format: models.ExportFormatUnspecified,
+			setup:  func(repo *mocks.ConversationRepository) {},
+			err:    message.ErrInvalidExportFormat,
+		},
+		{
+			name:   "conversation not found",
+			format: models.ExportFormatJSONL,
+			setup: func(repo *mocks.ConversationRepository) {
+				repo.On("GetConversation", ctx, conversationID).Return(nil, nil)
+			},
+			err: conversation.ErrConversationNotFound,
+		},
+		{
+			name:   "not a member",
+			format: models.ExportFormatJSONL,
+			setup: func(repo *mocks.ConversationRepository) {
+				repo.On("GetConversation", ctx, conversationID).Return(&models.Conversation{ConversationID: conversationID}, nil)
+				repo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+			},
+			err: conversation.ErrNotConversationMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MessageRepository)
+			mockConvRepo := new(mocks.ConversationRepository)
+			tt.setup(mockConvRepo)
+
+			var buf bytes.Buffer
+			usecase := message.NewExportConversationUsecase(mockRepo, mockConvRepo, nil)
+			err := usecase.Execute(ctx, conversationID, userID, tt.format, &buf)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Zero(t, buf.Len())
+			mockRepo.AssertNotCalled(t, "GetMessages", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestHistory возвращает сообщения беседы от новых к старым
+func newTestHistory(conversationID gocql.UUID, count int) []*models.Message {
+	start := time.Now().Add(-time.Hour)
+	messages := make([]*models.Message, count)
+	for i := 0; i < count; i++ {
+		ts := start.Add(time.Duration(count-i) * time.Second)
+		messages[i] = &models.Message{
+			MessageID:      gocql.UUIDFromTime(ts),
+			ConversationID: conversationID,
+			Content:        "hello",
+			Timestamp:      ts,
+		}
+	}
+	return messages
+}
+
+func mockMember(ctx context.Context, repo *mocks.ConversationRepository, conversationID, userID gocql.UUID) {
+	repo.On("GetMember", ctx, conversationID, userID).Return(&models.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Role:           models.RoleMember,
+	}, nil)
+}
+
+// mockReadMarks задает участников беседы и их отметки прочтения
+func mockReadMarks(ctx context.Context, repo *mocks.ConversationRepository, conversationID gocql.UUID, marks map[gocql.UUID]gocql.UUID, memberIDs ...gocql.UUID) *mocks.InboxRepository {
+	members := make([]*models.ConversationMember, len(memberIDs))
+	for i, memberID := range memberIDs {
+		members[i] = &models.ConversationMember{ConversationID: conversationID, UserID: memberID}
+	}
+	repo.On("GetMembers", ctx, conversationID).Return(members, nil)
+
+	inboxRepo := new(mocks.InboxRepository)
+	inboxRepo.On("GetReadMarks", ctx, conversationID).Return(marks, nil)
+	return inboxRepo
+}
+
+func TestGetMessagesUsecaseExecuteOlder(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+	history := newTestHistory(conversationID, 3)
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockRepo.On("GetMessages", ctx, conversationID, models.PageQuery{
+		Direction: models.PageDirectionOlder,
+		Limit:     3,
+	}).Return(history, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, conversationID, nil, userID)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, history[:2], page.Messages)
+	assert.Equal(t, history[1].MessageID, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetMessagesUsecaseExecuteNewer(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+	history := newTestHistory(conversationID, 3)
+	cursor := history[2].MessageID
+	// Репозиторий отдает более новые сообщения от старых к новым
+	ascending := []*models.Message{history[1], history[0]}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockRepo.On("GetMessages", ctx, conversationID, models.PageQuery{
+		Cursor:    cursor,
+		Direction: models.PageDirectionNewer,
+		Limit:     11,
+	}).Return(ascending, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, conversationID, nil, userID)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{
+		Cursor:    cursor,
+		Direction: models.PageDirectionNewer,
+		Limit:     10,
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, []*models.Message{history[0], history[1]}, page.Messages)
+	assert.Equal(t, history[0].MessageID, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetMessagesUsecaseExecuteSkipsHidden(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+	history := newTestHistory(conversationID, 3)
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockRepo.On("GetMessages", ctx, conversationID, mock.Anything).Return(history, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conversationID, []gocql.UUID{
+		history[0].MessageID, history[1].MessageID, history[2].MessageID,
+	}).Return(map[gocql.UUID]bool{history[1].MessageID: true}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, conversationID, nil, userID)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Message{history[0], history[2]}, page.Messages)
+	assert.Equal(t, history[2].MessageID, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetMessagesUsecaseExecuteAttachesReactions(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+	history := newTestHistory(conversationID, 2)
+	history[1].Deleted = true
+	summaries := []*models.ReactionSummary{{Emoji: "👍", Count: 2, ReactedByMe: true}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockRepo.On("GetMessages", ctx, conversationID, mock.Anything).Return(history, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	// Реакции удаленных сообщений не запрашиваются
+	mockReactionRepo.On("GetSummaries", ctx, []gocql.UUID{history[0].MessageID}, userID).Return(map[gocql.UUID][]*models.ReactionSummary{
+		history[0].MessageID: summaries,
+	}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, conversationID, nil, userID)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, summaries, page.Messages[0].Reactions)
+	assert.Nil(t, page.Messages[1].Reactions)
+	mockReactionRepo.AssertExpectations(t)
+}
+
+func TestGetMessagesUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, new(mocks.InboxRepository))
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{})
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	assert.Nil(t, page)
+	mockRepo.AssertNotCalled(t, "GetMessages", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPageToken(t *testing.T) {
+	cursor := gocql.TimeUUID()
+
+	token := message.EncodePageToken(cursor)
+	decoded, err := message.DecodePageToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	empty, err := message.DecodePageToken("")
+	assert.NoError(t, err)
+	assert.Equal(t, gocql.UUID{}, empty)
+
+	_, err = message.DecodePageToken("not-a-token")
+	assert.ErrorIs(t, err, message.ErrInvalidPageToken)
+}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendMessageUsecaseExecuteUpdatesInbox(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockInbox.On("GetMutedUserIDs", ctx, msg.ConversationID, []gocql.UUID{msg.RecipientID}, msg.Timestamp).Return(nil, nil)
+	mockInbox.On("RecordMessage", ctx, []gocql.UUID{msg.SenderID, msg.RecipientID}, msg).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, mockInbox, new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.NoError(t, err)
+	mockInbox.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteInboxFailure(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockInbox.On("GetMutedUserIDs", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockInbox.On("RecordMessage", ctx, mock.Anything, msg).Return(assert.AnError)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, mockInbox, new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Сообщение сохранено, поэтому сбой списка бесед не возвращается клиенту
+	assert.NoError(t, err)
+	mockHub.AssertExpectations(t)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteReadMovesWatermark(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("UpdateMessageStatus", ctx, msg, models.StatusRead, mock.Anything).Return(nil)
+	mockInbox.On("MarkRead", ctx, msg.RecipientID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, mockInbox, mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusRead)
+
+	assert.NoError(t, err)
+	mockInbox.AssertExpectations(t)
+}
+
+func TestEditMessageUsecaseExecuteUpdatesInboxPreview(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("EditMessage", ctx, msg, mock.Anything, mock.Anything).Return(nil)
+	mockInbox.On("UpdatePreview", ctx, []gocql.UUID{msg.SenderID, msg.RecipientID}, mock.MatchedBy(func(edited *models.Message) bool {
+		return edited.Content == "fixed"
+	})).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, mockInbox, new(mocks.UserDirectory), mockHub)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "fixed", nil)
+
+	assert.NoError(t, err)
+	mockInbox.AssertExpectations(t)
+}
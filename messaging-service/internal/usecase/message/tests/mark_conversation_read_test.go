@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkConversationReadUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	userID := msg.RecipientID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, userID)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockInboxRepo.On("GetEntry", ctx, userID, msg.ConversationID).Return(&models.InboxEntry{
+		UserID:         userID,
+		ConversationID: msg.ConversationID,
+		LastMessageID:  gocql.TimeUUID(),
+		LastSenderID:   msg.SenderID,
+	}, nil)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockInboxRepo.On("MarkRead", ctx, userID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockRepo.On("CountUnread", ctx, msg.ConversationID, userID, msg.MessageID, conversation.MaxUnreadCount).Return(1, nil)
+	mockInboxRepo.On("CountUnreadConversations", ctx, userID).Return(2, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventConversationRead &&
+			event.Message == nil &&
+			event.Receipt.UserID == userID &&
+			event.Receipt.LastReadMessageID == msg.MessageID
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewMarkConversationReadUsecase(mockRepo, mockConvRepo, mockInboxRepo, mockHub)
+	state, err := usecase.Execute(ctx, msg.ConversationID, userID, msg.MessageID)
+
+	require.NoError(t, err)
+	assert.Equal(t, msg.MessageID, state.LastReadMessageID)
+	assert.Equal(t, 1, state.UnreadCount)
+	assert.Equal(t, 2, state.UnreadConversations)
+	mockHub.AssertExpectations(t)
+}
+
+func TestMarkConversationReadUsecaseExecuteWholeConversation(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID, lastID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockConvRepo.On("GetMembers", ctx, conversationID).Return([]*models.ConversationMember{}, nil)
+	mockInboxRepo.On("GetEntry", ctx, userID, conversationID).Return(&models.InboxEntry{
+		UserID:         userID,
+		ConversationID: conversationID,
+		LastMessageID:  lastID,
+		LastSenderID:   gocql.TimeUUID(),
+	}, nil)
+	mockInboxRepo.On("MarkRead", ctx, userID, conversationID, lastID).Return(true, nil)
+	mockRepo.On("CountUnread", ctx, conversationID, userID, lastID, conversation.MaxUnreadCount).Return(0, nil)
+	mockInboxRepo.On("CountUnreadConversations", ctx, userID).Return(0, nil)
+	mockHub.On("Publish", ctx, mock.Anything).Return(nil)
+
+	usecase := message.NewMarkConversationReadUsecase(mockRepo, mockConvRepo, mockInboxRepo, mockHub)
+	state, err := usecase.Execute(ctx, conversationID, userID, gocql.UUID{})
+
+	require.NoError(t, err)
+	assert.Equal(t, lastID, state.LastReadMessageID)
+	assert.Zero(t, state.UnreadCount)
+	// Сообщение берется из списка бесед и не запрашивается отдельно
+	mockRepo.AssertNotCalled(t, "GetMessageByID", mock.Anything, mock.Anything)
+}
+
+func TestMarkConversationReadUsecaseExecuteKeepsLaterMark(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	userID := msg.RecipientID
+	laterID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, userID)
+	mockInboxRepo.On("GetEntry", ctx, userID, msg.ConversationID).Return(&models.InboxEntry{
+		UserID:            userID,
+		ConversationID:    msg.ConversationID,
+		LastMessageID:     laterID,
+		LastReadMessageID: laterID,
+	}, nil)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockInboxRepo.On("MarkRead", ctx, userID, msg.ConversationID, msg.MessageID).Return(false, nil)
+	mockRepo.On("CountUnread", ctx, msg.ConversationID, userID, laterID, conversation.MaxUnreadCount).Return(0, nil)
+	mockInboxRepo.On("CountUnreadConversations", ctx, userID).Return(0, nil)
+
+	usecase := message.NewMarkConversationReadUsecase(mockRepo, mockConvRepo, mockInboxRepo, mockHub)
+	state, err := usecase.Execute(ctx, msg.ConversationID, userID, msg.MessageID)
+
+	require.NoError(t, err)
+	assert.Equal(t, laterID, state.LastReadMessageID)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMarkConversationReadUsecaseExecuteForeignMessage(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	conversationID, userID := gocql.TimeUUID(), msg.RecipientID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockInboxRepo.On("GetEntry", ctx, userID, conversationID).Return(nil, nil)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewMarkConversationReadUsecase(mockRepo, mockConvRepo, mockInboxRepo, new(mocks.Hub))
+	state, err := usecase.Execute(ctx, conversationID, userID, msg.MessageID)
+
+	assert.ErrorIs(t, err, message.ErrMessageNotFound)
+	assert.Nil(t, state)
+	mockInboxRepo.AssertNotCalled(t, "MarkRead", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMarkConversationReadUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := message.NewMarkConversationReadUsecase(new(mocks.MessageRepository), mockConvRepo, new(mocks.InboxRepository), new(mocks.Hub))
+	state, err := usecase.Execute(ctx, conversationID, userID, gocql.UUID{})
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	assert.Nil(t, state)
+}
+
+func TestGetMessagesUsecaseExecuteDerivesReadStatus(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID, peerID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+	history := newTestHistory(conversationID, 3)
+	for _, msg := range history {
+		msg.SenderID = userID
+		msg.Status = models.StatusDelivered
+	}
+	// Собеседник прочитал беседу до среднего сообщения
+	history[0].SenderID = peerID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockRepo.On("GetMessages", ctx, conversationID, mock.Anything).Return(history, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, conversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, conversationID, map[gocql.UUID]gocql.UUID{
+		peerID: history[1].MessageID,
+	}, userID, peerID)
+
+	usecase := message.NewGetMessagesUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	page, err := usecase.Execute(ctx, conversationID, userID, models.PageQuery{})
+
+	require.NoError(t, err)
+	// Отметки пользователя нет, поэтому сообщение собеседника не прочитано
+	assert.Equal(t, models.StatusDelivered, page.Messages[0].Status)
+	assert.Equal(t, models.StatusRead, page.Messages[1].Status)
+	assert.Equal(t, models.StatusRead, page.Messages[2].Status)
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkConversationUnreadUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockInboxRepo.On("SetMarkedUnread", ctx, userID, conversationID, true).Return(nil)
+	mockInboxRepo.On("CountUnreadConversations", ctx, userID).Return(3, nil)
+
+	usecase := message.NewMarkConversationUnreadUsecase(mockConvRepo, mockInboxRepo)
+	count, err := usecase.Execute(ctx, conversationID, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	mockInboxRepo.AssertExpectations(t)
+}
+
+func TestMarkConversationUnreadUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := message.NewMarkConversationUnreadUsecase(mockConvRepo, mockInboxRepo)
+	_, err := usecase.Execute(ctx, conversationID, userID)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockInboxRepo.AssertNotCalled(t, "SetMarkedUnread", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMarkConversationReadUsecaseExecuteClearsMarkedUnread(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID, lastID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockMember(ctx, mockConvRepo, conversationID, userID)
+	mockInboxRepo.On("GetEntry", ctx, userID, conversationID).Return(&models.InboxEntry{
+		UserID:            userID,
+		ConversationID:    conversationID,
+		LastMessageID:     lastID,
+		LastReadMessageID: lastID,
+		MarkedUnread:      true,
+	}, nil)
+	mockInboxRepo.On("MarkRead", ctx, userID, conversationID, lastID).Return(false, nil)
+	mockInboxRepo.On("SetMarkedUnread", ctx, userID, conversationID, false).Return(nil)
+	mockRepo.On("CountUnread", ctx, conversationID, userID, lastID, conversation.MaxUnreadCount).Return(0, nil)
+	mockInboxRepo.On("CountUnreadConversations", ctx, userID).Return(0, nil)
+
+	usecase := message.NewMarkConversationReadUsecase(mockRepo, mockConvRepo, mockInboxRepo, new(mocks.Hub))
+	state, err := usecase.Execute(ctx, conversationID, userID, gocql.UUID{})
+
+	// Беседа уже была прочитана, но отметка непрочитанной все равно снимается
+	require.NoError(t, err)
+	assert.Zero(t, state.UnreadConversations)
+	mockInboxRepo.AssertExpectations(t)
+}
@@ -0,0 +1,230 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// mockGroupConversation настраивает групповую беседу отправителя сообщения и участников
+func mockGroupConversation(ctx context.Context, repo *mocks.ConversationRepository, msg *models.Message, memberIDs ...gocql.UUID) {
+	repo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	members := []*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleAdmin},
+	}
+	for _, memberID := range memberIDs {
+		members = append(members, &models.ConversationMember{ConversationID: msg.ConversationID, UserID: memberID, Role: models.RoleMember})
+	}
+	repo.On("GetMembers", ctx, msg.ConversationID).Return(members, nil)
+}
+
+func TestSendMessageUsecaseExecuteMentions(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.RecipientID = gocql.UUID{}
+	msg.Content = "Привет, @alice и @bob.! Пиши на mail@carol.com, @stranger"
+	alice, bob, stranger := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockGroupConversation(ctx, mockConvRepo, msg, alice, bob)
+	mockUsers.On("GetProfilesByNicknames", ctx, []string{"alice", "bob", "stranger"}).Return(map[string]*models.UserProfile{
+		"alice":    {UserID: alice, Nickname: "alice"},
+		"bob":      {UserID: bob, Nickname: "bob"},
+		"stranger": {UserID: stranger, Nickname: "stranger"},
+	}, nil)
+	var sent, mentioned *models.OutboxEvent
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent"), mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(*models.OutboxEvent)
+			mentioned = args.Get(3).(*models.OutboxEvent)
+		}).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, alice, bob).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), mockUsers, mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	// Позиции считаются в символах, а не в байтах; упоминание не участника беседы не сохраняется
+	assert.Equal(t, []*models.Mention{
+		{UserID: alice, Offset: 8, Length: 6},
+		{UserID: bob, Offset: 17, Length: 4},
+	}, msg.Mentions)
+
+	require.NotNil(t, sent)
+	assert.Equal(t, events.TopicMessageSent, sent.Topic)
+	require.NotNil(t, mentioned)
+	assert.Equal(t, events.TopicMessageMentioned, mentioned.Topic)
+	assert.Equal(t, msg.ConversationID.String(), mentioned.Key)
+
+	var payload pb.MessageMentioned
+	require.NoError(t, proto.Unmarshal(mentioned.Payload, &payload))
+	assert.Equal(t, events.EventTypeMessageMentioned, payload.GetMetadata().GetEventType())
+	assert.Equal(t, msg.MessageID.String(), payload.GetMessageId())
+	assert.Equal(t, msg.SenderID.String(), payload.GetSenderId())
+	assert.Equal(t, []string{alice.String(), bob.String()}, payload.GetMentionedUserIds())
+	assert.Equal(t, msg.PlainText(), payload.GetContent())
+	mockUsers.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteMentionsDeduplicated(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.RecipientID = gocql.UUID{}
+	msg.Content = "@alice @alice @me"
+	alice := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockGroupConversation(ctx, mockConvRepo, msg, alice)
+	mockUsers.On("GetProfilesByNicknames", ctx, []string{"alice"}).Return(map[string]*models.UserProfile{
+		"alice": {UserID: alice, Nickname: "alice"},
+	}, nil)
+	var mentioned *models.OutboxEvent
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent"), mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { mentioned = args.Get(3).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, alice).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), mockUsers, mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	// Слишком короткий никнейм не запрашивается, повторное упоминание сохраняется, но уведомление одно
+	assert.Len(t, msg.Mentions, 2)
+	var payload pb.MessageMentioned
+	require.NoError(t, proto.Unmarshal(mentioned.Payload, &payload))
+	assert.Equal(t, []string{alice.String()}, payload.GetMentionedUserIds())
+}
+
+func TestSendMessageUsecaseExecuteSelfMention(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = "заметка для @myself"
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockUsers.On("GetProfilesByNicknames", ctx, []string{"myself"}).Return(map[string]*models.UserProfile{
+		"myself": {UserID: msg.SenderID, Nickname: "myself"},
+	}, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), mockUsers, mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	// Упоминание себя подсвечивается, но уведомление не создаётся
+	assert.Len(t, msg.Mentions, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteMentionsDirectoryError(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = "@alice привет"
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockUsers.On("GetProfilesByNicknames", ctx, []string{"alice"}).Return(nil, assert.AnError)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), mockUsers, mockHub)
+
+	// Недоступность user-service не мешает отправке, сообщение уходит без упоминаний
+	require.NoError(t, usecase.Execute(ctx, msg))
+	assert.Empty(t, msg.Mentions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteWithoutMentions(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = "пиши на mail@example.com или @@"
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), mockUsers, mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	assert.Empty(t, msg.Mentions)
+	mockUsers.AssertNotCalled(t, "GetProfilesByNicknames", mock.Anything, mock.Anything)
+}
+
+func TestEditMessageUsecaseExecuteRecomputesMentions(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	alice := gocql.TimeUUID()
+	msg.Content = "@alice привет"
+	msg.Mentions = []*models.Mention{{UserID: alice, Offset: 0, Length: 6}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockGroupConversation(ctx, mockConvRepo, msg, alice)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockUsers.On("GetProfilesByNicknames", ctx, []string{"alice"}).Return(map[string]*models.UserProfile{
+		"alice": {UserID: alice, Nickname: "alice"},
+	}, nil)
+	mockRepo.On("EditMessage", ctx, msg, mock.Anything, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, alice).Return(nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockUsers, mockHub)
+	edited, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "Ещё раз, @alice", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*models.Mention{{UserID: alice, Offset: 9, Length: 6}}, edited.Mentions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEditMessageUsecaseExecuteRemovesMentions(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Content = "@alice привет"
+	msg.Mentions = []*models.Mention{{UserID: msg.RecipientID, Offset: 0, Length: 6}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("EditMessage", ctx, msg, mock.Anything, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockUsers, mockHub)
+	edited, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "привет", nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, edited.Mentions)
+	mockUsers.AssertNotCalled(t, "GetProfilesByNicknames", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMessageUsecaseExecutePolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       models.MessagingPolicy
+		relationship *models.Relationship
+		wantErr      error
+	}{
+		{"default policy allows friends", models.MessagingPolicyUnspecified, &models.Relationship{Friends: true}, nil},
+		{"default policy rejects strangers", models.MessagingPolicyUnspecified, &models.Relationship{}, message.ErrRecipientFriendsOnly},
+		{"friends only rejects strangers", models.MessagingPolicyFriends, &models.Relationship{}, message.ErrRecipientFriendsOnly},
+		{"everyone allows strangers", models.MessagingPolicyEveryone, &models.Relationship{}, nil},
+		{"nobody rejects friends", models.MessagingPolicyNobody, &models.Relationship{Friends: true}, message.ErrRecipientNotAccepting},
+		{"block overrides everyone", models.MessagingPolicyEveryone, &models.Relationship{Blocked: true}, message.ErrBlockedByRecipient},
+		// Отправитель может писать тому, кто заблокирован им самим, если получатель это разрешает
+		{"sender block is ignored", models.MessagingPolicyEveryone, &models.Relationship{BlockedBy: true}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			msg := newTestMessage()
+
+			mockRepo := new(mocks.MessageRepository)
+			mockConvRepo := new(mocks.ConversationRepository)
+			mockSettingsRepo := new(mocks.MessagingSettingsRepository)
+			mockFriendships := new(mocks.FriendshipDirectory)
+			mockHub := new(mocks.Hub)
+			mockDirectConversation(ctx, mockConvRepo, msg)
+			mockSettingsRepo.On("GetMessagingPolicy", ctx, msg.RecipientID).Return(tt.policy, nil).Maybe()
+			// Отношения запрашиваются со стороны получателя
+			mockFriendships.On("GetRelationship", ctx, msg.RecipientID, msg.SenderID).Return(tt.relationship, nil)
+			mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil).Maybe()
+			mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil).Maybe()
+
+			usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), mockSettingsRepo, mockFriendships, new(mocks.UserDirectory), mockHub)
+			err := usecase.Execute(ctx, msg)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendMessageUsecaseExecutePolicyGroup(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockFriendships := new(mocks.FriendshipDirectory)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleAdmin},
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleMember},
+	}, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), mockFriendships, new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	require.NoError(t, err)
+	mockFriendships.AssertNotCalled(t, "GetRelationship", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecutePolicyUnavailable(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockFriendships := new(mocks.FriendshipDirectory)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockFriendships.On("GetRelationship", ctx, msg.RecipientID, msg.SenderID).Return(nil, errors.New("unavailable"))
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), mockFriendships, new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	require.Error(t, err)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetMessagingSettingsUsecaseExecuteDefault(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockSettingsRepo := new(mocks.MessagingSettingsRepository)
+	mockSettingsRepo.On("GetMessagingPolicy", ctx, msg.SenderID).Return(models.MessagingPolicyUnspecified, nil)
+
+	usecase := message.NewGetMessagingSettingsUsecase(mockSettingsRepo)
+	policy, err := usecase.Execute(ctx, msg.SenderID)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.DefaultMessagingPolicy, policy)
+}
+
+func TestUpdateMessagingSettingsUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockSettingsRepo := new(mocks.MessagingSettingsRepository)
+	mockSettingsRepo.On("SetMessagingPolicy", ctx, msg.SenderID, models.MessagingPolicyEveryone).Return(nil)
+
+	usecase := message.NewUpdateMessagingSettingsUsecase(mockSettingsRepo)
+	err := usecase.Execute(ctx, msg.SenderID, models.MessagingPolicyEveryone)
+
+	require.NoError(t, err)
+	mockSettingsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSendMessageUsecaseExecuteWritesOutboxEvent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	var saved *models.OutboxEvent
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	require.NotNil(t, saved)
+	assert.Equal(t, events.TopicMessageSent, saved.Topic)
+	assert.Equal(t, msg.ConversationID.String(), saved.Key)
+	assert.Equal(t, models.OutboxShard(msg.ConversationID), saved.Shard)
+
+	var payload pb.MessageSent
+	require.NoError(t, proto.Unmarshal(saved.Payload, &payload))
+	assert.Equal(t, saved.EventID.String(), payload.GetMetadata().GetEventId())
+	assert.Equal(t, events.EventTypeMessageSent, payload.GetMetadata().GetEventType())
+	assert.EqualValues(t, 1, payload.GetMetadata().GetEventVersion())
+	assert.Equal(t, msg.MessageID.String(), payload.GetMessageId())
+	assert.Equal(t, msg.Content, payload.GetContent())
+	// Отправитель не получает уведомление о собственном сообщении
+	assert.Equal(t, []string{msg.RecipientID.String()}, payload.GetRecipientIds())
+}
+
+func TestSendMessageUsecaseExecuteOutboxFailure(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(assert.AnError)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Сообщение и событие пишутся одним батчем: без события сообщение не считается отправленным
+	assert.ErrorIs(t, err, assert.AnError)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteWritesOutboxEvent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	var saved *models.OutboxEvent
+	mockRepo.On("UpdateMessageStatus", ctx, msg, models.StatusDelivered, mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { saved = args.Get(3).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusDelivered))
+
+	require.NotNil(t, saved)
+	assert.Equal(t, events.TopicMessageStatusChanged, saved.Topic)
+
+	var payload pb.MessageStatusChanged
+	require.NoError(t, proto.Unmarshal(saved.Payload, &payload))
+	assert.Equal(t, events.EventTypeMessageStatusChanged, payload.GetMetadata().GetEventType())
+	assert.Equal(t, msg.MessageID.String(), payload.GetMessageId())
+	assert.Equal(t, pb.MessageStatus_MESSAGE_STATUS_DELIVERED, payload.GetStatus())
+}
+
+func TestEditMessageUsecaseExecuteWritesOutboxEvent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	var saved *models.OutboxEvent
+	mockRepo.On("EditMessage", ctx, msg, mock.Anything, mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { saved = args.Get(3).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewEditMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.UserDirectory), mockHub)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID, "hello, world", nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, saved)
+	assert.Equal(t, events.TopicMessageEdited, saved.Topic)
+	assert.Equal(t, msg.ConversationID.String(), saved.Key)
+
+	var payload pb.MessageEdited
+	require.NoError(t, proto.Unmarshal(saved.Payload, &payload))
+	assert.Equal(t, events.EventTypeMessageEdited, payload.GetMetadata().GetEventType())
+	assert.Equal(t, msg.MessageID.String(), payload.GetMessageId())
+	assert.Equal(t, "hello, world", payload.GetContent())
+	assert.NotZero(t, payload.GetEditedAt())
+}
+
+func TestDeleteMessageUsecaseExecuteWritesOutboxEvent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	var saved *models.OutboxEvent
+	mockRepo.On("DeleteMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewDeleteMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), newTestPinRepo(), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, msg.SenderID, models.DeleteModeForEveryone))
+
+	require.NotNil(t, saved)
+	assert.Equal(t, events.TopicMessageDeleted, saved.Topic)
+
+	var payload pb.MessageDeleted
+	require.NoError(t, proto.Unmarshal(saved.Payload, &payload))
+	assert.Equal(t, events.EventTypeMessageDeleted, payload.GetMetadata().GetEventType())
+	assert.Equal(t, msg.MessageID.String(), payload.GetMessageId())
+	assert.Equal(t, msg.ConversationID.String(), payload.GetConversationId())
+}
+
+func TestSendMessageUsecaseExecuteMarksMutedRecipients(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.RecipientID = gocql.UUID{}
+	muted, active := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockGroupConversation(ctx, mockConvRepo, msg, muted, active)
+	mockInbox.On("GetMutedUserIDs", ctx, msg.ConversationID, []gocql.UUID{muted, active}, msg.Timestamp).Return([]gocql.UUID{muted}, nil)
+	mockInbox.On("RecordMessage", ctx, mock.Anything, msg).Return(nil)
+	var saved *models.OutboxEvent
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(*models.OutboxEvent) }).
+		Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, mockInbox, new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg))
+
+	var payload pb.MessageSent
+	require.NoError(t, proto.Unmarshal(saved.Payload, &payload))
+	// Сообщение доставляется всем, но уведомление получат только участники без отключенного звука
+	assert.ElementsMatch(t, []string{muted.String(), active.String()}, payload.GetRecipientIds())
+	assert.Equal(t, []string{muted.String()}, payload.GetMutedRecipientIds())
+}
@@ -0,0 +1,208 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPinRepo возвращает закрепления, принимающие любое открепление
+func newTestPinRepo() *mocks.PinRepository {
+	repo := new(mocks.PinRepository)
+	repo.On("UnpinMessage", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	return repo
+}
+
+func TestPinMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockPinRepo.On("GetPin", ctx, msg.ConversationID, msg.MessageID).Return(nil, nil)
+	mockPinRepo.On("CountPins", ctx, msg.ConversationID).Return(2, nil)
+	mockPinRepo.On("PinMessage", ctx, mock.MatchedBy(func(pin *models.PinnedMessage) bool {
+		return pin.MessageID == msg.MessageID && pin.PinnedBy == msg.RecipientID
+	}), time.Duration(0)).Return(true, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessagePinned && event.Pin.PinnedBy == msg.RecipientID
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+	mockSystemEvents := new(mocks.PostSystemEventUsecase)
+	mockSystemEvents.On("Execute", ctx, msg.ConversationID, &models.SystemEvent{
+		Type:      models.SystemEventMessagePinned,
+		ActorID:   msg.RecipientID,
+		MessageID: msg.MessageID,
+	}).Return(&models.Message{}, nil)
+
+	usecase := message.NewPinMessageUsecase(mockRepo, mockConvRepo, mockPinRepo, mockSystemEvents, mockHub, 3)
+	pin, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID)
+
+	require.NoError(t, err)
+	assert.Equal(t, msg.RecipientID, pin.PinnedBy)
+	assert.False(t, pin.PinnedAt.IsZero())
+	assert.Same(t, msg, pin.Message)
+	mockPinRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+	mockSystemEvents.AssertExpectations(t)
+}
+
+func TestPinMessageUsecaseExecuteAlreadyPinned(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	existing := &models.PinnedMessage{
+		ConversationID: msg.ConversationID,
+		MessageID:      msg.MessageID,
+		PinnedBy:       msg.SenderID,
+		PinnedAt:       time.Now().Add(-time.Hour),
+	}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockPinRepo.On("GetPin", ctx, msg.ConversationID, msg.MessageID).Return(existing, nil)
+
+	usecase := message.NewPinMessageUsecase(mockRepo, mockConvRepo, mockPinRepo, new(mocks.PostSystemEventUsecase), mockHub, 3)
+	pin, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID)
+
+	require.NoError(t, err)
+	assert.Equal(t, msg.SenderID, pin.PinnedBy)
+	mockPinRepo.AssertNotCalled(t, "PinMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPinMessageUsecaseExecuteLimitReached(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.SenderID)
+	mockPinRepo.On("GetPin", ctx, msg.ConversationID, msg.MessageID).Return(nil, nil)
+	mockPinRepo.On("CountPins", ctx, msg.ConversationID).Return(3, nil)
+
+	usecase := message.NewPinMessageUsecase(mockRepo, mockConvRepo, mockPinRepo, new(mocks.PostSystemEventUsecase), new(mocks.Hub), 3)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID)
+
+	assert.ErrorIs(t, err, message.ErrTooManyPins)
+	mockPinRepo.AssertNotCalled(t, "PinMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPinMessageUsecaseExecuteDeleted(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Deleted = true
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.SenderID)
+
+	usecase := message.NewPinMessageUsecase(mockRepo, mockConvRepo, mockPinRepo, new(mocks.PostSystemEventUsecase), new(mocks.Hub), 0)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID)
+
+	assert.ErrorIs(t, err, message.ErrMessageDeleted)
+	mockPinRepo.AssertNotCalled(t, "PinMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUnpinMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.SenderID)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockPinRepo.On("UnpinMessage", ctx, msg.ConversationID, msg.MessageID).Return(true, nil).Once()
+	mockPinRepo.On("UnpinMessage", ctx, msg.ConversationID, msg.MessageID).Return(false, nil).Once()
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessageUnpinned
+	}), msg.SenderID, msg.RecipientID).Return(nil).Once()
+
+	usecase := message.NewUnpinMessageUsecase(mockRepo, mockConvRepo, mockPinRepo, mockHub)
+	removed, err := usecase.Execute(ctx, msg.MessageID, msg.SenderID)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	// Повторное открепление не рассылается
+	removed, err = usecase.Execute(ctx, msg.MessageID, msg.SenderID)
+	require.NoError(t, err)
+	assert.False(t, removed)
+	mockHub.AssertExpectations(t)
+}
+
+func TestListPinnedMessagesUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	older := newTestMessage()
+	newer := newTestMessage()
+	newer.ConversationID = older.ConversationID
+	deleted := newTestMessage()
+	deleted.ConversationID = older.ConversationID
+	deleted.Deleted = true
+	expiredID := gocql.TimeUUID()
+	userID := older.SenderID
+	now := time.Now()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockMember(ctx, mockConvRepo, older.ConversationID, userID)
+	mockPinRepo.On("GetPins", ctx, older.ConversationID).Return([]*models.PinnedMessage{
+		{ConversationID: older.ConversationID, MessageID: expiredID, PinnedAt: now},
+		{ConversationID: older.ConversationID, MessageID: deleted.MessageID, PinnedAt: now},
+		{ConversationID: older.ConversationID, MessageID: newer.MessageID, PinnedAt: now.Add(-time.Hour)},
+		{ConversationID: older.ConversationID, MessageID: older.MessageID, PinnedAt: now.Add(-time.Minute)},
+	}, nil)
+	mockRepo.On("GetMessageByID", ctx, expiredID).Return(nil, nil)
+	mockRepo.On("GetMessageByID", ctx, deleted.MessageID).Return(deleted, nil)
+	mockRepo.On("GetMessageByID", ctx, newer.MessageID).Return(newer, nil)
+	mockRepo.On("GetMessageByID", ctx, older.MessageID).Return(older, nil)
+
+	usecase := message.NewListPinnedMessagesUsecase(mockRepo, mockConvRepo, mockPinRepo)
+	pins, err := usecase.Execute(ctx, older.ConversationID, userID)
+
+	require.NoError(t, err)
+	require.Len(t, pins, 2)
+	// Последние закрепленные идут первыми
+	assert.Equal(t, older.MessageID, pins[0].MessageID)
+	assert.Equal(t, newer.MessageID, pins[1].MessageID)
+	assert.Same(t, older, pins[0].Message)
+}
+
+func TestListPinnedMessagesUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID := gocql.TimeUUID()
+	userID := gocql.TimeUUID()
+
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockPinRepo := new(mocks.PinRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := message.NewListPinnedMessagesUsecase(new(mocks.MessageRepository), mockConvRepo, mockPinRepo)
+	_, err := usecase.Execute(ctx, conversationID, userID)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockPinRepo.AssertNotCalled(t, "GetPins", mock.Anything, mock.Anything)
+}
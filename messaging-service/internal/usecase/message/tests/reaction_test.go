@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddReactionUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	summaries := []*models.ReactionSummary{{Emoji: "👍", Count: 1, ReactedByMe: true}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockReactionRepo.On("AddReaction", ctx, mock.MatchedBy(func(reaction *models.Reaction) bool {
+		return reaction.MessageID == msg.MessageID && reaction.UserID == msg.RecipientID && reaction.Emoji == "👍"
+	}), time.Duration(0)).Return(true, nil)
+	mockReactionRepo.On("GetSummaries", ctx, []gocql.UUID{msg.MessageID}, msg.RecipientID).Return(map[gocql.UUID][]*models.ReactionSummary{
+		msg.MessageID: summaries,
+	}, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventReactionAdded && event.Reaction.Emoji == "👍"
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockHub)
+	result, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "👍")
+
+	assert.NoError(t, err)
+	assert.Equal(t, summaries, result)
+	mockReactionRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestAddReactionUsecaseExecuteDuplicate(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockHub := new(mocks.Hub)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockReactionRepo.On("AddReaction", ctx, mock.Anything, time.Duration(0)).Return(false, nil)
+	mockReactionRepo.On("GetSummaries", ctx, []gocql.UUID{msg.MessageID}, msg.RecipientID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockHub)
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "👍")
+
+	assert.NoError(t, err)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddReactionUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	outsiderID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockConvRepo.On("GetMember", ctx, msg.ConversationID, outsiderID).Return(nil, nil)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, new(mocks.Hub))
+	_, err := usecase.Execute(ctx, msg.MessageID, outsiderID, "👍")
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockReactionRepo.AssertNotCalled(t, "AddReaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddReactionUsecaseExecuteDeleted(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Deleted = true
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, new(mocks.Hub))
+	_, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "👍")
+
+	assert.ErrorIs(t, err, message.ErrMessageDeleted)
+	mockReactionRepo.AssertNotCalled(t, "AddReaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddReactionUsecaseExecuteInvalidEmoji(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(mocks.MessageRepository)
+
+	usecase := message.NewAddReactionUsecase(mockRepo, new(mocks.ConversationRepository), new(mocks.ReactionRepository), new(mocks.Hub))
+	_, err := usecase.Execute(ctx, gocql.TimeUUID(), gocql.TimeUUID(), "👍 👍")
+
+	assert.ErrorIs(t, err, message.ErrInvalidEmoji)
+	mockRepo.AssertNotCalled(t, "GetMessageByID", mock.Anything, mock.Anything)
+}
+
+func TestRemoveReactionUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockReactionRepo.On("RemoveReaction", ctx, msg.MessageID, "👍", msg.RecipientID).Return(true, nil)
+	mockReactionRepo.On("GetSummaries", ctx, []gocql.UUID{msg.MessageID}, msg.RecipientID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventReactionRemoved && event.Reaction.UserID == msg.RecipientID
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewRemoveReactionUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockHub)
+	result, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "👍")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	mockHub.AssertExpectations(t)
+}
+
+func TestListReactionsUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	reactions := []*models.Reaction{{MessageID: msg.MessageID, UserID: msg.SenderID, Emoji: "👍"}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.RecipientID)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockReactionRepo.On("GetReactions", ctx, msg.MessageID, "", []byte{}, 50).Return(reactions, []byte{1, 2}, nil)
+
+	usecase := message.NewListReactionsUsecase(mockRepo, mockConvRepo, mockReactionRepo)
+	result, nextPageToken, err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, "", "", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reactions, result)
+	assert.Equal(t, "AQI", nextPageToken)
+}
+
+func TestListReactionsUsecaseExecuteInvalidPageToken(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(mocks.MessageRepository)
+
+	usecase := message.NewListReactionsUsecase(mockRepo, new(mocks.ConversationRepository), new(mocks.ReactionRepository))
+	_, _, err := usecase.Execute(ctx, gocql.TimeUUID(), gocql.TimeUUID(), "", "%%%", 0)
+
+	assert.ErrorIs(t, err, message.ErrInvalidPageToken)
+	mockRepo.AssertNotCalled(t, "GetMessageByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,420 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestScheduled возвращает ожидающее сообщение, время отправки которого наступило
+func newTestScheduled(now time.Time) *models.ScheduledMessage {
+	return &models.ScheduledMessage{
+		ScheduledID:    gocql.TimeUUID(),
+		SenderID:       gocql.TimeUUID(),
+		ConversationID: gocql.TimeUUID(),
+		Content:        "later",
+		AttachmentIDs:  []gocql.UUID{gocql.TimeUUID()},
+		SendAt:         now.Add(-time.Second),
+		State:          models.ScheduledPending,
+	}
+}
+
+// mockDueEntry кладет сообщение в очередь планировщика, остальные часы очереди пусты
+func mockDueEntry(ctx context.Context, repo *mocks.ScheduledMessageRepository, scheduled *models.ScheduledMessage, now time.Time) *models.ScheduledEntry {
+	entry := &models.ScheduledEntry{
+		Bucket:      models.ScheduleBucket(scheduled.SendAt),
+		SendAt:      scheduled.SendAt,
+		ScheduledID: scheduled.ScheduledID,
+		SenderID:    scheduled.SenderID,
+	}
+	repo.On("GetDueEntries", ctx, entry.Bucket, now).Return([]*models.ScheduledEntry{entry}, nil)
+	repo.On("GetDueEntries", ctx, mock.Anything, now).Return(nil, nil)
+	return entry
+}
+
+func TestScheduleMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.Attachments = []*models.Attachment{{AttachmentID: gocql.TimeUUID()}}
+	sendAt := time.Now().Add(time.Hour)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, msg.SenderID)
+	mockScheduledRepo.On("GetScheduledMessages", ctx, msg.SenderID).Return(nil, nil)
+	mockScheduledRepo.On("SaveScheduledMessage", ctx, mock.AnythingOfType("*models.ScheduledMessage")).Return(nil)
+
+	usecase := message.NewScheduleMessageUsecase(mockScheduledRepo, mockConvRepo)
+	scheduled, err := usecase.Execute(ctx, msg, sendAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.ScheduledPending, scheduled.State)
+	assert.Equal(t, sendAt, scheduled.SendAt)
+	assert.Equal(t, msg.Content, scheduled.Content)
+	assert.Equal(t, []gocql.UUID{msg.Attachments[0].AttachmentID}, scheduled.AttachmentIDs)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestScheduleMessageUsecaseExecuteInvalid(t *testing.T) {
+	ctx := context.Background()
+	encrypted, _ := newEncryptedMessage()
+
+	tests := []struct {
+		name    string
+		message *models.Message
+		sendAt  time.Time
+		err     error
+	}{
+		{"past", newTestMessage(), time.Now().Add(-time.Minute), message.ErrInvalidSendAt},
+		{"too far", newTestMessage(), time.Now().Add(message.MaxScheduleAhead + time.Hour), message.ErrInvalidSendAt},
+		{"encrypted", encrypted, time.Now().Add(time.Hour), message.ErrScheduledEncrypted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+			usecase := message.NewScheduleMessageUsecase(mockScheduledRepo, new(mocks.ConversationRepository))
+			_, err := usecase.Execute(ctx, tt.message, tt.sendAt)
+
+			assert.ErrorIs(t, err, tt.err)
+			mockScheduledRepo.AssertNotCalled(t, "SaveScheduledMessage", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestScheduleMessageUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetMember", ctx, msg.ConversationID, msg.SenderID).Return(nil, nil)
+
+	usecase := message.NewScheduleMessageUsecase(mockScheduledRepo, mockConvRepo)
+	_, err := usecase.Execute(ctx, msg, time.Now().Add(time.Hour))
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockScheduledRepo.AssertNotCalled(t, "SaveScheduledMessage", mock.Anything, mock.Anything)
+}
+
+func TestListScheduledMessagesUsecaseExecuteSortsBySendAt(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	later := newTestScheduled(now.Add(2 * time.Hour))
+	sooner := newTestScheduled(now.Add(time.Hour))
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetScheduledMessages", ctx, later.SenderID).Return([]*models.ScheduledMessage{later, sooner}, nil)
+
+	usecase := message.NewListScheduledMessagesUsecase(mockScheduledRepo)
+	scheduled, err := usecase.Execute(ctx, later.SenderID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*models.ScheduledMessage{sooner, later}, scheduled)
+}
+
+func TestCancelScheduledMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	scheduled := newTestScheduled(time.Now().Add(time.Hour))
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	mockScheduledRepo.On("CancelScheduledMessage", ctx, scheduled).Return(true, nil).Once()
+	mockScheduledRepo.On("CancelScheduledMessage", ctx, scheduled).Return(false, nil).Once()
+
+	usecase := message.NewCancelScheduledMessageUsecase(mockScheduledRepo)
+	require.NoError(t, usecase.Execute(ctx, scheduled.SenderID, scheduled.ScheduledID))
+
+	// Сообщение уже захватил планировщик
+	assert.ErrorIs(t, usecase.Execute(ctx, scheduled.SenderID, scheduled.ScheduledID), message.ErrScheduledSending)
+}
+
+func TestCancelScheduledMessageUsecaseExecuteNotFound(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	scheduledID := gocql.TimeUUID()
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, userID, scheduledID).Return(nil, nil)
+
+	usecase := message.NewCancelScheduledMessageUsecase(mockScheduledRepo)
+	err := usecase.Execute(ctx, userID, scheduledID)
+
+	assert.ErrorIs(t, err, message.ErrScheduledNotFound)
+}
+
+func TestRescheduleMessageUsecaseExecuteFailed(t *testing.T) {
+	ctx := context.Background()
+	scheduled := newTestScheduled(time.Now())
+	scheduled.State = models.ScheduledFailed
+	scheduled.MessageID = gocql.TimeUUID()
+	scheduled.FailureReason = "recipient accepts messages only from friends"
+	sendAt := time.Now().Add(time.Hour)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	mockScheduledRepo.On("RescheduleMessage", ctx, scheduled, sendAt).Return(true, nil)
+
+	usecase := message.NewRescheduleMessageUsecase(mockScheduledRepo)
+	rescheduled, err := usecase.Execute(ctx, scheduled.SenderID, scheduled.ScheduledID, sendAt)
+
+	// Неотправленное сообщение снова ждет отправки
+	require.NoError(t, err)
+	assert.Equal(t, sendAt, rescheduled.SendAt)
+	assert.Equal(t, models.ScheduledPending, rescheduled.State)
+	assert.Empty(t, rescheduled.FailureReason)
+	assert.Equal(t, gocql.UUID{}, rescheduled.MessageID)
+}
+
+func TestRescheduleMessageUsecaseExecuteInPast(t *testing.T) {
+	ctx := context.Background()
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+
+	usecase := message.NewRescheduleMessageUsecase(mockScheduledRepo)
+	_, err := usecase.Execute(ctx, gocql.TimeUUID(), gocql.TimeUUID(), time.Now().Add(-time.Hour))
+
+	assert.ErrorIs(t, err, message.ErrInvalidSendAt)
+	mockScheduledRepo.AssertNotCalled(t, "GetScheduledMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := newTestScheduled(now)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockRepo := new(mocks.MessageRepository)
+	mockSend := new(mocks.SendMessageUsecase)
+	mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(true, nil)
+	mockRepo.On("GetMessageByID", ctx, mock.Anything).Return(nil, nil)
+	mockSend.On("Execute", ctx, mock.MatchedBy(func(msg *models.Message) bool {
+		return msg.MessageID == scheduled.MessageID && msg.Content == scheduled.Content &&
+			msg.Timestamp.Equal(now) && len(msg.Attachments) == 1
+	})).Return(nil)
+	mockScheduledRepo.On("CompleteScheduledMessage", ctx, scheduled).Return(nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, mockRepo, mockSend)
+	delivered, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.NotEqual(t, gocql.UUID{}, scheduled.MessageID)
+	mockSend.AssertExpectations(t)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteSkipsSweptBuckets(t *testing.T) {
+	ctx := context.Background()
+	now := models.ScheduleBucket(time.Now()).Add(30 * time.Minute)
+	next := now.Add(5 * time.Second)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetDueEntries", ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, new(mocks.MessageRepository), new(mocks.SendMessageUsecase))
+	_, err := usecase.Execute(ctx, now)
+	require.NoError(t, err)
+	_, err = usecase.Execute(ctx, next)
+	require.NoError(t, err)
+
+	// Первый опрос просматривает всю глубину очереди, следующий — только текущий час
+	mockScheduledRepo.AssertNumberOfCalls(t, "GetDueEntries", int(message.ScheduleLookback/time.Hour)+2)
+	mockScheduledRepo.AssertCalled(t, "GetDueEntries", ctx, models.ScheduleBucket(now), next)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteRescansPendingBucket(t *testing.T) {
+	ctx := context.Background()
+	now := models.ScheduleBucket(time.Now()).Add(30 * time.Minute)
+	next := now.Add(5 * time.Second)
+	scheduled := newTestScheduled(now.Add(-2 * time.Hour))
+	entry := &models.ScheduledEntry{
+		Bucket:      models.ScheduleBucket(scheduled.SendAt),
+		SendAt:      scheduled.SendAt,
+		ScheduledID: scheduled.ScheduledID,
+		SenderID:    scheduled.SenderID,
+	}
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockScheduledRepo.On("GetDueEntries", ctx, entry.Bucket, mock.Anything).Return([]*models.ScheduledEntry{entry}, nil)
+	mockScheduledRepo.On("GetDueEntries", ctx, mock.Anything, mock.Anything).Return(nil, nil)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	// Отправку захватила другая реплика, час остается в просмотре до ее завершения
+	mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, mock.Anything).Return(false, nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, new(mocks.MessageRepository), new(mocks.SendMessageUsecase))
+	_, err := usecase.Execute(ctx, now)
+	require.NoError(t, err)
+	_, err = usecase.Execute(ctx, next)
+	require.NoError(t, err)
+
+	mockScheduledRepo.AssertCalled(t, "GetDueEntries", ctx, entry.Bucket, next)
+	mockScheduledRepo.AssertCalled(t, "GetDueEntries", ctx, entry.Bucket.Add(time.Hour), next)
+	mockScheduledRepo.AssertNotCalled(t, "GetDueEntries", ctx, entry.Bucket.Add(-time.Hour), next)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteClaimedElsewhere(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := newTestScheduled(now)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockSend := new(mocks.SendMessageUsecase)
+	mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(false, nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, new(mocks.MessageRepository), mockSend)
+	delivered, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	assert.Zero(t, delivered)
+	mockSend.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteSendingRecently(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := newTestScheduled(now)
+	scheduled.State = models.ScheduledSending
+	scheduled.ClaimedAt = now.Add(-time.Second)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockSend := new(mocks.SendMessageUsecase)
+	mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, new(mocks.MessageRepository), mockSend)
+	_, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	mockScheduledRepo.AssertNotCalled(t, "ClaimScheduledMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockSend.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteAlreadySent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := newTestScheduled(now)
+	// Реплика упала после отправки, не успев удалить сообщение из очереди
+	scheduled.State = models.ScheduledSending
+	scheduled.ClaimedAt = now.Add(-2 * message.ScheduledClaimTimeout)
+	scheduled.MessageID = gocql.TimeUUID()
+	sent := scheduled.Message(scheduled.ClaimedAt)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	mockRepo := new(mocks.MessageRepository)
+	mockSend := new(mocks.SendMessageUsecase)
+	mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+	mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(true, nil)
+	mockRepo.On("GetMessageByID", ctx, scheduled.MessageID).Return(sent, nil)
+	mockScheduledRepo.On("CompleteScheduledMessage", ctx, scheduled).Return(nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, mockRepo, mockSend)
+	_, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	mockSend.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteStaleEntry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := newTestScheduled(now)
+
+	mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+	entry := mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+	// Сообщение перенесли на более позднее время
+	rescheduled := *scheduled
+	rescheduled.SendAt = now.Add(time.Hour)
+	mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(&rescheduled, nil)
+	mockScheduledRepo.On("DeleteEntry", ctx, entry).Return(nil)
+
+	usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, new(mocks.MessageRepository), new(mocks.SendMessageUsecase))
+	_, err := usecase.Execute(ctx, now)
+
+	require.NoError(t, err)
+	mockScheduledRepo.AssertNotCalled(t, "ClaimScheduledMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockScheduledRepo.AssertExpectations(t)
+}
+
+func TestDeliverScheduledMessagesUsecaseExecuteSendErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("permanent", func(t *testing.T) {
+		now := time.Now()
+		scheduled := newTestScheduled(now)
+		mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+		mockRepo := new(mocks.MessageRepository)
+		mockSend := new(mocks.SendMessageUsecase)
+		mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+		mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+		mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(true, nil)
+		mockRepo.On("GetMessageByID", ctx, mock.Anything).Return(nil, nil)
+		mockSend.On("Execute", ctx, mock.Anything).Return(message.ErrBlockedByRecipient)
+		mockScheduledRepo.On("FailScheduledMessage", ctx, scheduled, message.ErrBlockedByRecipient.Error()).Return(nil)
+
+		usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, mockRepo, mockSend)
+		delivered, err := usecase.Execute(ctx, now)
+
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+		mockScheduledRepo.AssertExpectations(t)
+	})
+
+	t.Run("reply to deleted message", func(t *testing.T) {
+		now := time.Now()
+		scheduled := newTestScheduled(now)
+		scheduled.ReplyToMessageID = gocql.TimeUUID()
+		mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+		mockRepo := new(mocks.MessageRepository)
+		mockSend := new(mocks.SendMessageUsecase)
+		mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+		mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+		mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(true, nil)
+		mockRepo.On("GetMessageByID", ctx, mock.Anything).Return(nil, nil)
+		mockSend.On("Execute", ctx, mock.Anything).Return(message.ErrMessageDeleted)
+		mockScheduledRepo.On("FailScheduledMessage", ctx, scheduled, message.ErrMessageDeleted.Error()).Return(nil)
+
+		usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, mockRepo, mockSend)
+		_, err := usecase.Execute(ctx, now)
+
+		// Исходное сообщение не вернется, поэтому отправка завершается ошибкой один раз
+		require.NoError(t, err)
+		mockScheduledRepo.AssertExpectations(t)
+	})
+
+	t.Run("transient", func(t *testing.T) {
+		now := time.Now()
+		scheduled := newTestScheduled(now)
+		mockScheduledRepo := new(mocks.ScheduledMessageRepository)
+		mockRepo := new(mocks.MessageRepository)
+		mockSend := new(mocks.SendMessageUsecase)
+		mockDueEntry(ctx, mockScheduledRepo, scheduled, now)
+		mockScheduledRepo.On("GetScheduledMessage", ctx, scheduled.SenderID, scheduled.ScheduledID).Return(scheduled, nil)
+		mockScheduledRepo.On("ClaimScheduledMessage", ctx, scheduled, now).Return(true, nil)
+		mockRepo.On("GetMessageByID", ctx, mock.Anything).Return(nil, nil)
+		mockSend.On("Execute", ctx, mock.Anything).Return(errors.New("cassandra unavailable"))
+
+		usecase := message.NewDeliverScheduledMessagesUsecase(mockScheduledRepo, mockRepo, mockSend)
+		delivered, err := usecase.Execute(ctx, now)
+
+		// Захват истечет, и отправку повторят
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+		mockScheduledRepo.AssertNotCalled(t, "FailScheduledMessage", mock.Anything, mock.Anything, mock.Anything)
+		mockScheduledRepo.AssertNotCalled(t, "CompleteScheduledMessage", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/attachment"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendMessageUsecaseExecuteWithAttachments(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{
+		AttachmentID: gocql.TimeUUID(),
+		UploaderID:   msg.SenderID,
+		FileName:     "cat.png",
+		MimeType:     "image/png",
+	}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, stored.AttachmentID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Идентификаторы заменяются сведениями о файлах
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Attachment{stored}, msg.Attachments)
+	assert.Equal(t, msg.MessageID, stored.MessageID)
+	mockAttachmentRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteForeignAttachment(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.RecipientID}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, attachment.ErrNotAttachmentUploader)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteAttachmentAlreadySent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.SenderID}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	// Файл успели отправить в другом сообщении между проверкой и привязкой
+	mockAttachmentRepo.On("BindAttachment", ctx, stored.AttachmentID, msg.ConversationID, msg.MessageID).Return(false, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, attachment.ErrAttachmentAlreadySent)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteUnbindsAfterFailedBind(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	first := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.SenderID}
+	second := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.SenderID}
+	msg.Attachments = []*models.Attachment{{AttachmentID: first.AttachmentID}, {AttachmentID: second.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, first.AttachmentID).Return(first, nil)
+	mockAttachmentRepo.On("GetAttachment", ctx, second.AttachmentID).Return(second, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, first.AttachmentID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, second.AttachmentID, msg.ConversationID, msg.MessageID).Return(false, assert.AnError)
+	mockAttachmentRepo.On("UnbindAttachment", ctx, first.AttachmentID, msg.MessageID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	// Первый файл освобождается, иначе повторная отправка получила бы ErrAttachmentAlreadySent
+	assert.ErrorIs(t, err, assert.AnError)
+	mockAttachmentRepo.AssertExpectations(t)
+	mockAttachmentRepo.AssertNotCalled(t, "UnbindAttachment", ctx, second.AttachmentID, msg.MessageID)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteUnbindsAfterFailedSave(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.SenderID}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, stored.AttachmentID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockAttachmentRepo.On("UnbindAttachment", ctx, stored.AttachmentID, msg.MessageID).Return(nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(assert.AnError)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(nil, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	mockAttachmentRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteKeepsAttachmentsOfSavedMessage(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	stored := &models.Attachment{AttachmentID: gocql.TimeUUID(), UploaderID: msg.SenderID}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, stored.AttachmentID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(assert.AnError)
+	// Запись применилась несмотря на таймаут
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(&models.Message{MessageID: msg.MessageID}, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	mockAttachmentRepo.AssertNotCalled(t, "UnbindAttachment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteRebindsToSameMessage(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	// Файл привязан прерванной доставкой того же запланированного сообщения
+	stored := &models.Attachment{
+		AttachmentID:   gocql.TimeUUID(),
+		UploaderID:     msg.SenderID,
+		ConversationID: msg.ConversationID,
+		MessageID:      msg.MessageID,
+	}
+	msg.Attachments = []*models.Attachment{{AttachmentID: stored.AttachmentID}}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockAttachmentRepo.On("GetAttachment", ctx, stored.AttachmentID).Return(stored, nil)
+	mockAttachmentRepo.On("BindAttachment", ctx, stored.AttachmentID, msg.ConversationID, msg.MessageID).Return(true, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockAttachmentRepo, new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.NoError(t, err)
+	mockAttachmentRepo.AssertExpectations(t)
+}
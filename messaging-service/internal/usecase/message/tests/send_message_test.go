@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestMessage() *models.Message {
+	return &models.Message{
+		MessageID:      gocql.TimeUUID(),
+		SenderID:       gocql.TimeUUID(),
+		RecipientID:    gocql.TimeUUID(),
+		ConversationID: gocql.TimeUUID(),
+		Content:        "hello",
+		Timestamp:      time.Now(),
+		Status:         models.StatusSent,
+	}
+}
+
+// mockDirectConversation настраивает личную переписку отправителя и получателя сообщения
+func mockDirectConversation(ctx context.Context, repo *mocks.ConversationRepository, msg *models.Message) {
+	repo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	repo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleMember},
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleMember},
+	}, nil)
+}
+
+// newTestInboxRepo возвращает список бесед, принимающий любые обновления
+func newTestInboxRepo() *mocks.InboxRepository {
+	repo := new(mocks.InboxRepository)
+	repo.On("RecordMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	repo.On("UpdatePreview", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	repo.On("MarkRead", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	repo.On("GetMutedUserIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return repo
+}
+
+// newTestSettingsRepo возвращает настройки личных сообщений по умолчанию
+func newTestSettingsRepo() *mocks.MessagingSettingsRepository {
+	repo := new(mocks.MessagingSettingsRepository)
+	repo.On("GetMessagingPolicy", mock.Anything, mock.Anything).Return(models.MessagingPolicyUnspecified, nil).Maybe()
+	return repo
+}
+
+// newTestFriendships возвращает каталог, в котором все пользователи дружат
+func newTestFriendships() *mocks.FriendshipDirectory {
+	directory := new(mocks.FriendshipDirectory)
+	directory.On("GetRelationship", mock.Anything, mock.Anything, mock.Anything).Return(&models.Relationship{Friends: true}, nil).Maybe()
+	return directory
+}
+
+func TestSendMessageUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessageCreated && event.Message == msg
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteByConversationID(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	recipientID := msg.RecipientID
+	msg.RecipientID = gocql.UUID{}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleMember},
+		{ConversationID: msg.ConversationID, UserID: recipientID, Role: models.RoleMember},
+	}, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, recipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, recipientID, msg.RecipientID)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteGroup(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.RecipientID = gocql.UUID{}
+	member1, member2 := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID, Role: models.RoleAdmin},
+		{ConversationID: msg.ConversationID, UserID: member1, Role: models.RoleMember},
+		{ConversationID: msg.ConversationID, UserID: member2, Role: models.RoleMember},
+	}, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, member1, member2).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, gocql.UUID{}, msg.RecipientID)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockConvRepo.On("GetConversation", ctx, msg.ConversationID).Return(&models.Conversation{
+		ConversationID: msg.ConversationID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.RecipientID, Role: models.RoleAdmin},
+	}, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteSaveError(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(errors.New("database error"))
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	assert.Error(t, err)
+	assert.Equal(t, "database error", err.Error())
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecutePublishError(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(errors.New("redis error"))
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Сообщение сохранено, ошибка рассылки не возвращается клиенту
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
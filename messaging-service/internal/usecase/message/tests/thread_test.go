@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendMessageUsecaseExecuteReply(t *testing.T) {
+	ctx := context.Background()
+	root := newTestMessage()
+	parent := newTestMessage()
+	parent.ConversationID = root.ConversationID
+	parent.ThreadRootID = root.MessageID
+	msg := newTestMessage()
+	msg.ConversationID = root.ConversationID
+	msg.ReplyToMessageID = parent.MessageID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, parent.MessageID).Return(parent, nil)
+	mockRepo.On("SaveMessage", ctx, msg, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), mockHub)
+	err := usecase.Execute(ctx, msg)
+
+	// Ответ на ответ попадает в ветку корневого сообщения
+	assert.NoError(t, err)
+	assert.Equal(t, root.MessageID, msg.ThreadRootID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSendMessageUsecaseExecuteReplyForeignConversation(t *testing.T) {
+	ctx := context.Background()
+	parent := newTestMessage()
+	msg := newTestMessage()
+	msg.ReplyToMessageID = parent.MessageID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, parent.MessageID).Return(parent, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrReplyToForeign)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendMessageUsecaseExecuteReplyNotFound(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.ReplyToMessageID = gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.ReplyToMessageID).Return(nil, nil)
+
+	usecase := message.NewSendMessageUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), new(mocks.AttachmentRepository), new(mocks.KeyRepository), newTestSettingsRepo(), newTestFriendships(), new(mocks.UserDirectory), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg)
+
+	assert.ErrorIs(t, err, message.ErrReplyToNotFound)
+	mockRepo.AssertNotCalled(t, "SaveMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetThreadUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	root := newTestMessage()
+	userID := root.RecipientID
+	replies := newTestHistory(root.ConversationID, 3)
+	for _, reply := range replies {
+		reply.ThreadRootID = root.MessageID
+	}
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, root.ConversationID, userID)
+	mockRepo.On("GetMessageByID", ctx, root.MessageID).Return(root, nil)
+	mockRepo.On("GetReplies", ctx, root.ConversationID, root.MessageID, gocql.UUID{}, 3).Return(replies, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, root.ConversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	// Количество ответов запрашивается только для корня
+	mockRepo.On("GetReplyCounts", ctx, []gocql.UUID{root.MessageID}).Return(map[gocql.UUID]int{root.MessageID: 3}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, root.ConversationID, nil, root.SenderID, root.RecipientID)
+
+	usecase := message.NewGetThreadUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	thread, err := usecase.Execute(ctx, root.MessageID, userID, models.PageQuery{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, root, thread.Root)
+	assert.Equal(t, 3, thread.Root.ReplyCount)
+	assert.Equal(t, replies[:2], thread.Replies)
+	assert.True(t, thread.HasMore)
+	assert.Equal(t, replies[1].MessageID, thread.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetThreadUsecaseExecuteFromReply(t *testing.T) {
+	ctx := context.Background()
+	root := newTestMessage()
+	reply := newTestMessage()
+	reply.ConversationID = root.ConversationID
+	reply.ThreadRootID = root.MessageID
+	userID := root.SenderID
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockReactionRepo := new(mocks.ReactionRepository)
+	mockMember(ctx, mockConvRepo, root.ConversationID, userID)
+	mockRepo.On("GetMessageByID", ctx, reply.MessageID).Return(reply, nil)
+	mockRepo.On("GetMessageByID", ctx, root.MessageID).Return(root, nil)
+	mockRepo.On("GetReplies", ctx, root.ConversationID, root.MessageID, gocql.UUID{}, 51).Return([]*models.Message{reply}, nil)
+	mockRepo.On("GetHiddenMessageIDs", ctx, userID, root.ConversationID, mock.Anything).Return(map[gocql.UUID]bool{}, nil)
+	mockReactionRepo.On("GetSummaries", ctx, mock.Anything, userID).Return(map[gocql.UUID][]*models.ReactionSummary{}, nil)
+	mockRepo.On("GetReplyCounts", ctx, mock.Anything).Return(map[gocql.UUID]int{root.MessageID: 1}, nil)
+	mockInboxRepo := mockReadMarks(ctx, mockConvRepo, root.ConversationID, nil, root.SenderID, root.RecipientID)
+
+	usecase := message.NewGetThreadUsecase(mockRepo, mockConvRepo, mockReactionRepo, mockInboxRepo)
+	thread, err := usecase.Execute(ctx, reply.MessageID, userID, models.PageQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, root, thread.Root)
+	assert.Equal(t, []*models.Message{reply}, thread.Replies)
+	assert.False(t, thread.HasMore)
+}
@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/message/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMessageStatusUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("UpdateMessageStatus", ctx, msg, models.StatusRead, mock.AnythingOfType("*models.OutboxEvent")).Return(nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventMessageStatusChanged && event.Message.Status == models.StatusRead
+	}), msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusRead)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockHub.AssertExpectations(t)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteNotFound(t *testing.T) {
+	ctx := context.Background()
+	messageID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, messageID).Return(nil, nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	err := usecase.Execute(ctx, messageID, gocql.TimeUUID(), models.StatusRead)
+
+	assert.ErrorIs(t, err, message.ErrMessageNotFound)
+	mockRepo.AssertNotCalled(t, "UpdateMessageStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteRecordsTimes(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("UpdateMessageStatus", ctx, msg, mock.Anything, mock.Anything).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusDelivered))
+	deliveredAt := msg.DeliveredAt
+	require.False(t, deliveredAt.IsZero())
+	assert.True(t, msg.ReadAt.IsZero())
+
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusRead))
+	assert.Equal(t, models.StatusRead, msg.Status)
+	assert.Equal(t, deliveredAt, msg.DeliveredAt)
+	assert.False(t, msg.ReadAt.IsZero())
+}
+
+func TestUpdateMessageStatusUsecaseExecuteSkipToRead(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockDirectConversation(ctx, mockConvRepo, msg)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockRepo.On("UpdateMessageStatus", ctx, msg, models.StatusRead, mock.Anything).Return(nil)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, msg.RecipientID).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusRead))
+
+	// Пропущенная доставка получает время прочтения
+	assert.False(t, msg.ReadAt.IsZero())
+	assert.Equal(t, msg.ReadAt, msg.DeliveredAt)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteBackwards(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.AdvanceStatus(models.StatusRead, time.Now())
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.Hub))
+	err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusDelivered)
+
+	assert.ErrorIs(t, err, message.ErrInvalidStatus)
+	assert.Equal(t, models.StatusRead, msg.Status)
+	mockRepo.AssertNotCalled(t, "UpdateMessageStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteSameStatus(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.AdvanceStatus(models.StatusDelivered, time.Now())
+
+	mockRepo := new(mocks.MessageRepository)
+	mockHub := new(mocks.Hub)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), mockHub)
+	err := usecase.Execute(ctx, msg.MessageID, msg.RecipientID, models.StatusDelivered)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "UpdateMessageStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteNotRecipient(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, new(mocks.ConversationRepository), newTestInboxRepo(), new(mocks.Hub))
+	for _, userID := range []gocql.UUID{msg.SenderID, gocql.TimeUUID()} {
+		err := usecase.Execute(ctx, msg.MessageID, userID, models.StatusRead)
+		assert.ErrorIs(t, err, message.ErrNotMessageRecipient)
+	}
+	assert.Equal(t, models.StatusSent, msg.Status)
+	mockRepo.AssertNotCalled(t, "UpdateMessageStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateMessageStatusUsecaseExecuteGroupMember(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage()
+	msg.RecipientID = gocql.UUID{}
+	memberID := gocql.TimeUUID()
+	outsiderID := gocql.TimeUUID()
+
+	mockRepo := new(mocks.MessageRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockRepo.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockMember(ctx, mockConvRepo, msg.ConversationID, memberID)
+	mockConvRepo.On("GetMember", ctx, msg.ConversationID, outsiderID).Return(nil, nil)
+	mockRepo.On("UpdateMessageStatus", ctx, msg, models.StatusDelivered, mock.Anything).Return(nil)
+	mockConvRepo.On("GetMembers", ctx, msg.ConversationID).Return([]*models.ConversationMember{
+		{ConversationID: msg.ConversationID, UserID: msg.SenderID},
+		{ConversationID: msg.ConversationID, UserID: memberID},
+	}, nil)
+	mockHub := new(mocks.Hub)
+	mockHub.On("Publish", ctx, mock.Anything, msg.SenderID, memberID).Return(nil)
+
+	usecase := message.NewUpdateMessageStatusUsecase(mockRepo, mockConvRepo, newTestInboxRepo(), mockHub)
+	assert.ErrorIs(t, usecase.Execute(ctx, msg.MessageID, outsiderID, models.StatusDelivered), message.ErrNotMessageRecipient)
+	require.NoError(t, usecase.Execute(ctx, msg.MessageID, memberID, models.StatusDelivered))
+	assert.Equal(t, models.StatusDelivered, msg.Status)
+}
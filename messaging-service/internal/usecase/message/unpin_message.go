@@ -0,0 +1,62 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type UnpinMessageUsecase interface {
+	// Execute открепляет сообщение и сообщает, было ли оно закреплено
+	Execute(ctx context.Context, messageID, userID gocql.UUID) (bool, error)
+}
+
+type unpinMessageUsecase struct {
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	pinRepo          repositories.PinRepository
+	hub              events.Hub
+}
+
+func NewUnpinMessageUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	pinRepo repositories.PinRepository,
+	hub events.Hub,
+) UnpinMessageUsecase {
+	return &unpinMessageUsecase{
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		pinRepo:          pinRepo,
+		hub:              hub,
+	}
+}
+
+func (uc *unpinMessageUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID) (bool, error) {
+	message, err := getMessageForMember(ctx, uc.messageRepo, uc.conversationRepo, messageID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	removed, err := uc.pinRepo.UnpinMessage(ctx, message.ConversationID, messageID)
+	if err != nil {
+		return false, err
+	}
+
+	// Открепление незакрепленного сообщения ничего не меняет и не рассылается
+	if removed {
+		publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+			Type:    models.EventMessageUnpinned,
+			Message: message,
+			Pin: &models.PinnedMessage{
+				ConversationID: message.ConversationID,
+				MessageID:      messageID,
+				PinnedBy:       userID,
+			},
+		})
+	}
+	return removed, nil
+}
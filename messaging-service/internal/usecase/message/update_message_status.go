@@ -2,26 +2,102 @@ package message
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
 	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
 )
 
 type UpdateMessageStatusUsecase interface {
-	Execute(ctx context.Context, messageID gocql.UUID, status models.MessageStatus) error
+	// Execute продвигает статус сообщения от имени получателя, повтор текущего статуса ничего не меняет
+	Execute(ctx context.Context, messageID, userID gocql.UUID, status models.MessageStatus) error
 }
 
 type updateMessageStatusUsecase struct {
-	messageRepo repositories.MessageRepository
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+	hub              events.Hub
 }
 
-func NewUpdateMessageStatusUsecase(messageRepo repositories.MessageRepository) UpdateMessageStatusUsecase {
+func NewUpdateMessageStatusUsecase(
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+	hub events.Hub,
+) UpdateMessageStatusUsecase {
 	return &updateMessageStatusUsecase{
-		messageRepo: messageRepo,
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+		hub:              hub,
 	}
 }
 
-func (uc *updateMessageStatusUsecase) Execute(ctx context.Context, messageID gocql.UUID, status models.MessageStatus) error {
-	return uc.messageRepo.UpdateMessageStatus(ctx, messageID, status)
+func (uc *updateMessageStatusUsecase) Execute(ctx context.Context, messageID, userID gocql.UUID, status models.MessageStatus) error {
+	message, err := uc.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return ErrMessageNotFound
+	}
+	if err := uc.checkRecipient(ctx, message, userID); err != nil {
+		return err
+	}
+	if message.Status == status {
+		return nil
+	}
+	if !message.Status.CanAdvanceTo(status) {
+		return ErrInvalidStatus
+	}
+
+	message.AdvanceStatus(status, time.Now())
+	outboxEvent, err := events.NewMessageStatusChangedEvent(message)
+	if err != nil {
+		return err
+	}
+	if err := uc.messageRepo.UpdateMessageStatus(ctx, message, status, outboxEvent); err != nil {
+		return err
+	}
+
+	// Прочтение личного сообщения сдвигает отметку прочтения получателя в списке бесед
+	if status == models.StatusRead && message.RecipientID != (gocql.UUID{}) {
+		if _, err := uc.inboxRepo.MarkRead(ctx, message.RecipientID, message.ConversationID, messageID); err != nil {
+			log.Printf("error marking conversation %s read: %v", message.ConversationID, err)
+		}
+	}
+
+	publishToConversation(ctx, uc.conversationRepo, uc.hub, &models.MessageEvent{
+		Type:    models.EventMessageStatusChanged,
+		Message: message,
+	})
+
+	return nil
+}
+
+// checkRecipient проверяет, что статус меняет получатель: в личной переписке — адресат сообщения,
+// в группе — любой участник, кроме отправителя
+func (uc *updateMessageStatusUsecase) checkRecipient(ctx context.Context, message *models.Message, userID gocql.UUID) error {
+	if message.SenderID == userID {
+		return ErrNotMessageRecipient
+	}
+	if message.RecipientID != (gocql.UUID{}) {
+		if message.RecipientID != userID {
+			return ErrNotMessageRecipient
+		}
+		return nil
+	}
+
+	member, err := uc.conversationRepo.GetMember(ctx, message.ConversationID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrNotMessageRecipient
+	}
+	return nil
 }
@@ -0,0 +1,27 @@
+package message
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type UpdateMessagingSettingsUsecase interface {
+	Execute(ctx context.Context, userID gocql.UUID, policy models.MessagingPolicy) error
+}
+
+type updateMessagingSettingsUsecase struct {
+	settingsRepo repositories.MessagingSettingsRepository
+}
+
+func NewUpdateMessagingSettingsUsecase(settingsRepo repositories.MessagingSettingsRepository) UpdateMessagingSettingsUsecase {
+	return &updateMessagingSettingsUsecase{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (uc *updateMessagingSettingsUsecase) Execute(ctx context.Context, userID gocql.UUID, policy models.MessagingPolicy) error {
+	return uc.settingsRepo.SetMessagingPolicy(ctx, userID, policy)
+}
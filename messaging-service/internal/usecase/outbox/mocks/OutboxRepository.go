@@ -0,0 +1,80 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+)
+
+// OutboxRepository is an autogenerated mock type for the OutboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+// DeleteEvent provides a mock function with given fields: ctx, shard, eventID
+func (_m *OutboxRepository) DeleteEvent(ctx context.Context, shard int, eventID gocql.UUID) error {
+	ret := _m.Called(ctx, shard, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, gocql.UUID) error); ok {
+		r0 = rf(ctx, shard, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPendingEvents provides a mock function with given fields: ctx, shard, limit
+func (_m *OutboxRepository) GetPendingEvents(ctx context.Context, shard int, limit int) ([]*models.OutboxEvent, error) {
+	ret := _m.Called(ctx, shard, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingEvents")
+	}
+
+	var r0 []*models.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*models.OutboxEvent, error)); ok {
+		return rf(ctx, shard, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*models.OutboxEvent); ok {
+		r0 = rf(ctx, shard, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, shard, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+// Число событий, читаемых из партиции outbox за один проход
+const defaultBatchSize = 100
+
+// RelayEventsUsecase публикует накопленные события outbox и возвращает число опубликованных.
+// Доставка выполняется не менее одного раза: потребители отсекают повторы по event_id
+type RelayEventsUsecase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+type relayEventsUsecase struct {
+	outboxRepo repositories.OutboxRepository
+	publisher  events.Publisher
+	batchSize  int
+}
+
+func NewRelayEventsUsecase(
+	outboxRepo repositories.OutboxRepository,
+	publisher events.Publisher,
+	batchSize int,
+) RelayEventsUsecase {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	return &relayEventsUsecase{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		batchSize:  batchSize,
+	}
+}
+
+func (uc *relayEventsUsecase) Execute(ctx context.Context) (int, error) {
+	published := 0
+	var errs []error
+	for shard := 0; shard < models.OutboxShards; shard++ {
+		count, err := uc.relayShard(ctx, shard)
+		published += count
+		if err != nil {
+			errs = append(errs, fmt.Errorf("outbox shard %d: %w", shard, err))
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return published, errors.Join(errs...)
+}
+
+// relayShard публикует события партиции по порядку и останавливается на первой ошибке,
+// чтобы события беседы не обгоняли друг друга
+func (uc *relayEventsUsecase) relayShard(ctx context.Context, shard int) (int, error) {
+	pending, err := uc.outboxRepo.GetPendingEvents(ctx, shard, uc.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, event := range pending {
+		if err := uc.publisher.Publish(ctx, event); err != nil {
+			return published, err
+		}
+		// Если удалить не удалось, событие будет опубликовано повторно
+		if err := uc.outboxRepo.DeleteEvent(ctx, shard, event.EventID); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
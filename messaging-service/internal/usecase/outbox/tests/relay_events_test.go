@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/outbox"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/outbox/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestEvents(shard, n int) []*models.OutboxEvent {
+	events := make([]*models.OutboxEvent, n)
+	for i := range events {
+		events[i] = &models.OutboxEvent{
+			Shard:   shard,
+			EventID: gocql.TimeUUID(),
+			Topic:   "messaging.message.sent.v1",
+			Payload: []byte{byte(i)},
+		}
+	}
+	return events
+}
+
+// mockEmptyShards настраивает пустые партиции outbox, кроме перечисленных
+func mockEmptyShards(ctx context.Context, repo *mocks.OutboxRepository, except ...int) {
+	skip := make(map[int]bool, len(except))
+	for _, shard := range except {
+		skip[shard] = true
+	}
+	for shard := 0; shard < models.OutboxShards; shard++ {
+		if !skip[shard] {
+			repo.On("GetPendingEvents", ctx, shard, 10).Return(nil, nil)
+		}
+	}
+}
+
+func TestRelayEventsUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	pending := newTestEvents(3, 2)
+
+	mockRepo := new(mocks.OutboxRepository)
+	mockPublisher := new(mocks.Publisher)
+	mockEmptyShards(ctx, mockRepo, 3)
+	mockRepo.On("GetPendingEvents", ctx, 3, 10).Return(pending, nil)
+	for _, event := range pending {
+		mockPublisher.On("Publish", ctx, event).Return(nil).Once()
+		mockRepo.On("DeleteEvent", ctx, 3, event.EventID).Return(nil).Once()
+	}
+
+	usecase := outbox.NewRelayEventsUsecase(mockRepo, mockPublisher, 10)
+	published, err := usecase.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestRelayEventsUsecaseExecuteKeepsFailedEvents(t *testing.T) {
+	ctx := context.Background()
+	pending := newTestEvents(5, 3)
+
+	mockRepo := new(mocks.OutboxRepository)
+	mockPublisher := new(mocks.Publisher)
+	mockEmptyShards(ctx, mockRepo, 5)
+	mockRepo.On("GetPendingEvents", ctx, 5, 10).Return(pending, nil)
+	mockPublisher.On("Publish", ctx, pending[0]).Return(nil)
+	mockRepo.On("DeleteEvent", ctx, 5, pending[0].EventID).Return(nil)
+	mockPublisher.On("Publish", ctx, pending[1]).Return(assert.AnError)
+
+	usecase := outbox.NewRelayEventsUsecase(mockRepo, mockPublisher, 10)
+	published, err := usecase.Execute(ctx)
+
+	// Неопубликованное событие остается в outbox, следующие события беседы его не обгоняют
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 1, published)
+	mockPublisher.AssertNotCalled(t, "Publish", ctx, pending[2])
+	mockRepo.AssertNotCalled(t, "DeleteEvent", ctx, 5, pending[1].EventID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRelayEventsUsecaseExecuteContinuesOtherShards(t *testing.T) {
+	ctx := context.Background()
+	pending := newTestEvents(7, 1)
+
+	mockRepo := new(mocks.OutboxRepository)
+	mockPublisher := new(mocks.Publisher)
+	mockEmptyShards(ctx, mockRepo, 2, 7)
+	mockRepo.On("GetPendingEvents", ctx, 2, 10).Return(nil, assert.AnError)
+	mockRepo.On("GetPendingEvents", ctx, 7, 10).Return(pending, nil)
+	mockPublisher.On("Publish", ctx, pending[0]).Return(nil)
+	mockRepo.On("DeleteEvent", ctx, 7, pending[0].EventID).Return(nil)
+
+	usecase := outbox.NewRelayEventsUsecase(mockRepo, mockPublisher, 10)
+	published, err := usecase.Execute(ctx)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 1, published)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestRelayEventsUsecaseExecuteDefaultBatchSize(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(mocks.OutboxRepository)
+	mockRepo.On("GetPendingEvents", ctx, mock.AnythingOfType("int"), 100).Return(nil, nil)
+
+	usecase := outbox.NewRelayEventsUsecase(mockRepo, new(mocks.Publisher), 0)
+	published, err := usecase.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	mockRepo.AssertNumberOfCalls(t, "GetPendingEvents", models.OutboxShards)
+}
@@ -0,0 +1,54 @@
+package presence
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type GetPresenceUsecase interface {
+	// Execute возвращает присутствие пользователей в порядке запроса
+	Execute(ctx context.Context, requesterID gocql.UUID, userIDs []gocql.UUID) ([]*models.Presence, error)
+}
+
+type getPresenceUsecase struct {
+	presenceRepo repositories.PresenceRepository
+	settingsRepo repositories.PresenceSettingsRepository
+}
+
+func NewGetPresenceUsecase(
+	presenceRepo repositories.PresenceRepository,
+	settingsRepo repositories.PresenceSettingsRepository,
+) GetPresenceUsecase {
+	return &getPresenceUsecase{
+		presenceRepo: presenceRepo,
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (uc *getPresenceUsecase) Execute(ctx context.Context, requesterID gocql.UUID, userIDs []gocql.UUID) ([]*models.Presence, error) {
+	found, err := uc.presenceRepo.GetPresence(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	hidden, err := uc.settingsRepo.GetHiddenLastSeen(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	presences := make([]*models.Presence, 0, len(userIDs))
+	for _, userID := range userIDs {
+		presence, ok := found[userID]
+		if !ok {
+			presence = &models.Presence{UserID: userID}
+		}
+		// Пользователь всегда видит собственное время последнего присутствия
+		if hidden[userID] && userID != requesterID {
+			presence.HideLastSeen()
+		}
+		presences = append(presences, presence)
+	}
+	return presences, nil
+}
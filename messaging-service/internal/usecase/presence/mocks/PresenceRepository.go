@@ -0,0 +1,148 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// PresenceRepository is an autogenerated mock type for the PresenceRepository type
+type PresenceRepository struct {
+	mock.Mock
+}
+
+// GetPresence provides a mock function with given fields: ctx, userIDs
+func (_m *PresenceRepository) GetPresence(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]*models.Presence, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPresence")
+	}
+
+	var r0 map[gocql.UUID]*models.Presence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) (map[gocql.UUID]*models.Presence, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) map[gocql.UUID]*models.Presence); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]*models.Presence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []gocql.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOffline provides a mock function with given fields: ctx, userID, at
+func (_m *PresenceRepository) SetOffline(ctx context.Context, userID gocql.UUID, at time.Time) (bool, error) {
+	ret := _m.Called(ctx, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOffline")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, time.Time) (bool, error)); ok {
+		return rf(ctx, userID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, time.Time) bool); ok {
+		r0 = rf(ctx, userID, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOnline provides a mock function with given fields: ctx, userID, at, ttl
+func (_m *PresenceRepository) SetOnline(ctx context.Context, userID gocql.UUID, at time.Time, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, userID, at, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOnline")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, time.Time, time.Duration) (bool, error)); ok {
+		return rf(ctx, userID, at, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, time.Time, time.Duration) bool); ok {
+		r0 = rf(ctx, userID, at, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, userID, at, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTyping provides a mock function with given fields: ctx, conversationID, userID, typing, ttl
+func (_m *PresenceRepository) SetTyping(ctx context.Context, conversationID gocql.UUID, userID gocql.UUID, typing bool, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, conversationID, userID, typing, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTyping")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, bool, time.Duration) (bool, error)); ok {
+		return rf(ctx, conversationID, userID, typing, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, bool, time.Duration) bool); ok {
+		r0 = rf(ctx, conversationID, userID, typing, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, bool, time.Duration) error); ok {
+		r1 = rf(ctx, conversationID, userID, typing, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPresenceRepository creates a new instance of PresenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceRepository {
+	mock := &PresenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PresenceSettingsRepository is an autogenerated mock type for the PresenceSettingsRepository type
+type PresenceSettingsRepository struct {
+	mock.Mock
+}
+
+// GetHiddenLastSeen provides a mock function with given fields: ctx, userIDs
+func (_m *PresenceSettingsRepository) GetHiddenLastSeen(ctx context.Context, userIDs []gocql.UUID) (map[gocql.UUID]bool, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHiddenLastSeen")
+	}
+
+	var r0 map[gocql.UUID]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) (map[gocql.UUID]bool, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) map[gocql.UUID]bool); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []gocql.UUID) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHideLastSeen provides a mock function with given fields: ctx, userID, hide
+func (_m *PresenceSettingsRepository) SetHideLastSeen(ctx context.Context, userID gocql.UUID, hide bool) error {
+	ret := _m.Called(ctx, userID, hide)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHideLastSeen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, bool) error); ok {
+		r0 = rf(ctx, userID, hide)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPresenceSettingsRepository creates a new instance of PresenceSettingsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceSettingsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceSettingsRepository {
+	mock := &PresenceSettingsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
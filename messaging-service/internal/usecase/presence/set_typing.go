@@ -0,0 +1,91 @@
+package presence
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+// Время жизни индикатора набора по умолчанию, клиент продлевает его, пока пользователь печатает
+const DefaultTypingTTL = 6 * time.Second
+
+type SetTypingUsecase interface {
+	Execute(ctx context.Context, conversationID, userID gocql.UUID, typing bool) error
+}
+
+type setTypingUsecase struct {
+	presenceRepo     repositories.PresenceRepository
+	conversationRepo repositories.ConversationRepository
+	hub              events.Hub
+	ttl              time.Duration
+}
+
+func NewSetTypingUsecase(
+	presenceRepo repositories.PresenceRepository,
+	conversationRepo repositories.ConversationRepository,
+	hub events.Hub,
+	ttl time.Duration,
+) SetTypingUsecase {
+	if ttl <= 0 {
+		ttl = DefaultTypingTTL
+	}
+	return &setTypingUsecase{
+		presenceRepo:     presenceRepo,
+		conversationRepo: conversationRepo,
+		hub:              hub,
+		ttl:              ttl,
+	}
+}
+
+func (uc *setTypingUsecase) Execute(ctx context.Context, conversationID, userID gocql.UUID, typing bool) error {
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return conversation.ErrNotConversationMember
+	}
+
+	changed, err := uc.presenceRepo.SetTyping(ctx, conversationID, userID, typing, uc.ttl)
+	if err != nil {
+		return err
+	}
+	// Продление индикатора не рассылается, чтобы не засорять потоки событий
+	if !changed {
+		return nil
+	}
+
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	recipients := make([]gocql.UUID, 0, len(members))
+	for _, m := range members {
+		if m.UserID != userID {
+			recipients = append(recipients, m.UserID)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	event := &models.MessageEvent{
+		Type: models.EventTyping,
+		Typing: &models.TypingIndicator{
+			ConversationID: conversationID,
+			UserID:         userID,
+			Typing:         typing,
+		},
+		Timestamp: time.Now(),
+	}
+	if err := uc.hub.Publish(ctx, event, recipients...); err != nil {
+		log.Printf("error publishing typing in conversation %s: %v", conversationID, err)
+	}
+	return nil
+}
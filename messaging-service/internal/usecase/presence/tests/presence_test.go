@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// presenceFixture — пользователь с личной перепиской и групповой беседой в списке бесед
+type presenceFixture struct {
+	userID    gocql.UUID
+	peerID    gocql.UUID
+	inboxRepo *mocks.InboxRepository
+	convRepo  *mocks.ConversationRepository
+}
+
+func newPresenceFixture(ctx context.Context) *presenceFixture {
+	f := &presenceFixture{
+		userID:    gocql.TimeUUID(),
+		peerID:    gocql.TimeUUID(),
+		inboxRepo: new(mocks.InboxRepository),
+		convRepo:  new(mocks.ConversationRepository),
+	}
+	directID, groupID := gocql.TimeUUID(), gocql.TimeUUID()
+	f.inboxRepo.On("GetInbox", ctx, f.userID, gocql.UUID{}, models.InboxAll, mock.Anything).Return([]*models.InboxEntry{
+		{UserID: f.userID, ConversationID: directID},
+		{UserID: f.userID, ConversationID: groupID},
+	}, nil)
+	f.convRepo.On("GetConversation", ctx, directID).Return(&models.Conversation{
+		ConversationID: directID,
+		Type:           models.ConversationTypeDirect,
+	}, nil)
+	f.convRepo.On("GetConversation", ctx, groupID).Return(&models.Conversation{
+		ConversationID: groupID,
+		Type:           models.ConversationTypeGroup,
+	}, nil)
+	mockMembers(ctx, f.convRepo, directID, f.userID, f.peerID)
+	return f
+}
+
+func TestUpdatePresenceUsecaseExecuteOnline(t *testing.T) {
+	ctx := context.Background()
+	f := newPresenceFixture(ctx)
+	ttl := 30 * time.Second
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockSettingsRepo := new(mocks.PresenceSettingsRepository)
+	mockHub := new(mocks.Hub)
+	mockPresenceRepo.On("SetOnline", ctx, f.userID, mock.Anything, ttl).Return(true, nil)
+	mockSettingsRepo.On("GetHiddenLastSeen", ctx, []gocql.UUID{f.userID}).Return(map[gocql.UUID]bool{}, nil)
+	// Участники групповых бесед не получают изменения присутствия
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventPresenceChanged &&
+			event.Presence.UserID == f.userID &&
+			event.Presence.Online &&
+			!event.Presence.LastSeenAt.IsZero()
+	}), f.peerID).Return(nil)
+
+	usecase := presence.NewUpdatePresenceUsecase(mockPresenceRepo, mockSettingsRepo, f.inboxRepo, f.convRepo, mockHub, ttl)
+	interval, err := usecase.Execute(ctx, f.userID, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, ttl/2, interval)
+	mockHub.AssertExpectations(t)
+}
+
+func TestUpdatePresenceUsecaseExecuteOfflineHidesLastSeen(t *testing.T) {
+	ctx := context.Background()
+	f := newPresenceFixture(ctx)
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockSettingsRepo := new(mocks.PresenceSettingsRepository)
+	mockHub := new(mocks.Hub)
+	mockPresenceRepo.On("SetOffline", ctx, f.userID, mock.Anything).Return(true, nil)
+	mockSettingsRepo.On("GetHiddenLastSeen", ctx, []gocql.UUID{f.userID}).Return(map[gocql.UUID]bool{f.userID: true}, nil)
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return !event.Presence.Online && event.Presence.LastSeenHidden && event.Presence.LastSeenAt.IsZero()
+	}), f.peerID).Return(nil)
+
+	usecase := presence.NewUpdatePresenceUsecase(mockPresenceRepo, mockSettingsRepo, f.inboxRepo, f.convRepo, mockHub, 0)
+	_, err := usecase.Execute(ctx, f.userID, false)
+
+	require.NoError(t, err)
+	mockHub.AssertExpectations(t)
+}
+
+func TestUpdatePresenceUsecaseExecuteHeartbeat(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockInboxRepo := new(mocks.InboxRepository)
+	mockHub := new(mocks.Hub)
+	mockPresenceRepo.On("SetOnline", ctx, userID, mock.Anything, presence.DefaultPresenceTTL).Return(false, nil)
+
+	usecase := presence.NewUpdatePresenceUsecase(mockPresenceRepo, new(mocks.PresenceSettingsRepository), mockInboxRepo, new(mocks.ConversationRepository), mockHub, 0)
+	interval, err := usecase.Execute(ctx, userID, true)
+
+	// Пользователь уже в сети, собеседники ничего не получают
+	require.NoError(t, err)
+	assert.Equal(t, presence.DefaultPresenceTTL/2, interval)
+	mockInboxRepo.AssertNotCalled(t, "GetInbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetPresenceUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	requesterID, hiddenID, unknownID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+	lastSeen := time.Now().Add(-time.Hour).Truncate(time.Second)
+	userIDs := []gocql.UUID{hiddenID, requesterID, unknownID}
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockSettingsRepo := new(mocks.PresenceSettingsRepository)
+	mockPresenceRepo.On("GetPresence", ctx, userIDs).Return(map[gocql.UUID]*models.Presence{
+		hiddenID:    {UserID: hiddenID, Online: true, LastSeenAt: lastSeen},
+		requesterID: {UserID: requesterID, LastSeenAt: lastSeen},
+	}, nil)
+	mockSettingsRepo.On("GetHiddenLastSeen", ctx, userIDs).Return(map[gocql.UUID]bool{
+		hiddenID:    true,
+		requesterID: true,
+	}, nil)
+
+	usecase := presence.NewGetPresenceUsecase(mockPresenceRepo, mockSettingsRepo)
+	presences, err := usecase.Execute(ctx, requesterID, userIDs)
+
+	require.NoError(t, err)
+	require.Len(t, presences, 3)
+	// Скрытие времени не скрывает присутствие в сети
+	assert.True(t, presences[0].Online)
+	assert.True(t, presences[0].LastSeenHidden)
+	assert.True(t, presences[0].LastSeenAt.IsZero())
+	// Собственное время пользователь видит всегда
+	assert.Equal(t, lastSeen, presences[1].LastSeenAt)
+	assert.False(t, presences[1].LastSeenHidden)
+	assert.Equal(t, unknownID, presences[2].UserID)
+	assert.False(t, presences[2].Online)
+}
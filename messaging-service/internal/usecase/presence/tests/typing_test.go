@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/presence/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockMembers(ctx context.Context, repo *mocks.ConversationRepository, conversationID gocql.UUID, userIDs ...gocql.UUID) {
+	members := make([]*models.ConversationMember, len(userIDs))
+	for i, userID := range userIDs {
+		members[i] = &models.ConversationMember{ConversationID: conversationID, UserID: userID, Role: models.RoleMember}
+		repo.On("GetMember", ctx, conversationID, userID).Return(members[i], nil)
+	}
+	repo.On("GetMembers", ctx, conversationID).Return(members, nil)
+}
+
+func TestSetTypingUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID, peerID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockMembers(ctx, mockConvRepo, conversationID, userID, peerID)
+	mockPresenceRepo.On("SetTyping", ctx, conversationID, userID, true, presence.DefaultTypingTTL).Return(true, nil)
+	// Набирающий сообщение не получает собственный индикатор
+	mockHub.On("Publish", ctx, mock.MatchedBy(func(event *models.MessageEvent) bool {
+		return event.Type == models.EventTyping && event.Typing.UserID == userID && event.Typing.Typing
+	}), peerID).Return(nil)
+
+	usecase := presence.NewSetTypingUsecase(mockPresenceRepo, mockConvRepo, mockHub, 0)
+	err := usecase.Execute(ctx, conversationID, userID, true)
+
+	assert.NoError(t, err)
+	mockHub.AssertExpectations(t)
+}
+
+func TestSetTypingUsecaseExecuteProlongs(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID, peerID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockHub := new(mocks.Hub)
+	mockMembers(ctx, mockConvRepo, conversationID, userID, peerID)
+	mockPresenceRepo.On("SetTyping", ctx, conversationID, userID, true, presence.DefaultTypingTTL).Return(false, nil)
+
+	usecase := presence.NewSetTypingUsecase(mockPresenceRepo, mockConvRepo, mockHub, 0)
+	err := usecase.Execute(ctx, conversationID, userID, true)
+
+	assert.NoError(t, err)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetTypingUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	conversationID, userID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockPresenceRepo := new(mocks.PresenceRepository)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := presence.NewSetTypingUsecase(mockPresenceRepo, mockConvRepo, new(mocks.Hub), 0)
+	err := usecase.Execute(ctx, conversationID, userID, true)
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	mockPresenceRepo.AssertNotCalled(t, "SetTyping", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
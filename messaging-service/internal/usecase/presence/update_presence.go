@@ -0,0 +1,139 @@
+package presence
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+const (
+	// Время, в течение которого пользователь считается в сети после сигнала, по умолчанию
+	DefaultPresenceTTL = time.Minute
+	// Изменение присутствия рассылается собеседникам из стольких последних бесед пользователя
+	presenceRecentConversations = 50
+)
+
+type UpdatePresenceUsecase interface {
+	// Execute отмечает пользователя в сети или вне ее и возвращает интервал, с которым клиент должен повторять сигнал
+	Execute(ctx context.Context, userID gocql.UUID, online bool) (time.Duration, error)
+}
+
+type updatePresenceUsecase struct {
+	presenceRepo     repositories.PresenceRepository
+	settingsRepo     repositories.PresenceSettingsRepository
+	inboxRepo        repositories.InboxRepository
+	conversationRepo repositories.ConversationRepository
+	hub              events.Hub
+	ttl              time.Duration
+}
+
+func NewUpdatePresenceUsecase(
+	presenceRepo repositories.PresenceRepository,
+	settingsRepo repositories.PresenceSettingsRepository,
+	inboxRepo repositories.InboxRepository,
+	conversationRepo repositories.ConversationRepository,
+	hub events.Hub,
+	ttl time.Duration,
+) UpdatePresenceUsecase {
+	if ttl <= 0 {
+		ttl = DefaultPresenceTTL
+	}
+	return &updatePresenceUsecase{
+		presenceRepo:     presenceRepo,
+		settingsRepo:     settingsRepo,
+		inboxRepo:        inboxRepo,
+		conversationRepo: conversationRepo,
+		hub:              hub,
+		ttl:              ttl,
+	}
+}
+
+func (uc *updatePresenceUsecase) Execute(ctx context.Context, userID gocql.UUID, online bool) (time.Duration, error) {
+	// Сигнал повторяется с запасом, чтобы задержка одного запроса не выводила пользователя из сети
+	interval := uc.ttl / 2
+
+	now := time.Now()
+	var changed bool
+	var err error
+	if online {
+		changed, err = uc.presenceRepo.SetOnline(ctx, userID, now, uc.ttl)
+	} else {
+		changed, err = uc.presenceRepo.SetOffline(ctx, userID, now)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if changed {
+		uc.publishPresence(ctx, &models.Presence{
+			UserID:     userID,
+			Online:     online,
+			LastSeenAt: now,
+		})
+	}
+	return interval, nil
+}
+
+// publishPresence сообщает об изменении присутствия собеседникам из последних личных переписок.
+// Присутствие уже сохранено, поэтому ошибки рассылки только логируются
+func (uc *updatePresenceUsecase) publishPresence(ctx context.Context, presence *models.Presence) {
+	peerIDs, err := uc.recentPeers(ctx, presence.UserID)
+	if err != nil {
+		log.Printf("error getting recent peers of %s: %v", presence.UserID, err)
+		return
+	}
+	if len(peerIDs) == 0 {
+		return
+	}
+
+	hidden, err := uc.settingsRepo.GetHiddenLastSeen(ctx, []gocql.UUID{presence.UserID})
+	if err != nil {
+		log.Printf("error getting presence settings of %s: %v", presence.UserID, err)
+		return
+	}
+	if hidden[presence.UserID] {
+		presence.HideLastSeen()
+	}
+
+	event := &models.MessageEvent{
+		Type:      models.EventPresenceChanged,
+		Presence:  presence,
+		Timestamp: time.Now(),
+	}
+	if err := uc.hub.Publish(ctx, event, peerIDs...); err != nil {
+		log.Printf("error publishing presence of %s: %v", presence.UserID, err)
+	}
+}
+
+// recentPeers возвращает собеседников пользователя в последних личных переписках
+func (uc *updatePresenceUsecase) recentPeers(ctx context.Context, userID gocql.UUID) ([]gocql.UUID, error) {
+	entries, err := uc.inboxRepo.GetInbox(ctx, userID, gocql.UUID{}, models.InboxAll, presenceRecentConversations)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerIDs []gocql.UUID
+	for _, entry := range entries {
+		conv, err := uc.conversationRepo.GetConversation(ctx, entry.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		if conv == nil || conv.Type != models.ConversationTypeDirect {
+			continue
+		}
+		members, err := uc.conversationRepo.GetMembers(ctx, conv.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.UserID != userID {
+				peerIDs = append(peerIDs, member.UserID)
+			}
+		}
+	}
+	return peerIDs, nil
+}
@@ -0,0 +1,26 @@
+package presence
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+type UpdatePresenceSettingsUsecase interface {
+	Execute(ctx context.Context, userID gocql.UUID, hideLastSeen bool) error
+}
+
+type updatePresenceSettingsUsecase struct {
+	settingsRepo repositories.PresenceSettingsRepository
+}
+
+func NewUpdatePresenceSettingsUsecase(settingsRepo repositories.PresenceSettingsRepository) UpdatePresenceSettingsUsecase {
+	return &updatePresenceSettingsUsecase{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (uc *updatePresenceSettingsUsecase) Execute(ctx context.Context, userID gocql.UUID, hideLastSeen bool) error {
+	return uc.settingsRepo.SetHideLastSeen(ctx, userID, hideLastSeen)
+}
@@ -0,0 +1,8 @@
+package search
+
+import "errors"
+
+var (
+	ErrEmptyQuery       = errors.New("search query has no words")
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
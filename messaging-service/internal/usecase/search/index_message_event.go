@@ -0,0 +1,91 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/search"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// IndexedTopics — топики событий, из которых строится поисковый индекс
+var IndexedTopics = []string{
+	events.TopicMessageSent,
+	events.TopicMessageEdited,
+	events.TopicMessageDeleted,
+}
+
+// IndexMessageEventUsecase обновляет поисковый индекс по событию сообщения из Kafka
+type IndexMessageEventUsecase interface {
+	Execute(ctx context.Context, topic string, payload []byte) error
+}
+
+type indexMessageEventUsecase struct {
+	index       search.Index
+	messageRepo repositories.MessageRepository
+}
+
+func NewIndexMessageEventUsecase(
+	index search.Index,
+	messageRepo repositories.MessageRepository,
+) IndexMessageEventUsecase {
+	return &indexMessageEventUsecase{
+		index:       index,
+		messageRepo: messageRepo,
+	}
+}
+
+// Execute индексирует текущее состояние сообщения из Cassandra, а не содержимое события.
+// События разных топиков не упорядочены между собой, а так индекс сходится при любом порядке и повторах
+func (uc *indexMessageEventUsecase) Execute(ctx context.Context, topic string, payload []byte) error {
+	messageID, err := decodeMessageID(topic, payload)
+	if err != nil {
+		return err
+	}
+
+	message, err := uc.messageRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if message == nil || message.Deleted || message.PlainText() == "" {
+		return uc.index.Delete(ctx, messageID)
+	}
+	return uc.index.Index(ctx, &models.SearchDocument{
+		MessageID:      message.MessageID,
+		ConversationID: message.ConversationID,
+		SenderID:       message.SenderID,
+		Content:        message.PlainText(),
+		SentAt:         message.Timestamp,
+		ExpiresAt:      message.ExpiresAt,
+	})
+}
+
+func decodeMessageID(topic string, payload []byte) (gocql.UUID, error) {
+	var event interface {
+		proto.Message
+		GetMessageId() string
+	}
+	switch topic {
+	case events.TopicMessageSent:
+		event = &pb.MessageSent{}
+	case events.TopicMessageEdited:
+		event = &pb.MessageEdited{}
+	case events.TopicMessageDeleted:
+		event = &pb.MessageDeleted{}
+	default:
+		return gocql.UUID{}, fmt.Errorf("unexpected topic %s", topic)
+	}
+	if err := proto.Unmarshal(payload, event); err != nil {
+		return gocql.UUID{}, fmt.Errorf("failed to decode %s event: %w", topic, err)
+	}
+	messageID, err := gocql.ParseUUID(event.GetMessageId())
+	if err != nil {
+		return gocql.UUID{}, fmt.Errorf("invalid message_id in %s event: %w", topic, err)
+	}
+	return messageID, nil
+}
@@ -0,0 +1,330 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// InboxRepository is an autogenerated mock type for the InboxRepository type
+type InboxRepository struct {
+	mock.Mock
+}
+
+// CountUnreadConversations provides a mock function with given fields: ctx, userID
+func (_m *InboxRepository) CountUnreadConversations(ctx context.Context, userID gocql.UUID) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadConversations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConversationIDs provides a mock function with given fields: ctx, userID
+func (_m *InboxRepository) GetConversationIDs(ctx context.Context, userID gocql.UUID) ([]gocql.UUID, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversationIDs")
+	}
+
+	var r0 []gocql.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]gocql.UUID, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []gocql.UUID); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gocql.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEntry provides a mock function with given fields: ctx, userID, conversationID
+func (_m *InboxRepository) GetEntry(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID) (*models.InboxEntry, error) {
+	ret := _m.Called(ctx, userID, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntry")
+	}
+
+	var r0 *models.InboxEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) (*models.InboxEntry, error)); ok {
+		return rf(ctx, userID, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID) *models.InboxEntry); ok {
+		r0 = rf(ctx, userID, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InboxEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInbox provides a mock function with given fields: ctx, userID, cursor, folder, limit
+func (_m *InboxRepository) GetInbox(ctx context.Context, userID gocql.UUID, cursor gocql.UUID, folder models.InboxFolder, limit int) ([]*models.InboxEntry, error) {
+	ret := _m.Called(ctx, userID, cursor, folder, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInbox")
+	}
+
+	var r0 []*models.InboxEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, models.InboxFolder, int) ([]*models.InboxEntry, error)); ok {
+		return rf(ctx, userID, cursor, folder, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, models.InboxFolder, int) []*models.InboxEntry); ok {
+		r0 = rf(ctx, userID, cursor, folder, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.InboxEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, models.InboxFolder, int) error); ok {
+		r1 = rf(ctx, userID, cursor, folder, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMutedUserIDs provides a mock function with given fields: ctx, conversationID, userIDs, now
+func (_m *InboxRepository) GetMutedUserIDs(ctx context.Context, conversationID gocql.UUID, userIDs []gocql.UUID, now time.Time) ([]gocql.UUID, error) {
+	ret := _m.Called(ctx, conversationID, userIDs, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMutedUserIDs")
+	}
+
+	var r0 []gocql.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, []gocql.UUID, time.Time) ([]gocql.UUID, error)); ok {
+		return rf(ctx, conversationID, userIDs, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, []gocql.UUID, time.Time) []gocql.UUID); ok {
+		r0 = rf(ctx, conversationID, userIDs, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gocql.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, []gocql.UUID, time.Time) error); ok {
+		r1 = rf(ctx, conversationID, userIDs, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadMarks provides a mock function with given fields: ctx, conversationID
+func (_m *InboxRepository) GetReadMarks(ctx context.Context, conversationID gocql.UUID) (map[gocql.UUID]gocql.UUID, error) {
+	ret := _m.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadMarks")
+	}
+
+	var r0 map[gocql.UUID]gocql.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (map[gocql.UUID]gocql.UUID, error)); ok {
+		return rf(ctx, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) map[gocql.UUID]gocql.UUID); ok {
+		r0 = rf(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]gocql.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: ctx, userID, conversationID, messageID
+func (_m *InboxRepository) MarkRead(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID, messageID gocql.UUID) (bool, error) {
+	ret := _m.Called(ctx, userID, conversationID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) (bool, error)); ok {
+		return rf(ctx, userID, conversationID, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) bool); ok {
+		r0 = rf(ctx, userID, conversationID, messageID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, conversationID, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordMessage provides a mock function with given fields: ctx, userIDs, message
+func (_m *InboxRepository) RecordMessage(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error {
+	ret := _m.Called(ctx, userIDs, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID, *models.Message) error); ok {
+		r0 = rf(ctx, userIDs, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetArchived provides a mock function with given fields: ctx, userID, conversationID, archived
+func (_m *InboxRepository) SetArchived(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID, archived bool) error {
+	ret := _m.Called(ctx, userID, conversationID, archived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArchived")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, bool) error); ok {
+		r0 = rf(ctx, userID, conversationID, archived)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMarkedUnread provides a mock function with given fields: ctx, userID, conversationID, markedUnread
+func (_m *InboxRepository) SetMarkedUnread(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID, markedUnread bool) error {
+	ret := _m.Called(ctx, userID, conversationID, markedUnread)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMarkedUnread")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, bool) error); ok {
+		r0 = rf(ctx, userID, conversationID, markedUnread)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMutedUntil provides a mock function with given fields: ctx, userID, conversationID, mutedUntil
+func (_m *InboxRepository) SetMutedUntil(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID, mutedUntil time.Time) error {
+	ret := _m.Called(ctx, userID, conversationID, mutedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMutedUntil")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, time.Time) error); ok {
+		r0 = rf(ctx, userID, conversationID, mutedUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePreview provides a mock function with given fields: ctx, userIDs, message
+func (_m *InboxRepository) UpdatePreview(ctx context.Context, userIDs []gocql.UUID, message *models.Message) error {
+	ret := _m.Called(ctx, userIDs, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID, *models.Message) error); ok {
+		r0 = rf(ctx, userIDs, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewInboxRepository creates a new instance of InboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InboxRepository {
+	mock := &InboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
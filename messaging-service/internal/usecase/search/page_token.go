@@ -0,0 +1,33 @@
+package search
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+)
+
+// Версия формата токена страницы, чтобы его можно было менять без поломки клиентов
+const pageTokenVersion = 1
+
+// encodePageToken кодирует смещение следующей страницы в непрозрачный для клиента токен
+func encodePageToken(offset int) string {
+	buf := make([]byte, 1, 1+binary.MaxVarintLen64)
+	buf[0] = pageTokenVersion
+	buf = binary.AppendUvarint(buf, uint64(offset))
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// decodePageToken восстанавливает смещение из токена. Пустой токен означает первую страницу
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) < 2 || buf[0] != pageTokenVersion {
+		return 0, ErrInvalidPageToken
+	}
+	offset, n := binary.Uvarint(buf[1:])
+	if n != len(buf)-1 || offset > maxResults {
+		return 0, ErrInvalidPageToken
+	}
+	return int(offset), nil
+}
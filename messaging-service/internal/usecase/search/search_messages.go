@@ -0,0 +1,147 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 50
+	// Глубже этого смещения поиск не листается, чтобы не сортировать в памяти слишком много совпадений
+	maxResults = 1000
+)
+
+type SearchMessagesUsecase interface {
+	// Execute ищет сообщения в беседе или, при нулевом conversationID, во всех беседах пользователя.
+	// Беседы и смещение запроса заполняются usecase
+	Execute(ctx context.Context, userID, conversationID gocql.UUID, query *models.SearchQuery, pageToken string) (*models.SearchPage, error)
+}
+
+type searchMessagesUsecase struct {
+	index            search.Index
+	messageRepo      repositories.MessageRepository
+	conversationRepo repositories.ConversationRepository
+	inboxRepo        repositories.InboxRepository
+}
+
+func NewSearchMessagesUsecase(
+	index search.Index,
+	messageRepo repositories.MessageRepository,
+	conversationRepo repositories.ConversationRepository,
+	inboxRepo repositories.InboxRepository,
+) SearchMessagesUsecase {
+	return &searchMessagesUsecase{
+		index:            index,
+		messageRepo:      messageRepo,
+		conversationRepo: conversationRepo,
+		inboxRepo:        inboxRepo,
+	}
+}
+
+func (uc *searchMessagesUsecase) Execute(ctx context.Context, userID, conversationID gocql.UUID, query *models.SearchQuery, pageToken string) (*models.SearchPage, error) {
+	if strings.Trim(query.Text, "\" \t\n") == "" {
+		return nil, ErrEmptyQuery
+	}
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	q := *query
+	q.Offset = offset
+	if q.Limit <= 0 {
+		q.Limit = defaultPageSize
+	}
+	if q.Limit > maxPageSize {
+		q.Limit = maxPageSize
+	}
+	if q.Offset+q.Limit > maxResults {
+		q.Limit = maxResults - q.Offset
+	}
+	q.ConversationIDs, err = uc.conversationIDs(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.SearchPage{}
+	if q.Limit <= 0 {
+		return page, nil
+	}
+	result, err := uc.index.Search(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	page.Matches, err = uc.loadMatches(ctx, userID, result.Hits)
+	if err != nil {
+		return nil, err
+	}
+
+	next := q.Offset + len(result.Hits)
+	if next < result.Total && next < maxResults {
+		page.HasMore = true
+		page.NextToken = encodePageToken(next)
+	}
+	return page, nil
+}
+
+// conversationIDs возвращает беседы, по которым пользователь может искать
+func (uc *searchMessagesUsecase) conversationIDs(ctx context.Context, userID, conversationID gocql.UUID) ([]gocql.UUID, error) {
+	if conversationID == (gocql.UUID{}) {
+		return uc.inboxRepo.GetConversationIDs(ctx, userID)
+	}
+	member, err := uc.conversationRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, conversation.ErrNotConversationMember
+	}
+	return []gocql.UUID{conversationID}, nil
+}
+
+// loadMatches читает найденные сообщения из Cassandra. Индекс может отставать,
+// поэтому удаленные и скрытые пользователем сообщения отбрасываются здесь
+func (uc *searchMessagesUsecase) loadMatches(ctx context.Context, userID gocql.UUID, hits []*models.SearchHit) ([]*models.SearchMatch, error) {
+	matches := make([]*models.SearchMatch, 0, len(hits))
+	byConversation := make(map[gocql.UUID][]gocql.UUID)
+	for _, hit := range hits {
+		message, err := uc.messageRepo.GetMessageByID(ctx, hit.MessageID)
+		if err != nil {
+			return nil, err
+		}
+		if message == nil || message.Deleted {
+			continue
+		}
+		matches = append(matches, &models.SearchMatch{Message: message, Highlights: hit.Highlights})
+		byConversation[message.ConversationID] = append(byConversation[message.ConversationID], message.MessageID)
+	}
+
+	hidden := make(map[gocql.UUID]bool)
+	for conversationID, messageIDs := range byConversation {
+		ids, err := uc.messageRepo.GetHiddenMessageIDs(ctx, userID, conversationID, messageIDs)
+		if err != nil {
+			return nil, err
+		}
+		for id := range ids {
+			hidden[id] = true
+		}
+	}
+	if len(hidden) == 0 {
+		return matches, nil
+	}
+
+	visible := matches[:0]
+	for _, match := range matches {
+		if !hidden[match.Message.MessageID] {
+			visible = append(visible, match)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexMessageEventUsecaseExecuteIndexesCurrentContent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage(gocql.TimeUUID(), "hello")
+	event, err := events.NewMessageSentEvent(msg, nil, nil)
+	require.NoError(t, err)
+	// Событие отправки пришло уже после правки: в индекс попадает текущий текст
+	edited := *msg
+	edited.Content = "hello, world"
+
+	mockIndex := new(mocks.Index)
+	mockMessages := new(mocks.MessageRepository)
+	mockMessages.On("GetMessageByID", ctx, msg.MessageID).Return(&edited, nil)
+	mockIndex.On("Index", ctx, mock.MatchedBy(func(doc *models.SearchDocument) bool {
+		return doc.MessageID == msg.MessageID &&
+			doc.ConversationID == msg.ConversationID &&
+			doc.SenderID == msg.SenderID &&
+			doc.Content == "hello, world"
+	})).Return(nil)
+
+	usecase := search.NewIndexMessageEventUsecase(mockIndex, mockMessages)
+	require.NoError(t, usecase.Execute(ctx, event.Topic, event.Payload))
+	mockIndex.AssertExpectations(t)
+}
+
+func TestIndexMessageEventUsecaseExecuteRemovesDeleted(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage(gocql.TimeUUID(), "hello")
+	event, err := events.NewMessageDeletedEvent(msg)
+	require.NoError(t, err)
+	msg.Content = ""
+	msg.Deleted = true
+
+	mockIndex := new(mocks.Index)
+	mockMessages := new(mocks.MessageRepository)
+	mockMessages.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil)
+	mockIndex.On("Delete", ctx, msg.MessageID).Return(nil)
+
+	usecase := search.NewIndexMessageEventUsecase(mockIndex, mockMessages)
+	require.NoError(t, usecase.Execute(ctx, event.Topic, event.Payload))
+	mockIndex.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+}
+
+func TestIndexMessageEventUsecaseExecuteUnknownTopic(t *testing.T) {
+	ctx := context.Background()
+	mockMessages := new(mocks.MessageRepository)
+
+	usecase := search.NewIndexMessageEventUsecase(new(mocks.Index), mockMessages)
+	err := usecase.Execute(ctx, events.TopicMessageStatusChanged, nil)
+
+	assert.Error(t, err)
+	mockMessages.AssertNotCalled(t, "GetMessageByID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/conversation"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/search/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMessage(conversationID gocql.UUID, content string) *models.Message {
+	return &models.Message{
+		MessageID:      gocql.TimeUUID(),
+		ConversationID: conversationID,
+		SenderID:       gocql.TimeUUID(),
+		Content:        content,
+		Timestamp:      time.Now(),
+		Status:         models.StatusSent,
+	}
+}
+
+func newHit(msg *models.Message) *models.SearchHit {
+	return &models.SearchHit{
+		MessageID:      msg.MessageID,
+		ConversationID: msg.ConversationID,
+		Highlights:     []string{"<mark>" + msg.Content + "</mark>"},
+	}
+}
+
+func TestSearchMessagesUsecaseExecuteAllConversations(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	first, second := gocql.TimeUUID(), gocql.TimeUUID()
+	found := newTestMessage(first, "hello")
+	deleted := newTestMessage(second, "")
+	deleted.Deleted = true
+	hidden := newTestMessage(second, "hello")
+
+	mockIndex := new(mocks.Index)
+	mockMessages := new(mocks.MessageRepository)
+	mockInbox := new(mocks.InboxRepository)
+	mockInbox.On("GetConversationIDs", ctx, userID).Return([]gocql.UUID{first, second}, nil)
+	mockIndex.On("Search", ctx, mock.MatchedBy(func(q *models.SearchQuery) bool {
+		return q.Text == "hello" && q.Limit == 20 && q.Offset == 0 && len(q.ConversationIDs) == 2
+	})).Return(&models.SearchResult{
+		Hits:  []*models.SearchHit{newHit(found), newHit(deleted), newHit(hidden)},
+		Total: 4,
+	}, nil)
+	mockMessages.On("GetMessageByID", ctx, found.MessageID).Return(found, nil)
+	mockMessages.On("GetMessageByID", ctx, deleted.MessageID).Return(deleted, nil)
+	mockMessages.On("GetMessageByID", ctx, hidden.MessageID).Return(hidden, nil)
+	mockMessages.On("GetHiddenMessageIDs", ctx, userID, first, []gocql.UUID{found.MessageID}).Return(map[gocql.UUID]bool{}, nil)
+	mockMessages.On("GetHiddenMessageIDs", ctx, userID, second, []gocql.UUID{hidden.MessageID}).Return(map[gocql.UUID]bool{hidden.MessageID: true}, nil)
+
+	usecase := search.NewSearchMessagesUsecase(mockIndex, mockMessages, new(mocks.ConversationRepository), mockInbox)
+	page, err := usecase.Execute(ctx, userID, gocql.UUID{}, &models.SearchQuery{Text: "hello"}, "")
+
+	require.NoError(t, err)
+	require.Len(t, page.Matches, 1)
+	assert.Equal(t, found.MessageID, page.Matches[0].Message.MessageID)
+	assert.Equal(t, []string{"<mark>hello</mark>"}, page.Matches[0].Highlights)
+	assert.True(t, page.HasMore)
+	assert.NotEmpty(t, page.NextToken)
+
+	// Следующая страница начинается после всех найденных индексом сообщений, даже отброшенных
+	last := newTestMessage(first, "hello")
+	mockIndex.On("Search", ctx, mock.MatchedBy(func(q *models.SearchQuery) bool {
+		return q.Offset == 3
+	})).Return(&models.SearchResult{Hits: []*models.SearchHit{newHit(last)}, Total: 4}, nil)
+	mockMessages.On("GetMessageByID", ctx, last.MessageID).Return(last, nil)
+	mockMessages.On("GetHiddenMessageIDs", ctx, userID, first, []gocql.UUID{last.MessageID}).Return(map[gocql.UUID]bool{}, nil)
+	page, err = usecase.Execute(ctx, userID, gocql.UUID{}, &models.SearchQuery{Text: "hello"}, page.NextToken)
+
+	require.NoError(t, err)
+	require.Len(t, page.Matches, 1)
+	assert.Equal(t, last.MessageID, page.Matches[0].Message.MessageID)
+	assert.False(t, page.HasMore)
+	assert.Empty(t, page.NextToken)
+}
+
+func TestSearchMessagesUsecaseExecuteConversation(t *testing.T) {
+	ctx := context.Background()
+	userID, conversationID, senderID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockIndex := new(mocks.Index)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(&models.ConversationMember{
+		ConversationID: conversationID,
+		UserID:         userID,
+	}, nil)
+	mockIndex.On("Search", ctx, mock.MatchedBy(func(q *models.SearchQuery) bool {
+		return q.SenderID == senderID && q.Limit == 50 &&
+			len(q.ConversationIDs) == 1 && q.ConversationIDs[0] == conversationID
+	})).Return(&models.SearchResult{}, nil)
+
+	usecase := search.NewSearchMessagesUsecase(mockIndex, new(mocks.MessageRepository), mockConvRepo, new(mocks.InboxRepository))
+	page, err := usecase.Execute(ctx, userID, conversationID, &models.SearchQuery{
+		Text:     `"release notes"`,
+		SenderID: senderID,
+		Limit:    100,
+	}, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Matches)
+	assert.False(t, page.HasMore)
+	mockIndex.AssertExpectations(t)
+}
+
+func TestSearchMessagesUsecaseExecuteNotMember(t *testing.T) {
+	ctx := context.Background()
+	userID, conversationID := gocql.TimeUUID(), gocql.TimeUUID()
+
+	mockIndex := new(mocks.Index)
+	mockConvRepo := new(mocks.ConversationRepository)
+	mockConvRepo.On("GetMember", ctx, conversationID, userID).Return(nil, nil)
+
+	usecase := search.NewSearchMessagesUsecase(mockIndex, new(mocks.MessageRepository), mockConvRepo, new(mocks.InboxRepository))
+	page, err := usecase.Execute(ctx, userID, conversationID, &models.SearchQuery{Text: "hello"}, "")
+
+	assert.ErrorIs(t, err, conversation.ErrNotConversationMember)
+	assert.Nil(t, page)
+	mockIndex.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+}
+
+func TestSearchMessagesUsecaseExecuteInvalidInput(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+
+	usecase := search.NewSearchMessagesUsecase(new(mocks.Index), new(mocks.MessageRepository), new(mocks.ConversationRepository), new(mocks.InboxRepository))
+
+	_, err := usecase.Execute(ctx, userID, gocql.UUID{}, &models.SearchQuery{Text: ` "" `}, "")
+	assert.ErrorIs(t, err, search.ErrEmptyQuery)
+
+	_, err = usecase.Execute(ctx, userID, gocql.UUID{}, &models.SearchQuery{Text: "hello"}, "not a token")
+	assert.ErrorIs(t, err, search.ErrInvalidPageToken)
+}
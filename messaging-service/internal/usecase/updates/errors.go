@@ -0,0 +1,8 @@
+package updates
+
+import "errors"
+
+var (
+	// ErrMalformedEvent — событие не удается разобрать, повторная запись не поможет
+	ErrMalformedEvent = errors.New("malformed sync event")
+)
@@ -0,0 +1,127 @@
+package updates
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+)
+
+const (
+	// Размер страницы изменений, если клиент его не указал
+	defaultUpdatesPageSize = 100
+	// Максимальный размер страницы изменений
+	maxUpdatesPageSize = 500
+)
+
+type GetUpdatesUsecase interface {
+	// Execute возвращает изменения пользователя с номерами больше sinceSeq
+	Execute(ctx context.Context, userID gocql.UUID, sinceSeq int64, limit int) (*models.SyncUpdates, error)
+}
+
+type getUpdatesUsecase struct {
+	syncLogRepo repositories.SyncLogRepository
+	messageRepo repositories.MessageRepository
+}
+
+func NewGetUpdatesUsecase(
+	syncLogRepo repositories.SyncLogRepository,
+	messageRepo repositories.MessageRepository,
+) GetUpdatesUsecase {
+	return &getUpdatesUsecase{
+		syncLogRepo: syncLogRepo,
+		messageRepo: messageRepo,
+	}
+}
+
+func (uc *getUpdatesUsecase) Execute(ctx context.Context, userID gocql.UUID, sinceSeq int64, limit int) (*models.SyncUpdates, error) {
+	if limit <= 0 {
+		limit = defaultUpdatesPageSize
+	}
+	if limit > maxUpdatesPageSize {
+		limit = maxUpdatesPageSize
+	}
+
+	latestSeq, err := uc.syncLogRepo.GetLatestSeq(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	updates := &models.SyncUpdates{LatestSeq: latestSeq}
+	if sinceSeq >= latestSeq {
+		// Номер клиента впереди журнала, только если журнал пользователя был утерян
+		updates.ResyncRequired = sinceSeq > latestSeq
+		return updates, nil
+	}
+
+	// Читается и последнее известное клиенту изменение: номера идут без пропусков и
+	// удаляются от старых к новым, поэтому пока оно в журнале, более поздние тоже на месте
+	fromSeq := sinceSeq
+	if fromSeq == 0 {
+		fromSeq = 1
+	}
+	changes, err := uc.syncLogRepo.GetChanges(ctx, userID, fromSeq, limit+2)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 || changes[0].Seq != fromSeq {
+		updates.ResyncRequired = true
+		return updates, nil
+	}
+	if sinceSeq > 0 {
+		changes = changes[1:]
+	}
+	// Лишнее изменение показывает, есть ли следующая страница
+	if len(changes) > limit {
+		changes = changes[:limit]
+		updates.HasMore = true
+	}
+
+	if err := uc.attachMessages(ctx, userID, changes); err != nil {
+		return nil, err
+	}
+	updates.Changes = changes
+	return updates, nil
+}
+
+// attachMessages добавляет текущее состояние новых и измененных сообщений.
+// Удаленные, исчезнувшие и скрытые пользователем сообщения не добавляются
+func (uc *getUpdatesUsecase) attachMessages(ctx context.Context, userID gocql.UUID, changes []*models.SyncChange) error {
+	messages := make(map[gocql.UUID]*models.Message)
+	byConversation := make(map[gocql.UUID][]gocql.UUID)
+	for _, change := range changes {
+		if !change.Type.HasMessage() {
+			continue
+		}
+		if _, ok := messages[change.MessageID]; ok {
+			continue
+		}
+		message, err := uc.messageRepo.GetMessageByID(ctx, change.MessageID)
+		if err != nil {
+			return err
+		}
+		if message == nil || message.Deleted || message.ConversationID != change.ConversationID {
+			message = nil
+		} else {
+			byConversation[message.ConversationID] = append(byConversation[message.ConversationID], message.MessageID)
+		}
+		messages[change.MessageID] = message
+	}
+
+	for conversationID, messageIDs := range byConversation {
+		hidden, err := uc.messageRepo.GetHiddenMessageIDs(ctx, userID, conversationID, messageIDs)
+		if err != nil {
+			return err
+		}
+		for messageID := range hidden {
+			messages[messageID] = nil
+		}
+	}
+
+	for _, change := range changes {
+		if change.Type.HasMessage() {
+			change.Message = messages[change.MessageID]
+		}
+	}
+	return nil
+}
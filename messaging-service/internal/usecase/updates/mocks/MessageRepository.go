@@ -0,0 +1,412 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// MessageRepository is an autogenerated mock type for the MessageRepository type
+type MessageRepository struct {
+	mock.Mock
+}
+
+// ConfirmClientMessageID provides a mock function with given fields: ctx, senderID, clientMessageID, messageID, ttl
+func (_m *MessageRepository) ConfirmClientMessageID(ctx context.Context, senderID gocql.UUID, clientMessageID gocql.UUID, messageID gocql.UUID, ttl time.Duration) error {
+	ret := _m.Called(ctx, senderID, clientMessageID, messageID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmClientMessageID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, time.Duration) error); ok {
+		r0 = rf(ctx, senderID, clientMessageID, messageID, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountUnread provides a mock function with given fields: ctx, conversationID, userID, lastReadID, limit
+func (_m *MessageRepository) CountUnread(ctx context.Context, conversationID gocql.UUID, userID gocql.UUID, lastReadID gocql.UUID, limit int) (int, error) {
+	ret := _m.Called(ctx, conversationID, userID, lastReadID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) (int, error)); ok {
+		return rf(ctx, conversationID, userID, lastReadID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) int); ok {
+		r0 = rf(ctx, conversationID, userID, lastReadID, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) error); ok {
+		r1 = rf(ctx, conversationID, userID, lastReadID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMessage provides a mock function with given fields: ctx, message, event
+func (_m *MessageRepository) DeleteMessage(ctx context.Context, message *models.Message, event *models.OutboxEvent) error {
+	ret := _m.Called(ctx, message, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Message, *models.OutboxEvent) error); ok {
+		r0 = rf(ctx, message, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditMessage provides a mock function with given fields: ctx, message, previous, event
+func (_m *MessageRepository) EditMessage(ctx context.Context, message *models.Message, previous *models.MessageEdit, event *models.OutboxEvent) error {
+	ret := _m.Called(ctx, message, previous, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Message, *models.MessageEdit, *models.OutboxEvent) error); ok {
+		r0 = rf(ctx, message, previous, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetHiddenMessageIDs provides a mock function with given fields: ctx, userID, conversationID, messageIDs
+func (_m *MessageRepository) GetHiddenMessageIDs(ctx context.Context, userID gocql.UUID, conversationID gocql.UUID, messageIDs []gocql.UUID) (map[gocql.UUID]bool, error) {
+	ret := _m.Called(ctx, userID, conversationID, messageIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHiddenMessageIDs")
+	}
+
+	var r0 map[gocql.UUID]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, []gocql.UUID) (map[gocql.UUID]bool, error)); ok {
+		return rf(ctx, userID, conversationID, messageIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, []gocql.UUID) map[gocql.UUID]bool); ok {
+		r0 = rf(ctx, userID, conversationID, messageIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, []gocql.UUID) error); ok {
+		r1 = rf(ctx, userID, conversationID, messageIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessageByID provides a mock function with given fields: ctx, messageID
+func (_m *MessageRepository) GetMessageByID(ctx context.Context, messageID gocql.UUID) (*models.Message, error) {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageByID")
+	}
+
+	var r0 *models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (*models.Message, error)); ok {
+		return rf(ctx, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) *models.Message); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessageEdits provides a mock function with given fields: ctx, messageID
+func (_m *MessageRepository) GetMessageEdits(ctx context.Context, messageID gocql.UUID) ([]*models.MessageEdit, error) {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageEdits")
+	}
+
+	var r0 []*models.MessageEdit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) ([]*models.MessageEdit, error)); ok {
+		return rf(ctx, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) []*models.MessageEdit); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.MessageEdit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessages provides a mock function with given fields: ctx, conversationID, page
+func (_m *MessageRepository) GetMessages(ctx context.Context, conversationID gocql.UUID, page models.PageQuery) ([]*models.Message, error) {
+	ret := _m.Called(ctx, conversationID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessages")
+	}
+
+	var r0 []*models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, models.PageQuery) ([]*models.Message, error)); ok {
+		return rf(ctx, conversationID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, models.PageQuery) []*models.Message); ok {
+		r0 = rf(ctx, conversationID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, models.PageQuery) error); ok {
+		r1 = rf(ctx, conversationID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplies provides a mock function with given fields: ctx, conversationID, rootID, cursor, limit
+func (_m *MessageRepository) GetReplies(ctx context.Context, conversationID gocql.UUID, rootID gocql.UUID, cursor gocql.UUID, limit int) ([]*models.Message, error) {
+	ret := _m.Called(ctx, conversationID, rootID, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplies")
+	}
+
+	var r0 []*models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) ([]*models.Message, error)); ok {
+		return rf(ctx, conversationID, rootID, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) []*models.Message); ok {
+		r0 = rf(ctx, conversationID, rootID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, int) error); ok {
+		r1 = rf(ctx, conversationID, rootID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplyCounts provides a mock function with given fields: ctx, rootIDs
+func (_m *MessageRepository) GetReplyCounts(ctx context.Context, rootIDs []gocql.UUID) (map[gocql.UUID]int, error) {
+	ret := _m.Called(ctx, rootIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplyCounts")
+	}
+
+	var r0 map[gocql.UUID]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) (map[gocql.UUID]int, error)); ok {
+		return rf(ctx, rootIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []gocql.UUID) map[gocql.UUID]int); ok {
+		r0 = rf(ctx, rootIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[gocql.UUID]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []gocql.UUID) error); ok {
+		r1 = rf(ctx, rootIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HideMessage provides a mock function with given fields: ctx, userID, message
+func (_m *MessageRepository) HideMessage(ctx context.Context, userID gocql.UUID, message *models.Message) error {
+	ret := _m.Called(ctx, userID, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HideMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, *models.Message) error); ok {
+		r0 = rf(ctx, userID, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseClientMessageID provides a mock function with given fields: ctx, senderID, clientMessageID, messageID
+func (_m *MessageRepository) ReleaseClientMessageID(ctx context.Context, senderID gocql.UUID, clientMessageID gocql.UUID, messageID gocql.UUID) error {
+	ret := _m.Called(ctx, senderID, clientMessageID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseClientMessageID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID) error); ok {
+		r0 = rf(ctx, senderID, clientMessageID, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveClientMessageID provides a mock function with given fields: ctx, senderID, clientMessageID, messageID, ttl
+func (_m *MessageRepository) ReserveClientMessageID(ctx context.Context, senderID gocql.UUID, clientMessageID gocql.UUID, messageID gocql.UUID, ttl time.Duration) (gocql.UUID, bool, error) {
+	ret := _m.Called(ctx, senderID, clientMessageID, messageID, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveClientMessageID")
+	}
+
+	var r0 gocql.UUID
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, time.Duration) (gocql.UUID, bool, error)); ok {
+		return rf(ctx, senderID, clientMessageID, messageID, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, time.Duration) gocql.UUID); ok {
+		r0 = rf(ctx, senderID, clientMessageID, messageID, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gocql.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, time.Duration) bool); ok {
+		r1 = rf(ctx, senderID, clientMessageID, messageID, ttl)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, gocql.UUID, gocql.UUID, gocql.UUID, time.Duration) error); ok {
+		r2 = rf(ctx, senderID, clientMessageID, messageID, ttl)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SaveMessage provides a mock function with given fields: ctx, message, events
+func (_m *MessageRepository) SaveMessage(ctx context.Context, message *models.Message, events ...*models.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, message)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Message, ...*models.OutboxEvent) error); ok {
+		r0 = rf(ctx, message, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMessageStatus provides a mock function with given fields: ctx, message, status, event
+func (_m *MessageRepository) UpdateMessageStatus(ctx context.Context, message *models.Message, status models.MessageStatus, event *models.OutboxEvent) error {
+	ret := _m.Called(ctx, message, status, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMessageStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Message, models.MessageStatus, *models.OutboxEvent) error); ok {
+		r0 = rf(ctx, message, status, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMessageRepository creates a new instance of MessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMessageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MessageRepository {
+	mock := &MessageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
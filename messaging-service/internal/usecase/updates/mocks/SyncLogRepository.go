@@ -0,0 +1,120 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gocql "github.com/gocql/gocql"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+
+	time "time"
+)
+
+// SyncLogRepository is an autogenerated mock type for the SyncLogRepository type
+type SyncLogRepository struct {
+	mock.Mock
+}
+
+// Append provides a mock function with given fields: ctx, change, retention
+func (_m *SyncLogRepository) Append(ctx context.Context, change *models.SyncChange, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, change, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SyncChange, time.Duration) (int64, error)); ok {
+		return rf(ctx, change, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SyncChange, time.Duration) int64); ok {
+		r0 = rf(ctx, change, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SyncChange, time.Duration) error); ok {
+		r1 = rf(ctx, change, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChanges provides a mock function with given fields: ctx, userID, fromSeq, limit
+func (_m *SyncLogRepository) GetChanges(ctx context.Context, userID gocql.UUID, fromSeq int64, limit int) ([]*models.SyncChange, error) {
+	ret := _m.Called(ctx, userID, fromSeq, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChanges")
+	}
+
+	var r0 []*models.SyncChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, int64, int) ([]*models.SyncChange, error)); ok {
+		return rf(ctx, userID, fromSeq, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID, int64, int) []*models.SyncChange); ok {
+		r0 = rf(ctx, userID, fromSeq, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SyncChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID, int64, int) error); ok {
+		r1 = rf(ctx, userID, fromSeq, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestSeq provides a mock function with given fields: ctx, userID
+func (_m *SyncLogRepository) GetLatestSeq(ctx context.Context, userID gocql.UUID) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSeq")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gocql.UUID) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gocql.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSyncLogRepository creates a new instance of SyncLogRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSyncLogRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SyncLogRepository {
+	mock := &SyncLogRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,238 @@
+package updates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/repositories"
+	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// SyncLogRetention — сколько изменения хранятся в журнале синхронизации.
+// Клиент, отставший сильнее, загружает беседы заново
+const SyncLogRetention = 30 * 24 * time.Hour
+
+// SyncedTopics — топики событий, из которых строятся журналы синхронизации пользователей
+var SyncedTopics = []string{
+	events.TopicMessageSent,
+	events.TopicMessageEdited,
+	events.TopicMessageDeleted,
+	events.TopicMessageStatusChanged,
+	events.TopicConversationMembersChanged,
+}
+
+// RecordSyncEventUsecase записывает событие из Kafka в журналы синхронизации участников беседы
+type RecordSyncEventUsecase interface {
+	Execute(ctx context.Context, topic string, payload []byte) error
+}
+
+type recordSyncEventUsecase struct {
+	syncLogRepo      repositories.SyncLogRepository
+	conversationRepo repositories.ConversationRepository
+}
+
+func NewRecordSyncEventUsecase(
+	syncLogRepo repositories.SyncLogRepository,
+	conversationRepo repositories.ConversationRepository,
+) RecordSyncEventUsecase {
+	return &recordSyncEventUsecase{
+		syncLogRepo:      syncLogRepo,
+		conversationRepo: conversationRepo,
+	}
+}
+
+func (uc *recordSyncEventUsecase) Execute(ctx context.Context, topic string, payload []byte) error {
+	switch topic {
+	case events.TopicMessageSent:
+		var event pb.MessageSent
+		if err := decodeEvent(topic, payload, &event); err != nil {
+			return err
+		}
+		change, err := messageChange(models.SyncMessageCreated, event.GetMetadata(), event.GetConversationId(), event.GetMessageId())
+		if err != nil {
+			return err
+		}
+		// Новое сообщение попадает к участникам на момент отправки, включая отправителя для его других устройств
+		userIDs, err := parseUUIDs(append([]string{event.GetSenderId()}, event.GetRecipientIds()...))
+		if err != nil {
+			return err
+		}
+		return uc.append(ctx, userIDs, change)
+	case events.TopicMessageEdited:
+		var event pb.MessageEdited
+		if err := decodeEvent(topic, payload, &event); err != nil {
+			return err
+		}
+		return uc.appendMessageChange(ctx, models.SyncMessageEdited, event.GetMetadata(), event.GetConversationId(), event.GetMessageId())
+	case events.TopicMessageDeleted:
+		var event pb.MessageDeleted
+		if err := decodeEvent(topic, payload, &event); err != nil {
+			return err
+		}
+		return uc.appendMessageChange(ctx, models.SyncMessageDeleted, event.GetMetadata(), event.GetConversationId(), event.GetMessageId())
+	case events.TopicMessageStatusChanged:
+		var event pb.MessageStatusChanged
+		if err := decodeEvent(topic, payload, &event); err != nil {
+			return err
+		}
+		change, err := messageChange(models.SyncMessageStatusChanged, event.GetMetadata(), event.GetConversationId(), event.GetMessageId())
+		if err != nil {
+			return err
+		}
+		change.Status = models.MessageStatus(event.GetStatus())
+		return uc.appendToMembers(ctx, change)
+	case events.TopicConversationMembersChanged:
+		var event pb.ConversationMembersChanged
+		if err := decodeEvent(topic, payload, &event); err != nil {
+			return err
+		}
+		return uc.appendMembershipChange(ctx, &event)
+	default:
+		return fmt.Errorf("%w: unexpected topic %s", ErrMalformedEvent, topic)
+	}
+}
+
+func (uc *recordSyncEventUsecase) appendMessageChange(ctx context.Context, changeType models.SyncChangeType, metadata *pb.EventMetadata, conversationID, messageID string) error {
+	change, err := messageChange(changeType, metadata, conversationID, messageID)
+	if err != nil {
+		return err
+	}
+	return uc.appendToMembers(ctx, change)
+}
+
+// appendToMembers записывает изменение текущим участникам беседы
+func (uc *recordSyncEventUsecase) appendToMembers(ctx context.Context, change *models.SyncChange) error {
+	members, err := uc.conversationRepo.GetMembers(ctx, change.ConversationID)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]gocql.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	return uc.append(ctx, userIDs, change)
+}
+
+// appendMembershipChange записывает по изменению на каждого затронутого участника.
+// Изменения получают текущие участники и сами затронутые, в том числе удаленные из беседы
+func (uc *recordSyncEventUsecase) appendMembershipChange(ctx context.Context, event *pb.ConversationMembersChanged) error {
+	var changeType models.SyncChangeType
+	switch event.GetChange() {
+	case pb.MembershipChange_MEMBERSHIP_CHANGE_ADDED:
+		changeType = models.SyncMemberAdded
+	case pb.MembershipChange_MEMBERSHIP_CHANGE_REMOVED:
+		changeType = models.SyncMemberRemoved
+	case pb.MembershipChange_MEMBERSHIP_CHANGE_ROLE_CHANGED:
+		changeType = models.SyncMemberRoleChanged
+	default:
+		return fmt.Errorf("%w: unexpected membership change %s", ErrMalformedEvent, event.GetChange())
+	}
+
+	conversationID, err := gocql.ParseUUID(event.GetConversationId())
+	if err != nil {
+		return fmt.Errorf("%w: invalid conversation_id in membership event: %v", ErrMalformedEvent, err)
+	}
+	members, err := uc.conversationRepo.GetMembers(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	affectedIDs, err := parseUUIDs(affectedUserIDs(event.GetMembers()))
+	if err != nil {
+		return err
+	}
+	userIDs := make([]gocql.UUID, 0, len(members)+len(affectedIDs))
+	seen := make(map[gocql.UUID]bool, cap(userIDs))
+	for _, member := range members {
+		seen[member.UserID] = true
+		userIDs = append(userIDs, member.UserID)
+	}
+	for _, memberID := range affectedIDs {
+		if !seen[memberID] {
+			seen[memberID] = true
+			userIDs = append(userIDs, memberID)
+		}
+	}
+
+	var errs []error
+	for i, affected := range event.GetMembers() {
+		change := newChange(changeType, event.GetMetadata(), conversationID)
+		change.MemberID = affectedIDs[i]
+		change.Role = models.MemberRole(affected.GetRole())
+		errs = append(errs, uc.append(ctx, userIDs, change))
+	}
+	return errors.Join(errs...)
+}
+
+// append записывает изменение в журнал каждого пользователя.
+// Сбой одного журнала не мешает записи в остальные и возвращается вместе с другими ошибками
+func (uc *recordSyncEventUsecase) append(ctx context.Context, userIDs []gocql.UUID, change *models.SyncChange) error {
+	var errs []error
+	for _, userID := range userIDs {
+		userChange := *change
+		userChange.UserID = userID
+		if _, err := uc.syncLogRepo.Append(ctx, &userChange, SyncLogRetention); err != nil {
+			errs = append(errs, fmt.Errorf("failed to append sync change for %s: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func messageChange(changeType models.SyncChangeType, metadata *pb.EventMetadata, conversationID, messageID string) (*models.SyncChange, error) {
+	parsedConversationID, err := gocql.ParseUUID(conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid conversation_id in %s event: %v", ErrMalformedEvent, metadata.GetEventType(), err)
+	}
+	parsedMessageID, err := gocql.ParseUUID(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid message_id in %s event: %v", ErrMalformedEvent, metadata.GetEventType(), err)
+	}
+	change := newChange(changeType, metadata, parsedConversationID)
+	change.MessageID = parsedMessageID
+	return change, nil
+}
+
+func newChange(changeType models.SyncChangeType, metadata *pb.EventMetadata, conversationID gocql.UUID) *models.SyncChange {
+	change := &models.SyncChange{
+		Type:           changeType,
+		ConversationID: conversationID,
+		CreatedAt:      time.Unix(metadata.GetOccurredAt(), 0),
+	}
+	// Идентификатор события связывает запись журнала с событием outbox
+	if eventID, err := gocql.ParseUUID(metadata.GetEventId()); err == nil {
+		change.EventID = eventID
+	}
+	return change
+}
+
+func decodeEvent(topic string, payload []byte, event proto.Message) error {
+	if err := proto.Unmarshal(payload, event); err != nil {
+		return fmt.Errorf("%w: failed to decode %s event: %v", ErrMalformedEvent, topic, err)
+	}
+	return nil
+}
+
+func affectedUserIDs(members []*pb.ConversationMember) []string {
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.GetUserId()
+	}
+	return userIDs
+}
+
+func parseUUIDs(values []string) ([]gocql.UUID, error) {
+	ids := make([]gocql.UUID, len(values))
+	for i, value := range values {
+		id, err := gocql.ParseUUID(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid user id %q: %v", ErrMalformedEvent, value, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
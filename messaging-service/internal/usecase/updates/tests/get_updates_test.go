@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChange(userID gocql.UUID, seq int64, changeType models.SyncChangeType, msg *models.Message) *models.SyncChange {
+	return &models.SyncChange{
+		UserID:         userID,
+		Seq:            seq,
+		Type:           changeType,
+		ConversationID: msg.ConversationID,
+		MessageID:      msg.MessageID,
+	}
+}
+
+func TestGetUpdatesUsecaseExecute(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	msg := newTestMessage(gocql.TimeUUID())
+	hidden := newTestMessage(msg.ConversationID)
+	removed := newTestMessage(msg.ConversationID)
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockMessages := new(mocks.MessageRepository)
+	mockSyncLog.On("GetLatestSeq", ctx, userID).Return(int64(9), nil)
+	// Первая запись — последнее известное клиенту изменение, последняя — признак следующей страницы
+	mockSyncLog.On("GetChanges", ctx, userID, int64(4), 5).Return([]*models.SyncChange{
+		newTestChange(userID, 4, models.SyncMessageCreated, msg),
+		newTestChange(userID, 5, models.SyncMessageCreated, msg),
+		newTestChange(userID, 6, models.SyncMessageCreated, hidden),
+		newTestChange(userID, 7, models.SyncMessageDeleted, removed),
+		newTestChange(userID, 8, models.SyncMessageEdited, msg),
+	}, nil)
+	mockMessages.On("GetMessageByID", ctx, msg.MessageID).Return(msg, nil).Once()
+	mockMessages.On("GetMessageByID", ctx, hidden.MessageID).Return(hidden, nil).Once()
+	mockMessages.On("GetHiddenMessageIDs", ctx, userID, msg.ConversationID, []gocql.UUID{msg.MessageID, hidden.MessageID}).
+		Return(map[gocql.UUID]bool{hidden.MessageID: true}, nil)
+
+	usecase := updates.NewGetUpdatesUsecase(mockSyncLog, mockMessages)
+	result, err := usecase.Execute(ctx, userID, 4, 3)
+
+	require.NoError(t, err)
+	assert.False(t, result.ResyncRequired)
+	assert.True(t, result.HasMore)
+	assert.Equal(t, int64(9), result.LatestSeq)
+	require.Len(t, result.Changes, 3)
+	assert.Equal(t, []int64{5, 6, 7}, []int64{result.Changes[0].Seq, result.Changes[1].Seq, result.Changes[2].Seq})
+	assert.Equal(t, msg, result.Changes[0].Message)
+	assert.Nil(t, result.Changes[1].Message)
+	assert.Nil(t, result.Changes[2].Message)
+	mockMessages.AssertNotCalled(t, "GetMessageByID", ctx, removed.MessageID)
+}
+
+func TestGetUpdatesUsecaseExecuteUpToDate(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockMessages := new(mocks.MessageRepository)
+	mockSyncLog.On("GetLatestSeq", ctx, userID).Return(int64(12), nil)
+
+	usecase := updates.NewGetUpdatesUsecase(mockSyncLog, mockMessages)
+	result, err := usecase.Execute(ctx, userID, 12, 0)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.False(t, result.ResyncRequired)
+	assert.False(t, result.HasMore)
+	mockSyncLog.AssertNotCalled(t, "GetChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetUpdatesUsecaseExecuteCompactedLog(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+	msg := newTestMessage(gocql.TimeUUID())
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockMessages := new(mocks.MessageRepository)
+	mockSyncLog.On("GetLatestSeq", ctx, userID).Return(int64(300), nil)
+	// Изменения до 250 уже удалены из журнала
+	mockSyncLog.On("GetChanges", ctx, userID, int64(100), 102).Return([]*models.SyncChange{
+		newTestChange(userID, 250, models.SyncMessageCreated, msg),
+	}, nil)
+
+	usecase := updates.NewGetUpdatesUsecase(mockSyncLog, mockMessages)
+	result, err := usecase.Execute(ctx, userID, 100, 0)
+
+	require.NoError(t, err)
+	assert.True(t, result.ResyncRequired)
+	assert.Empty(t, result.Changes)
+	assert.Equal(t, int64(300), result.LatestSeq)
+	mockMessages.AssertNotCalled(t, "GetMessageByID", mock.Anything, mock.Anything)
+}
+
+func TestGetUpdatesUsecaseExecuteAheadOfLog(t *testing.T) {
+	ctx := context.Background()
+	userID := gocql.TimeUUID()
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockMessages := new(mocks.MessageRepository)
+	mockSyncLog.On("GetLatestSeq", ctx, userID).Return(int64(0), nil)
+
+	usecase := updates.NewGetUpdatesUsecase(mockSyncLog, mockMessages)
+	result, err := usecase.Execute(ctx, userID, 42, 10)
+
+	require.NoError(t, err)
+	assert.True(t, result.ResyncRequired)
+	assert.Empty(t, result.Changes)
+}
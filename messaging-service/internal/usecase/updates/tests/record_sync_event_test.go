@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/events"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates"
+	"github.com/malytinKonstantin/go-messenger-mono/messaging-service/internal/usecase/updates/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMessage(conversationID gocql.UUID) *models.Message {
+	return &models.Message{
+		MessageID:      gocql.TimeUUID(),
+		ConversationID: conversationID,
+		SenderID:       gocql.TimeUUID(),
+		Content:        "hello",
+		Timestamp:      time.Now(),
+		Status:         models.StatusSent,
+	}
+}
+
+func newTestMembers(conversationID gocql.UUID, userIDs ...gocql.UUID) []*models.ConversationMember {
+	members := make([]*models.ConversationMember, len(userIDs))
+	for i, userID := range userIDs {
+		members[i] = &models.ConversationMember{
+			ConversationID: conversationID,
+			UserID:         userID,
+			Role:           models.RoleMember,
+		}
+	}
+	return members
+}
+
+// expectAppend ожидает запись изменения в журнал пользователя
+func expectAppend(ctx context.Context, repo *mocks.SyncLogRepository, userID gocql.UUID, match func(*models.SyncChange) bool) {
+	repo.On("Append", ctx, mock.MatchedBy(func(change *models.SyncChange) bool {
+		return change.UserID == userID && match(change)
+	}), updates.SyncLogRetention).Return(int64(1), nil).Once()
+}
+
+func TestRecordSyncEventUsecaseExecuteMessageSent(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage(gocql.TimeUUID())
+	recipientID := gocql.TimeUUID()
+	event, err := events.NewMessageSentEvent(msg, []gocql.UUID{msg.SenderID, recipientID}, nil)
+	require.NoError(t, err)
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockConversations := new(mocks.ConversationRepository)
+	isCreated := func(change *models.SyncChange) bool {
+		return change.Type == models.SyncMessageCreated &&
+			change.ConversationID == msg.ConversationID &&
+			change.MessageID == msg.MessageID &&
+			change.EventID == event.EventID
+	}
+	// Отправитель тоже получает изменение для своих других устройств
+	expectAppend(ctx, mockSyncLog, msg.SenderID, isCreated)
+	expectAppend(ctx, mockSyncLog, recipientID, isCreated)
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, mockConversations)
+	require.NoError(t, usecase.Execute(ctx, event.Topic, event.Payload))
+	mockSyncLog.AssertExpectations(t)
+	mockConversations.AssertNotCalled(t, "GetMembers", mock.Anything, mock.Anything)
+}
+
+func TestRecordSyncEventUsecaseExecuteMessageEdited(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage(gocql.TimeUUID())
+	memberID := gocql.TimeUUID()
+	event, err := events.NewMessageEditedEvent(msg)
+	require.NoError(t, err)
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockConversations := new(mocks.ConversationRepository)
+	mockConversations.On("GetMembers", ctx, msg.ConversationID).
+		Return(newTestMembers(msg.ConversationID, msg.SenderID, memberID), nil)
+	isEdited := func(change *models.SyncChange) bool {
+		return change.Type == models.SyncMessageEdited && change.MessageID == msg.MessageID
+	}
+	expectAppend(ctx, mockSyncLog, msg.SenderID, isEdited)
+	expectAppend(ctx, mockSyncLog, memberID, isEdited)
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, mockConversations)
+	require.NoError(t, usecase.Execute(ctx, event.Topic, event.Payload))
+	mockSyncLog.AssertExpectations(t)
+}
+
+func TestRecordSyncEventUsecaseExecuteMemberRemoved(t *testing.T) {
+	ctx := context.Background()
+	conversationID, actorID, removedID := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+	removed := newTestMembers(conversationID, removedID)
+	event, err := events.NewConversationMembersChangedEvent(conversationID, actorID, models.MembershipRemoved, removed)
+	require.NoError(t, err)
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockConversations := new(mocks.ConversationRepository)
+	// Удаленного уже нет среди участников, но изменение он получает
+	mockConversations.On("GetMembers", ctx, conversationID).
+		Return(newTestMembers(conversationID, actorID), nil)
+	isRemoved := func(change *models.SyncChange) bool {
+		return change.Type == models.SyncMemberRemoved &&
+			change.ConversationID == conversationID &&
+			change.MemberID == removedID &&
+			change.Role == models.RoleMember
+	}
+	expectAppend(ctx, mockSyncLog, actorID, isRemoved)
+	expectAppend(ctx, mockSyncLog, removedID, isRemoved)
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, mockConversations)
+	require.NoError(t, usecase.Execute(ctx, event.Topic, event.Payload))
+	mockSyncLog.AssertExpectations(t)
+}
+
+func TestRecordSyncEventUsecaseExecuteUnknownTopic(t *testing.T) {
+	ctx := context.Background()
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockConversations := new(mocks.ConversationRepository)
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, mockConversations)
+	err := usecase.Execute(ctx, "messaging.unknown.v1", nil)
+
+	assert.ErrorIs(t, err, updates.ErrMalformedEvent)
+	mockSyncLog.AssertNotCalled(t, "Append", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecordSyncEventUsecaseExecuteMalformedPayload(t *testing.T) {
+	ctx := context.Background()
+	mockSyncLog := new(mocks.SyncLogRepository)
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, new(mocks.ConversationRepository))
+	err := usecase.Execute(ctx, events.TopicMessageSent, []byte("not a protobuf"))
+
+	assert.ErrorIs(t, err, updates.ErrMalformedEvent)
+	mockSyncLog.AssertNotCalled(t, "Append", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecordSyncEventUsecaseExecuteAppendError(t *testing.T) {
+	ctx := context.Background()
+	msg := newTestMessage(gocql.TimeUUID())
+	recipientID := gocql.TimeUUID()
+	event, err := events.NewMessageSentEvent(msg, []gocql.UUID{msg.SenderID, recipientID}, nil)
+	require.NoError(t, err)
+
+	mockSyncLog := new(mocks.SyncLogRepository)
+	mockSyncLog.On("Append", ctx, mock.MatchedBy(func(change *models.SyncChange) bool {
+		return change.UserID == msg.SenderID
+	}), updates.SyncLogRetention).Return(int64(0), assert.AnError).Once()
+	expectAppend(ctx, mockSyncLog, recipientID, func(change *models.SyncChange) bool { return true })
+
+	usecase := updates.NewRecordSyncEventUsecase(mockSyncLog, new(mocks.ConversationRepository))
+	err = usecase.Execute(ctx, event.Topic, event.Payload)
+
+	// Сбой хранилища не считается битым событием: событие записывается повторно, а не пропускается
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.NotErrorIs(t, err, updates.ErrMalformedEvent)
+	mockSyncLog.AssertExpectations(t)
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/malytinKonstantin/go-messenger-mono/notification-service/infrastructure/database"
 	"github.com/malytinKonstantin/go-messenger-mono/notification-service/infrastructure/queue"
 	"github.com/malytinKonstantin/go-messenger-mono/notification-service/infrastructure/server"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/cache"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/spf13/viper"
 )
 
@@ -47,7 +50,25 @@ func run() error {
 	}
 	defer producer.Close()
 
-	grpcServer, err := server.SetupGRPCServer(session, producer)
+	cache.InitRedis(viper.GetString("REDIS_HOST"), viper.GetString("REDIS_PASSWORD"), 0)
+
+	if err := cache.Ping(context.Background()); err != nil {
+		return fmt.Errorf("error connecting to Redis: %w", err)
+	}
+
+	broker := pubsub.NewRedisBroker(cache.GetRedisClient())
+
+	consumer, err := queue.CreateKafkaConsumer(server.NotifierConsumerGroup())
+	if err != nil {
+		return fmt.Errorf("error creating Kafka consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	notifierCtx, stopNotifier := context.WithCancel(context.Background())
+	defer stopNotifier()
+	go server.StartMessageNotifier(notifierCtx, consumer, server.SetupMessageNotifier(session, broker))
+
+	grpcServer, err := server.SetupGRPCServer(session, producer, broker)
 	if err != nil {
 		return fmt.Errorf("error setting up gRPC server: %w", err)
 	}
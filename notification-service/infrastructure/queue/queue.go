@@ -16,3 +16,18 @@ func CreateKafkaProducer() (*kafka.Producer, error) {
 	}
 	return producer, nil
 }
+
+// CreateKafkaConsumer создает потребителя с ручной фиксацией смещений.
+// Новая группа начинает с последних событий, чтобы не рассылать уведомления о старых сообщениях
+func CreateKafkaConsumer(groupID string) (*kafka.Consumer, error) {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  viper.GetString("KAFKA_BOOTSTRAP_SERVERS"),
+		"group.id":           groupID,
+		"auto.offset.reset":  "latest",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer: %v", err)
+	}
+	return consumer, nil
+}
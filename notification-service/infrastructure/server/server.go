@@ -1,12 +1,15 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/gocql/gocql"
@@ -16,18 +19,25 @@ import (
 	notification_uc "github.com/malytinKonstantin/go-messenger-mono/notification-service/internal/usecase/notification"
 	preferences_uc "github.com/malytinKonstantin/go-messenger-mono/notification-service/internal/usecase/preferences"
 	pb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/notification_service/v1"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
-func SetupGRPCServer(session *gocql.Session, producer *kafka.Producer) (*grpc.Server, error) {
+// Ожидание события из Kafka, после которого отправитель уведомлений проверяет отмену контекста
+const notifierPollTimeout = time.Second
+
+// Группа потребителей по умолчанию: реплики делят события, чтобы уведомление отправлялось один раз
+const defaultNotifierConsumerGroup = "notification-messages"
+
+func SetupGRPCServer(session *gocql.Session, producer *kafka.Producer, broker pubsub.Broker) (*grpc.Server, error) {
 	// Инициализация репозиториев
 	notificationRepo := repositories.NewNotificationRepository(session)
 	preferencesRepo := repositories.NewNotificationPreferencesRepository(session)
 
 	// Инициализация usecase
-	sendNotificationUsecase := notification_uc.NewSendNotificationUsecase(notificationRepo)
+	sendNotificationUsecase := notification_uc.NewSendNotificationUsecase(notificationRepo, broker)
 	getNotificationsUsecase := notification_uc.NewGetNotificationsUsecase(notificationRepo)
 	markAsReadUsecase := notification_uc.NewMarkNotificationAsReadUsecase(notificationRepo)
 	updatePreferencesUsecase := preferences_uc.NewUpdatePreferencesUsecase(preferencesRepo)
@@ -54,6 +64,49 @@ func SetupGRPCServer(session *gocql.Session, producer *kafka.Producer) (*grpc.Se
 	return grpcServer, nil
 }
 
+// SetupMessageNotifier создает отправку уведомлений по событиям сообщений
+func SetupMessageNotifier(session *gocql.Session, broker pubsub.Broker) notification_uc.NotifyMessageEventUsecase {
+	notificationRepo := repositories.NewNotificationRepository(session)
+	return notification_uc.NewNotifyMessageEventUsecase(notification_uc.NewSendNotificationUsecase(notificationRepo, broker))
+}
+
+// NotifierConsumerGroup возвращает группу потребителей событий сообщений
+func NotifierConsumerGroup() string {
+	if group := viper.GetString("NOTIFIER_CONSUMER_GROUP"); group != "" {
+		return group
+	}
+	return defaultNotifierConsumerGroup
+}
+
+// StartMessageNotifier отправляет уведомления по событиям сообщений до отмены контекста.
+// Смещение фиксируется и после ошибки, чтобы одно битое событие не останавливало рассылку
+func StartMessageNotifier(ctx context.Context, consumer *kafka.Consumer, notifier notification_uc.NotifyMessageEventUsecase) {
+	if err := consumer.SubscribeTopics(notification_uc.NotifiedTopics, nil); err != nil {
+		log.Printf("error subscribing message notifier: %v", err)
+		return
+	}
+
+	for ctx.Err() == nil {
+		msg, err := consumer.ReadMessage(notifierPollTimeout)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrTimedOut {
+				log.Printf("error reading message events: %v", err)
+			}
+			continue
+		}
+		if err := notifier.Execute(ctx, *msg.TopicPartition.Topic, msg.Value); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("error sending message notifications: %v", err)
+		}
+		if _, err := consumer.CommitMessage(msg); err != nil {
+			log.Printf("error committing message event offset: %v", err)
+		}
+	}
+}
+
 func SetupHTTPServer() *fiber.App {
 	app := fiber.New()
 	app.Get("/health", func(c *fiber.Ctx) error {
@@ -13,13 +13,14 @@ const (
 	NotificationTypeNewMessage    NotificationType = 1
 	NotificationTypeFriendRequest NotificationType = 2
 	NotificationTypeSystem        NotificationType = 3
+	NotificationTypeMention       NotificationType = 4
 )
 
 type Notification struct {
-	NotificationID gocql.UUID
-	UserID         gocql.UUID
-	Message        string
-	Type           NotificationType
-	CreatedAt      time.Time
-	IsRead         bool
+	NotificationID gocql.UUID       `json:"notification_id"`
+	UserID         gocql.UUID       `json:"user_id"`
+	Message        string           `json:"message"`
+	Type           NotificationType `json:"type"`
+	CreatedAt      time.Time        `json:"created_at"`
+	IsRead         bool             `json:"is_read"`
 }
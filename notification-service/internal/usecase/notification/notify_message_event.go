@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gocql/gocql"
+	"github.com/malytinKonstantin/go-messenger-mono/notification-service/internal/models"
+	messagingpb "github.com/malytinKonstantin/go-messenger-mono/proto/pkg/api/messaging_service/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Топики событий messaging-service, по которым отправляются уведомления
+const (
+	TopicMessageSent      = "messaging.message.sent.v1"
+	TopicMessageMentioned = "messaging.message.mentioned.v1"
+)
+
+// NotifiedTopics — топики, на которые подписан отправитель уведомлений о сообщениях
+var NotifiedTopics = []string{
+	TopicMessageSent,
+	TopicMessageMentioned,
+}
+
+// Текст уведомления о сообщении без текста, например зашифрованном или только с вложениями
+const (
+	defaultNewMessageText = "New message"
+	defaultMentionText    = "You were mentioned"
+)
+
+// NotifyMessageEventUsecase отправляет уведомления по событию сообщения из Kafka
+type NotifyMessageEventUsecase interface {
+	Execute(ctx context.Context, topic string, payload []byte) error
+}
+
+type notifyMessageEventUsecase struct {
+	sendNotification SendNotificationUsecase
+}
+
+func NewNotifyMessageEventUsecase(sendNotification SendNotificationUsecase) NotifyMessageEventUsecase {
+	return &notifyMessageEventUsecase{
+		sendNotification: sendNotification,
+	}
+}
+
+func (uc *notifyMessageEventUsecase) Execute(ctx context.Context, topic string, payload []byte) error {
+	switch topic {
+	case TopicMessageSent:
+		var event messagingpb.MessageSent
+		if err := proto.Unmarshal(payload, &event); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", topic, err)
+		}
+		return uc.notifyRecipients(ctx, &event)
+	case TopicMessageMentioned:
+		var event messagingpb.MessageMentioned
+		if err := proto.Unmarshal(payload, &event); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", topic, err)
+		}
+		return uc.notify(ctx, event.GetMentionedUserIds(), nil, notificationText(event.GetContent(), defaultMentionText), models.NotificationTypeMention)
+	default:
+		return fmt.Errorf("unexpected topic %s", topic)
+	}
+}
+
+// notifyRecipients уведомляет о новом сообщении получателей, не заглушивших беседу.
+// Упомянутые получают отдельное уведомление об упоминании, поэтому здесь пропускаются,
+// о системных сообщениях беседы уведомления не отправляются
+func (uc *notifyMessageEventUsecase) notifyRecipients(ctx context.Context, event *messagingpb.MessageSent) error {
+	if event.GetSystem() {
+		return nil
+	}
+	skipped := make(map[string]bool, len(event.GetMutedRecipientIds())+len(event.GetMentionedUserIds()))
+	for _, userID := range event.GetMutedRecipientIds() {
+		skipped[userID] = true
+	}
+	for _, userID := range event.GetMentionedUserIds() {
+		skipped[userID] = true
+	}
+	return uc.notify(ctx, event.GetRecipientIds(), skipped, notificationText(event.GetContent(), defaultNewMessageText), models.NotificationTypeNewMessage)
+}
+
+// notify отправляет уведомление каждому пользователю, кроме пропущенных.
+// Ошибка одного получателя не мешает уведомить остальных и возвращается последней
+func (uc *notifyMessageEventUsecase) notify(ctx context.Context, userIDs []string, skipped map[string]bool, text string, notifType models.NotificationType) error {
+	var lastErr error
+	for _, rawID := range userIDs {
+		if skipped[rawID] {
+			continue
+		}
+		userID, err := gocql.ParseUUID(rawID)
+		if err != nil {
+			log.Printf("invalid recipient id %q in message event: %v", rawID, err)
+			continue
+		}
+		if err := uc.sendNotification.Execute(ctx, userID, text, notifType); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func notificationText(content, fallback string) string {
+	if content == "" {
+		return fallback
+	}
+	return content
+}
@@ -2,24 +2,32 @@ package notification
 
 import (
 	"context"
+	"encoding/json"
+	"log"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/malytinKonstantin/go-messenger-mono/notification-service/internal/models"
 	"github.com/malytinKonstantin/go-messenger-mono/notification-service/internal/repositories"
+	"github.com/malytinKonstantin/go-messenger-mono/shared/pubsub"
 )
 
+// Тип события о новом уведомлении в персональном топике пользователя
+const EventNotificationCreated = "notification.created"
+
 type SendNotificationUsecase interface {
 	Execute(ctx context.Context, userID gocql.UUID, message string, notifType models.NotificationType) error
 }
 
 type sendNotificationUsecase struct {
 	notificationRepo repositories.NotificationRepository
+	broker           pubsub.Broker
 }
 
-func NewSendNotificationUsecase(notificationRepo repositories.NotificationRepository) SendNotificationUsecase {
+func NewSendNotificationUsecase(notificationRepo repositories.NotificationRepository, broker pubsub.Broker) SendNotificationUsecase {
 	return &sendNotificationUsecase{
 		notificationRepo: notificationRepo,
+		broker:           broker,
 	}
 }
 
@@ -33,5 +41,19 @@ func (uc *sendNotificationUsecase) Execute(ctx context.Context, userID gocql.UUI
 		IsRead:         false,
 	}
 
-	return uc.notificationRepo.CreateNotification(ctx, notification)
+	if err := uc.notificationRepo.CreateNotification(ctx, notification); err != nil {
+		return err
+	}
+
+	// Уведомление уже сохранено, ошибка доставки в реальном времени не критична
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		log.Printf("error encoding notification %s: %v", notification.NotificationID, err)
+		return nil
+	}
+	if _, err := uc.broker.Publish(ctx, pubsub.UserTopic(userID.String()), EventNotificationCreated, payload); err != nil {
+		log.Printf("error publishing notification %s: %v", notification.NotificationID, err)
+	}
+
+	return nil
 }
@@ -1890,3 +1890,719 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = FriendRequestValidationError{}
+
+// Validate checks the field values on BlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserRequestMultiError, or nil if none found.
+func (m *BlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = BlockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetBlockedUserId()); err != nil {
+		err = BlockUserRequestValidationError{
+			field:  "BlockedUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BlockUserRequest) _validateUuid(uuid string) error {
+	if matched := _friendship_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserRequestMultiError) AllErrors() []error { return m }
+
+// BlockUserRequestValidationError is the validation error returned by
+// BlockUserRequest.Validate if the designated constraints aren't met.
+type BlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserRequestValidationError) ErrorName() string { return "BlockUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserRequestValidationError{}
+
+// Validate checks the field values on BlockUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserResponseMultiError, or nil if none found.
+func (m *BlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return BlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserResponseMultiError) AllErrors() []error { return m }
+
+// BlockUserResponseValidationError is the validation error returned by
+// BlockUserResponse.Validate if the designated constraints aren't met.
+type BlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserResponseValidationError) ErrorName() string {
+	return "BlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserResponseValidationError{}
+
+// Validate checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserRequestMultiError, or nil if none found.
+func (m *UnblockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnblockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetBlockedUserId()); err != nil {
+		err = UnblockUserRequestValidationError{
+			field:  "BlockedUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnblockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnblockUserRequest) _validateUuid(uuid string) error {
+	if matched := _friendship_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnblockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnblockUserRequestValidationError is the validation error returned by
+// UnblockUserRequest.Validate if the designated constraints aren't met.
+type UnblockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserRequestValidationError) ErrorName() string {
+	return "UnblockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserRequestValidationError{}
+
+// Validate checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserResponseMultiError, or nil if none found.
+func (m *UnblockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UnblockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockUserResponseMultiError is an error wrapping multiple validation
+// errors returned by UnblockUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnblockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserResponseMultiError) AllErrors() []error { return m }
+
+// UnblockUserResponseValidationError is the validation error returned by
+// UnblockUserResponse.Validate if the designated constraints aren't met.
+type UnblockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserResponseValidationError) ErrorName() string {
+	return "UnblockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserResponseValidationError{}
+
+// Validate checks the field values on GetRelationshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelationshipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelationshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelationshipRequestMultiError, or nil if none found.
+func (m *GetRelationshipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelationshipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetRelationshipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetUserId()); err != nil {
+		err = GetRelationshipRequestValidationError{
+			field:  "TargetUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRelationshipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRelationshipRequest) _validateUuid(uuid string) error {
+	if matched := _friendship_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRelationshipRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRelationshipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelationshipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelationshipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelationshipRequestMultiError) AllErrors() []error { return m }
+
+// GetRelationshipRequestValidationError is the validation error returned by
+// GetRelationshipRequest.Validate if the designated constraints aren't met.
+type GetRelationshipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelationshipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelationshipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelationshipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelationshipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelationshipRequestValidationError) ErrorName() string {
+	return "GetRelationshipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelationshipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelationshipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelationshipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelationshipRequestValidationError{}
+
+// Validate checks the field values on GetRelationshipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelationshipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelationshipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelationshipResponseMultiError, or nil if none found.
+func (m *GetRelationshipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelationshipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Friends
+
+	// no validation rules for Blocked
+
+	// no validation rules for BlockedBy
+
+	if len(errors) > 0 {
+		return GetRelationshipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelationshipResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRelationshipResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelationshipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelationshipResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelationshipResponseMultiError) AllErrors() []error { return m }
+
+// GetRelationshipResponseValidationError is the validation error returned by
+// GetRelationshipResponse.Validate if the designated constraints aren't met.
+type GetRelationshipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelationshipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelationshipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelationshipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelationshipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelationshipResponseValidationError) ErrorName() string {
+	return "GetRelationshipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelationshipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelationshipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelationshipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelationshipResponseValidationError{}
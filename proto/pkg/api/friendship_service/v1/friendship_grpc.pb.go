@@ -25,6 +25,9 @@ const (
 	FriendshipService_RemoveFriend_FullMethodName        = "/api.friendship_service.v1.FriendshipService/RemoveFriend"
 	FriendshipService_GetFriendsList_FullMethodName      = "/api.friendship_service.v1.FriendshipService/GetFriendsList"
 	FriendshipService_GetPendingRequests_FullMethodName  = "/api.friendship_service.v1.FriendshipService/GetPendingRequests"
+	FriendshipService_BlockUser_FullMethodName           = "/api.friendship_service.v1.FriendshipService/BlockUser"
+	FriendshipService_UnblockUser_FullMethodName         = "/api.friendship_service.v1.FriendshipService/UnblockUser"
+	FriendshipService_GetRelationship_FullMethodName     = "/api.friendship_service.v1.FriendshipService/GetRelationship"
 )
 
 // FriendshipServiceClient is the client API for FriendshipService service.
@@ -45,6 +48,13 @@ type FriendshipServiceClient interface {
 	GetFriendsList(ctx context.Context, in *GetFriendsListRequest, opts ...grpc.CallOption) (*GetFriendsListResponse, error)
 	// Получение списка запросов на дружбу
 	GetPendingRequests(ctx context.Context, in *GetPendingRequestsRequest, opts ...grpc.CallOption) (*GetPendingRequestsResponse, error)
+	// Блокировка пользователя, дружба с ним прекращается
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	// Снятие блокировки пользователя
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	// Отношения пользователя с другим пользователем: дружба и блокировки в обе стороны.
+	// Только gRPC: вызывается messaging-service и не публикуется через шлюз
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error)
 }
 
 type friendshipServiceClient struct {
@@ -115,6 +125,36 @@ func (c *friendshipServiceClient) GetPendingRequests(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *friendshipServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, FriendshipService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendshipServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, FriendshipService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendshipServiceClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipResponse)
+	err := c.cc.Invoke(ctx, FriendshipService_GetRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendshipServiceServer is the server API for FriendshipService service.
 // All implementations must embed UnimplementedFriendshipServiceServer
 // for forward compatibility.
@@ -133,6 +173,13 @@ type FriendshipServiceServer interface {
 	GetFriendsList(context.Context, *GetFriendsListRequest) (*GetFriendsListResponse, error)
 	// Получение списка запросов на дружбу
 	GetPendingRequests(context.Context, *GetPendingRequestsRequest) (*GetPendingRequestsResponse, error)
+	// Блокировка пользователя, дружба с ним прекращается
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	// Снятие блокировки пользователя
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	// Отношения пользователя с другим пользователем: дружба и блокировки в обе стороны.
+	// Только gRPC: вызывается messaging-service и не публикуется через шлюз
+	GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error)
 	mustEmbedUnimplementedFriendshipServiceServer()
 }
 
@@ -161,6 +208,15 @@ func (UnimplementedFriendshipServiceServer) GetFriendsList(context.Context, *Get
 func (UnimplementedFriendshipServiceServer) GetPendingRequests(context.Context, *GetPendingRequestsRequest) (*GetPendingRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingRequests not implemented")
 }
+func (UnimplementedFriendshipServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedFriendshipServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedFriendshipServiceServer) GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
 func (UnimplementedFriendshipServiceServer) mustEmbedUnimplementedFriendshipServiceServer() {}
 func (UnimplementedFriendshipServiceServer) testEmbeddedByValue()                           {}
 
@@ -290,6 +346,60 @@ func _FriendshipService_GetPendingRequests_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendshipService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendshipServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendshipService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendshipServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendshipService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendshipServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendshipService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendshipServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendshipService_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendshipServiceServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendshipService_GetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendshipServiceServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendshipService_ServiceDesc is the grpc.ServiceDesc for FriendshipService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +431,18 @@ var FriendshipService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingRequests",
 			Handler:    _FriendshipService_GetPendingRequests_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _FriendshipService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _FriendshipService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _FriendshipService_GetRelationship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "friendship_service/v1/friendship.proto",
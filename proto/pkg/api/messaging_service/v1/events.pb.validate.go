@@ -0,0 +1,1017 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: messaging_service/v1/events.proto
+
+package messaging_service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EventMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventMetadataMultiError, or
+// nil if none found.
+func (m *EventMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for EventVersion
+
+	// no validation rules for OccurredAt
+
+	if len(errors) > 0 {
+		return EventMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMetadataMultiError is an error wrapping multiple validation errors
+// returned by EventMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type EventMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMetadataMultiError) AllErrors() []error { return m }
+
+// EventMetadataValidationError is the validation error returned by
+// EventMetadata.Validate if the designated constraints aren't met.
+type EventMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventMetadataValidationError) ErrorName() string { return "EventMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventMetadataValidationError{}
+
+// Validate checks the field values on MessageSent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageSent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageSent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageSentMultiError, or
+// nil if none found.
+func (m *MessageSent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageSent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageSentValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageSentValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageSentValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for Content
+
+	// no validation rules for ReplyToMessageId
+
+	// no validation rules for ThreadRootId
+
+	// no validation rules for AttachmentCount
+
+	// no validation rules for SentAt
+
+	// no validation rules for System
+
+	if len(errors) > 0 {
+		return MessageSentMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageSentMultiError is an error wrapping multiple validation errors
+// returned by MessageSent.ValidateAll() if the designated constraints aren't met.
+type MessageSentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageSentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageSentMultiError) AllErrors() []error { return m }
+
+// MessageSentValidationError is the validation error returned by
+// MessageSent.Validate if the designated constraints aren't met.
+type MessageSentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageSentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageSentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageSentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageSentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageSentValidationError) ErrorName() string { return "MessageSentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageSentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageSent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageSentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageSentValidationError{}
+
+// Validate checks the field values on MessageMentioned with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageMentioned) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageMentioned with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageMentionedMultiError, or nil if none found.
+func (m *MessageMentioned) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageMentioned) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageMentionedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageMentionedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageMentionedValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for Content
+
+	// no validation rules for SentAt
+
+	if len(errors) > 0 {
+		return MessageMentionedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageMentionedMultiError is an error wrapping multiple validation errors
+// returned by MessageMentioned.ValidateAll() if the designated constraints
+// aren't met.
+type MessageMentionedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageMentionedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageMentionedMultiError) AllErrors() []error { return m }
+
+// MessageMentionedValidationError is the validation error returned by
+// MessageMentioned.Validate if the designated constraints aren't met.
+type MessageMentionedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageMentionedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageMentionedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageMentionedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageMentionedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageMentionedValidationError) ErrorName() string { return "MessageMentionedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageMentionedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageMentioned.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageMentionedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageMentionedValidationError{}
+
+// Validate checks the field values on MessageStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MessageStatusChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageStatusChangedMultiError, or nil if none found.
+func (m *MessageStatusChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageStatusChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageStatusChangedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageStatusChangedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageStatusChangedValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return MessageStatusChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageStatusChangedMultiError is an error wrapping multiple validation
+// errors returned by MessageStatusChanged.ValidateAll() if the designated
+// constraints aren't met.
+type MessageStatusChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageStatusChangedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageStatusChangedMultiError) AllErrors() []error { return m }
+
+// MessageStatusChangedValidationError is the validation error returned by
+// MessageStatusChanged.Validate if the designated constraints aren't met.
+type MessageStatusChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageStatusChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageStatusChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageStatusChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageStatusChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageStatusChangedValidationError) ErrorName() string {
+	return "MessageStatusChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MessageStatusChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageStatusChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageStatusChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageStatusChangedValidationError{}
+
+// Validate checks the field values on MessageEdited with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageEdited) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEdited with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageEditedMultiError, or
+// nil if none found.
+func (m *MessageEdited) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEdited) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEditedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEditedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEditedValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for Content
+
+	// no validation rules for SentAt
+
+	// no validation rules for EditedAt
+
+	if len(errors) > 0 {
+		return MessageEditedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEditedMultiError is an error wrapping multiple validation errors
+// returned by MessageEdited.ValidateAll() if the designated constraints
+// aren't met.
+type MessageEditedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEditedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEditedMultiError) AllErrors() []error { return m }
+
+// MessageEditedValidationError is the validation error returned by
+// MessageEdited.Validate if the designated constraints aren't met.
+type MessageEditedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEditedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEditedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEditedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEditedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEditedValidationError) ErrorName() string { return "MessageEditedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEditedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEdited.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEditedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEditedValidationError{}
+
+// Validate checks the field values on MessageDeleted with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageDeleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageDeleted with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageDeletedMultiError,
+// or nil if none found.
+func (m *MessageDeleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageDeleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageDeletedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageDeletedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageDeletedValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	if len(errors) > 0 {
+		return MessageDeletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageDeletedMultiError is an error wrapping multiple validation errors
+// returned by MessageDeleted.ValidateAll() if the designated constraints
+// aren't met.
+type MessageDeletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageDeletedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageDeletedMultiError) AllErrors() []error { return m }
+
+// MessageDeletedValidationError is the validation error returned by
+// MessageDeleted.Validate if the designated constraints aren't met.
+type MessageDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageDeletedValidationError) ErrorName() string { return "MessageDeletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageDeletedValidationError{}
+
+// Validate checks the field values on ConversationMembersChanged with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationMembersChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationMembersChanged with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationMembersChangedMultiError, or nil if none found.
+func (m *ConversationMembersChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationMembersChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationMembersChangedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationMembersChangedValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationMembersChangedValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ConversationId
+
+	// no validation rules for ActorId
+
+	// no validation rules for Change
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConversationMembersChangedValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConversationMembersChangedValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConversationMembersChangedValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConversationMembersChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationMembersChangedMultiError is an error wrapping multiple
+// validation errors returned by ConversationMembersChanged.ValidateAll() if
+// the designated constraints aren't met.
+type ConversationMembersChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationMembersChangedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationMembersChangedMultiError) AllErrors() []error { return m }
+
+// ConversationMembersChangedValidationError is the validation error returned
+// by ConversationMembersChanged.Validate if the designated constraints aren't met.
+type ConversationMembersChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationMembersChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationMembersChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationMembersChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationMembersChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationMembersChangedValidationError) ErrorName() string {
+	return "ConversationMembersChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationMembersChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationMembersChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationMembersChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationMembersChangedValidationError{}
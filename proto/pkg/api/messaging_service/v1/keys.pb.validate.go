@@ -0,0 +1,1432 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: messaging_service/v1/keys.proto
+
+package messaging_service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _keys_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on UploadKeysRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKeysRequestMultiError, or nil if none found.
+func (m *UploadKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UploadKeysRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetDeviceId()); err != nil {
+		err = UploadKeysRequestValidationError{
+			field:  "DeviceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetIdentityKey()) > 0 {
+
+		if l := len(m.GetIdentityKey()); l < 32 || l > 64 {
+			err := UploadKeysRequestValidationError{
+				field:  "IdentityKey",
+				reason: "value length must be between 32 and 64 bytes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSignedPreKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadKeysRequestValidationError{
+					field:  "SignedPreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadKeysRequestValidationError{
+					field:  "SignedPreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignedPreKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadKeysRequestValidationError{
+				field:  "SignedPreKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetOneTimePreKeys()) > 100 {
+		err := UploadKeysRequestValidationError{
+			field:  "OneTimePreKeys",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOneTimePreKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadKeysRequestValidationError{
+						field:  fmt.Sprintf("OneTimePreKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadKeysRequestValidationError{
+						field:  fmt.Sprintf("OneTimePreKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadKeysRequestValidationError{
+					field:  fmt.Sprintf("OneTimePreKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UploadKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UploadKeysRequest) _validateUuid(uuid string) error {
+	if matched := _keys_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UploadKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKeysRequestMultiError) AllErrors() []error { return m }
+
+// UploadKeysRequestValidationError is the validation error returned by
+// UploadKeysRequest.Validate if the designated constraints aren't met.
+type UploadKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKeysRequestValidationError) ErrorName() string {
+	return "UploadKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKeysRequestValidationError{}
+
+// Validate checks the field values on UploadKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKeysResponseMultiError, or nil if none found.
+func (m *UploadKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadKeysResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadKeysResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadKeysResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadKeysResponseMultiError is an error wrapping multiple validation errors
+// returned by UploadKeysResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UploadKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKeysResponseMultiError) AllErrors() []error { return m }
+
+// UploadKeysResponseValidationError is the validation error returned by
+// UploadKeysResponse.Validate if the designated constraints aren't met.
+type UploadKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKeysResponseValidationError) ErrorName() string {
+	return "UploadKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKeysResponseValidationError{}
+
+// Validate checks the field values on GetPreKeyBundlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreKeyBundlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreKeyBundlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreKeyBundlesRequestMultiError, or nil if none found.
+func (m *GetPreKeyBundlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreKeyBundlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetPreKeyBundlesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetUserId()); err != nil {
+		err = GetPreKeyBundlesRequestValidationError{
+			field:  "TargetUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPreKeyBundlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPreKeyBundlesRequest) _validateUuid(uuid string) error {
+	if matched := _keys_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPreKeyBundlesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPreKeyBundlesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreKeyBundlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreKeyBundlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreKeyBundlesRequestMultiError) AllErrors() []error { return m }
+
+// GetPreKeyBundlesRequestValidationError is the validation error returned by
+// GetPreKeyBundlesRequest.Validate if the designated constraints aren't met.
+type GetPreKeyBundlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreKeyBundlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreKeyBundlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreKeyBundlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreKeyBundlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreKeyBundlesRequestValidationError) ErrorName() string {
+	return "GetPreKeyBundlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreKeyBundlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreKeyBundlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreKeyBundlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreKeyBundlesRequestValidationError{}
+
+// Validate checks the field values on GetPreKeyBundlesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreKeyBundlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreKeyBundlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreKeyBundlesResponseMultiError, or nil if none found.
+func (m *GetPreKeyBundlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreKeyBundlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBundles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPreKeyBundlesResponseValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPreKeyBundlesResponseValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPreKeyBundlesResponseValidationError{
+					field:  fmt.Sprintf("Bundles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPreKeyBundlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreKeyBundlesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPreKeyBundlesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreKeyBundlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreKeyBundlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreKeyBundlesResponseMultiError) AllErrors() []error { return m }
+
+// GetPreKeyBundlesResponseValidationError is the validation error returned by
+// GetPreKeyBundlesResponse.Validate if the designated constraints aren't met.
+type GetPreKeyBundlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreKeyBundlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreKeyBundlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreKeyBundlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreKeyBundlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreKeyBundlesResponseValidationError) ErrorName() string {
+	return "GetPreKeyBundlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreKeyBundlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreKeyBundlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreKeyBundlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreKeyBundlesResponseValidationError{}
+
+// Validate checks the field values on GetPreKeyStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreKeyStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreKeyStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreKeyStatusRequestMultiError, or nil if none found.
+func (m *GetPreKeyStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreKeyStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetPreKeyStatusRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetDeviceId()); err != nil {
+		err = GetPreKeyStatusRequestValidationError{
+			field:  "DeviceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPreKeyStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPreKeyStatusRequest) _validateUuid(uuid string) error {
+	if matched := _keys_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPreKeyStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPreKeyStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreKeyStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreKeyStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreKeyStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetPreKeyStatusRequestValidationError is the validation error returned by
+// GetPreKeyStatusRequest.Validate if the designated constraints aren't met.
+type GetPreKeyStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreKeyStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreKeyStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreKeyStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreKeyStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreKeyStatusRequestValidationError) ErrorName() string {
+	return "GetPreKeyStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreKeyStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreKeyStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreKeyStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreKeyStatusRequestValidationError{}
+
+// Validate checks the field values on GetPreKeyStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreKeyStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreKeyStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreKeyStatusResponseMultiError, or nil if none found.
+func (m *GetPreKeyStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreKeyStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPreKeyStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPreKeyStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPreKeyStatusResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPreKeyStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreKeyStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPreKeyStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreKeyStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreKeyStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreKeyStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetPreKeyStatusResponseValidationError is the validation error returned by
+// GetPreKeyStatusResponse.Validate if the designated constraints aren't met.
+type GetPreKeyStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreKeyStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreKeyStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreKeyStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreKeyStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreKeyStatusResponseValidationError) ErrorName() string {
+	return "GetPreKeyStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreKeyStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreKeyStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreKeyStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreKeyStatusResponseValidationError{}
+
+// Validate checks the field values on SignedPreKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignedPreKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignedPreKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignedPreKeyMultiError, or
+// nil if none found.
+func (m *SignedPreKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignedPreKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	if l := len(m.GetPublicKey()); l < 32 || l > 64 {
+		err := SignedPreKeyValidationError{
+			field:  "PublicKey",
+			reason: "value length must be between 32 and 64 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetSignature()); l < 64 || l > 128 {
+		err := SignedPreKeyValidationError{
+			field:  "Signature",
+			reason: "value length must be between 64 and 128 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SignedPreKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignedPreKeyMultiError is an error wrapping multiple validation errors
+// returned by SignedPreKey.ValidateAll() if the designated constraints aren't met.
+type SignedPreKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignedPreKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignedPreKeyMultiError) AllErrors() []error { return m }
+
+// SignedPreKeyValidationError is the validation error returned by
+// SignedPreKey.Validate if the designated constraints aren't met.
+type SignedPreKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignedPreKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignedPreKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignedPreKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignedPreKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignedPreKeyValidationError) ErrorName() string { return "SignedPreKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignedPreKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignedPreKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignedPreKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignedPreKeyValidationError{}
+
+// Validate checks the field values on OneTimePreKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OneTimePreKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OneTimePreKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OneTimePreKeyMultiError, or
+// nil if none found.
+func (m *OneTimePreKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OneTimePreKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	if l := len(m.GetPublicKey()); l < 32 || l > 64 {
+		err := OneTimePreKeyValidationError{
+			field:  "PublicKey",
+			reason: "value length must be between 32 and 64 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OneTimePreKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// OneTimePreKeyMultiError is an error wrapping multiple validation errors
+// returned by OneTimePreKey.ValidateAll() if the designated constraints
+// aren't met.
+type OneTimePreKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OneTimePreKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OneTimePreKeyMultiError) AllErrors() []error { return m }
+
+// OneTimePreKeyValidationError is the validation error returned by
+// OneTimePreKey.Validate if the designated constraints aren't met.
+type OneTimePreKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OneTimePreKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OneTimePreKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OneTimePreKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OneTimePreKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OneTimePreKeyValidationError) ErrorName() string { return "OneTimePreKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OneTimePreKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOneTimePreKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OneTimePreKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OneTimePreKeyValidationError{}
+
+// Validate checks the field values on PreKeyBundle with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PreKeyBundle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreKeyBundle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreKeyBundleMultiError, or
+// nil if none found.
+func (m *PreKeyBundle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreKeyBundle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DeviceId
+
+	// no validation rules for IdentityKey
+
+	if all {
+		switch v := interface{}(m.GetSignedPreKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreKeyBundleValidationError{
+					field:  "SignedPreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreKeyBundleValidationError{
+					field:  "SignedPreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignedPreKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreKeyBundleValidationError{
+				field:  "SignedPreKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOneTimePreKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreKeyBundleValidationError{
+					field:  "OneTimePreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreKeyBundleValidationError{
+					field:  "OneTimePreKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOneTimePreKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreKeyBundleValidationError{
+				field:  "OneTimePreKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreKeyBundleMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreKeyBundleMultiError is an error wrapping multiple validation errors
+// returned by PreKeyBundle.ValidateAll() if the designated constraints aren't met.
+type PreKeyBundleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreKeyBundleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreKeyBundleMultiError) AllErrors() []error { return m }
+
+// PreKeyBundleValidationError is the validation error returned by
+// PreKeyBundle.Validate if the designated constraints aren't met.
+type PreKeyBundleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreKeyBundleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreKeyBundleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreKeyBundleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreKeyBundleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreKeyBundleValidationError) ErrorName() string { return "PreKeyBundleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreKeyBundleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreKeyBundle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreKeyBundleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreKeyBundleValidationError{}
+
+// Validate checks the field values on PreKeyStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PreKeyStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreKeyStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreKeyStatusMultiError, or
+// nil if none found.
+func (m *PreKeyStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreKeyStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceId
+
+	// no validation rules for Remaining
+
+	// no validation rules for Replenish
+
+	if len(errors) > 0 {
+		return PreKeyStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreKeyStatusMultiError is an error wrapping multiple validation errors
+// returned by PreKeyStatus.ValidateAll() if the designated constraints aren't met.
+type PreKeyStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreKeyStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreKeyStatusMultiError) AllErrors() []error { return m }
+
+// PreKeyStatusValidationError is the validation error returned by
+// PreKeyStatus.Validate if the designated constraints aren't met.
+type PreKeyStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreKeyStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreKeyStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreKeyStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreKeyStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreKeyStatusValidationError) ErrorName() string { return "PreKeyStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreKeyStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreKeyStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreKeyStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreKeyStatusValidationError{}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: messaging_service/v1/keys.proto
+
+package messaging_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KeyDirectoryService_UploadKeys_FullMethodName       = "/api.messaging_service.v1.KeyDirectoryService/UploadKeys"
+	KeyDirectoryService_GetPreKeyBundles_FullMethodName = "/api.messaging_service.v1.KeyDirectoryService/GetPreKeyBundles"
+	KeyDirectoryService_GetPreKeyStatus_FullMethodName  = "/api.messaging_service.v1.KeyDirectoryService/GetPreKeyStatus"
+)
+
+// KeyDirectoryServiceClient is the client API for KeyDirectoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Каталог открытых ключей устройств для сквозного шифрования личных переписок.
+// Сервер хранит только открытые ключи и не может расшифровать сообщения
+type KeyDirectoryServiceClient interface {
+	// Загрузка ключей устройства: регистрация устройства, смена подписанного ключа и пополнение одноразовых ключей
+	UploadKeys(ctx context.Context, in *UploadKeysRequest, opts ...grpc.CallOption) (*UploadKeysResponse, error)
+	// Получение наборов ключей всех устройств пользователя, одноразовый ключ каждого устройства расходуется
+	GetPreKeyBundles(ctx context.Context, in *GetPreKeyBundlesRequest, opts ...grpc.CallOption) (*GetPreKeyBundlesResponse, error)
+	// Количество оставшихся одноразовых ключей устройства
+	GetPreKeyStatus(ctx context.Context, in *GetPreKeyStatusRequest, opts ...grpc.CallOption) (*GetPreKeyStatusResponse, error)
+}
+
+type keyDirectoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyDirectoryServiceClient(cc grpc.ClientConnInterface) KeyDirectoryServiceClient {
+	return &keyDirectoryServiceClient{cc}
+}
+
+func (c *keyDirectoryServiceClient) UploadKeys(ctx context.Context, in *UploadKeysRequest, opts ...grpc.CallOption) (*UploadKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadKeysResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_UploadKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) GetPreKeyBundles(ctx context.Context, in *GetPreKeyBundlesRequest, opts ...grpc.CallOption) (*GetPreKeyBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreKeyBundlesResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_GetPreKeyBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) GetPreKeyStatus(ctx context.Context, in *GetPreKeyStatusRequest, opts ...grpc.CallOption) (*GetPreKeyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreKeyStatusResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_GetPreKeyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyDirectoryServiceServer is the server API for KeyDirectoryService service.
+// All implementations must embed UnimplementedKeyDirectoryServiceServer
+// for forward compatibility.
+//
+// Каталог открытых ключей устройств для сквозного шифрования личных переписок.
+// Сервер хранит только открытые ключи и не может расшифровать сообщения
+type KeyDirectoryServiceServer interface {
+	// Загрузка ключей устройства: регистрация устройства, смена подписанного ключа и пополнение одноразовых ключей
+	UploadKeys(context.Context, *UploadKeysRequest) (*UploadKeysResponse, error)
+	// Получение наборов ключей всех устройств пользователя, одноразовый ключ каждого устройства расходуется
+	GetPreKeyBundles(context.Context, *GetPreKeyBundlesRequest) (*GetPreKeyBundlesResponse, error)
+	// Количество оставшихся одноразовых ключей устройства
+	GetPreKeyStatus(context.Context, *GetPreKeyStatusRequest) (*GetPreKeyStatusResponse, error)
+	mustEmbedUnimplementedKeyDirectoryServiceServer()
+}
+
+// UnimplementedKeyDirectoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKeyDirectoryServiceServer struct{}
+
+func (UnimplementedKeyDirectoryServiceServer) UploadKeys(context.Context, *UploadKeysRequest) (*UploadKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadKeys not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) GetPreKeyBundles(context.Context, *GetPreKeyBundlesRequest) (*GetPreKeyBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreKeyBundles not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) GetPreKeyStatus(context.Context, *GetPreKeyStatusRequest) (*GetPreKeyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreKeyStatus not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) mustEmbedUnimplementedKeyDirectoryServiceServer() {}
+func (UnimplementedKeyDirectoryServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeKeyDirectoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyDirectoryServiceServer will
+// result in compilation errors.
+type UnsafeKeyDirectoryServiceServer interface {
+	mustEmbedUnimplementedKeyDirectoryServiceServer()
+}
+
+func RegisterKeyDirectoryServiceServer(s grpc.ServiceRegistrar, srv KeyDirectoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKeyDirectoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KeyDirectoryService_ServiceDesc, srv)
+}
+
+func _KeyDirectoryService_UploadKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).UploadKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_UploadKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).UploadKeys(ctx, req.(*UploadKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_GetPreKeyBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreKeyBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).GetPreKeyBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_GetPreKeyBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).GetPreKeyBundles(ctx, req.(*GetPreKeyBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_GetPreKeyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreKeyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).GetPreKeyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_GetPreKeyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).GetPreKeyStatus(ctx, req.(*GetPreKeyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyDirectoryService_ServiceDesc is the grpc.ServiceDesc for KeyDirectoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KeyDirectoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.messaging_service.v1.KeyDirectoryService",
+	HandlerType: (*KeyDirectoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadKeys",
+			Handler:    _KeyDirectoryService_UploadKeys_Handler,
+		},
+		{
+			MethodName: "GetPreKeyBundles",
+			Handler:    _KeyDirectoryService_GetPreKeyBundles_Handler,
+		},
+		{
+			MethodName: "GetPreKeyStatus",
+			Handler:    _KeyDirectoryService_GetPreKeyStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "messaging_service/v1/keys.proto",
+}
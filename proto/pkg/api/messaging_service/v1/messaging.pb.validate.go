@@ -72,11 +72,60 @@ func (m *SendMessageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetRecipientId()); err != nil {
-		err = SendMessageRequestValidationError{
-			field:  "RecipientId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if m.GetRecipientId() != "" {
+
+		if err := m._validateUuid(m.GetRecipientId()); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  "RecipientId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Content
+
+	if m.GetConversationId() != "" {
+
+		if err := m._validateUuid(m.GetConversationId()); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  "ConversationId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetReplyToMessageId() != "" {
+
+		if err := m._validateUuid(m.GetReplyToMessageId()); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  "ReplyToMessageId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetAttachmentIds()) > 10 {
+		err := SendMessageRequestValidationError{
+			field:  "AttachmentIds",
+			reason: "value must contain no more than 10 item(s)",
 		}
 		if !all {
 			return err
@@ -84,10 +133,103 @@ func (m *SendMessageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetContent()) < 1 {
+	_SendMessageRequest_AttachmentIds_Unique := make(map[string]struct{}, len(m.GetAttachmentIds()))
+
+	for idx, item := range m.GetAttachmentIds() {
+		_, _ = idx, item
+
+		if _, exists := _SendMessageRequest_AttachmentIds_Unique[item]; exists {
+			err := SendMessageRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SendMessageRequest_AttachmentIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSenderDeviceId() != "" {
+
+		if err := m._validateUuid(m.GetSenderDeviceId()); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  "SenderDeviceId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetEncryptedPayloads()) > 100 {
 		err := SendMessageRequestValidationError{
-			field:  "Content",
-			reason: "value length must be at least 1 runes",
+			field:  "EncryptedPayloads",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEncryptedPayloads() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetSendAt() < 0 {
+		err := SendMessageRequestValidationError{
+			field:  "SendAt",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -95,6 +237,232 @@ func (m *SendMessageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetClientMessageId() != "" {
+
+		if err := m._validateUuid(m.GetClientMessageId()); err != nil {
+			err = SendMessageRequestValidationError{
+				field:  "ClientMessageId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	switch v := m.Payload.(type) {
+	case *SendMessageRequest_Text:
+		if v == nil {
+			err := SendMessageRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetText()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Text",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Text",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetText()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SendMessageRequest_Image:
+		if v == nil {
+			err := SendMessageRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetImage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Image",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Image",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SendMessageRequest_File:
+		if v == nil {
+			err := SendMessageRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFile()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "File",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "File",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SendMessageRequest_Location:
+		if v == nil {
+			err := SendMessageRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLocation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Location",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Location",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SendMessageRequest_Contact:
+		if v == nil {
+			err := SendMessageRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetContact()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Contact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Contact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContact()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return SendMessageRequestMultiError(errors)
 	}
@@ -205,16 +573,49 @@ func (m *SendMessageResponse) validate(all bool) error {
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetMessageId()); err != nil {
-		err = SendMessageResponseValidationError{
-			field:  "MessageId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if m.GetMessageId() != "" {
+
+		if err := m._validateUuid(m.GetMessageId()); err != nil {
+			err = SendMessageResponseValidationError{
+				field:  "MessageId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetScheduledMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMessageResponseValidationError{
+				field:  "ScheduledMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
@@ -339,22 +740,26 @@ func (m *GetMessagesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetConversationUserId()); err != nil {
-		err = GetMessagesRequestValidationError{
-			field:  "ConversationUserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
+	if m.GetConversationUserId() != "" {
+
+		if err := m._validateUuid(m.GetConversationUserId()); err != nil {
+			err = GetMessagesRequestValidationError{
+				field:  "ConversationUserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
-	if m.GetLimit() <= 0 {
+	if val := m.GetLimit(); val < 0 || val > 100 {
 		err := GetMessagesRequestValidationError{
 			field:  "Limit",
-			reason: "value must be greater than 0",
+			reason: "value must be inside range [0, 100]",
 		}
 		if !all {
 			return err
@@ -362,10 +767,28 @@ func (m *GetMessagesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOffset() < 0 {
-		err := GetMessagesRequestValidationError{
-			field:  "Offset",
-			reason: "value must be greater than or equal to 0",
+	if m.GetConversationId() != "" {
+
+		if err := m._validateUuid(m.GetConversationId()); err != nil {
+			err = GetMessagesRequestValidationError{
+				field:  "ConversationId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if _, ok := PageDirection_name[int32(m.GetDirection())]; !ok {
+		err := GetMessagesRequestValidationError{
+			field:  "Direction",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -451,86 +874,14694 @@ func (e GetMessagesRequestValidationError) Error() string {
 		cause)
 }
 
-var _ error = GetMessagesRequestValidationError{}
+var _ error = GetMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessagesRequestValidationError{}
+
+// Validate checks the field values on GetMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessagesResponseMultiError, or nil if none found.
+func (m *GetMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return GetMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessagesResponseMultiError) AllErrors() []error { return m }
+
+// GetMessagesResponseValidationError is the validation error returned by
+// GetMessagesResponse.Validate if the designated constraints aren't met.
+type GetMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessagesResponseValidationError) ErrorName() string {
+	return "GetMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessagesResponseValidationError{}
+
+// Validate checks the field values on UpdateMessageStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMessageStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMessageStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMessageStatusRequestMultiError, or nil if none found.
+func (m *UpdateMessageStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMessageStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = UpdateMessageStatusRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdateMessageStatusRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateMessageStatusRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := UpdateMessageStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [MESSAGE_STATUS_DELIVERED MESSAGE_STATUS_READ]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateMessageStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateMessageStatusRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateMessageStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMessageStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMessageStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMessageStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMessageStatusRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMessageStatusRequestValidationError is the validation error returned
+// by UpdateMessageStatusRequest.Validate if the designated constraints aren't met.
+type UpdateMessageStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMessageStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMessageStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMessageStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMessageStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMessageStatusRequestValidationError) ErrorName() string {
+	return "UpdateMessageStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMessageStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMessageStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMessageStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMessageStatusRequestValidationError{}
+
+var _UpdateMessageStatusRequest_Status_InLookup = map[MessageStatus]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on UpdateMessageStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMessageStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMessageStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMessageStatusResponseMultiError, or nil if none found.
+func (m *UpdateMessageStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMessageStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UpdateMessageStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMessageStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateMessageStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMessageStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMessageStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMessageStatusResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMessageStatusResponseValidationError is the validation error returned
+// by UpdateMessageStatusResponse.Validate if the designated constraints
+// aren't met.
+type UpdateMessageStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMessageStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMessageStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMessageStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMessageStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMessageStatusResponseValidationError) ErrorName() string {
+	return "UpdateMessageStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMessageStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMessageStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMessageStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMessageStatusResponseValidationError{}
+
+// Validate checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadRequestMultiError, or nil if none found.
+func (m *MarkConversationReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = MarkConversationReadRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = MarkConversationReadRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() != "" {
+
+		if err := m._validateUuid(m.GetMessageId()); err != nil {
+			err = MarkConversationReadRequestValidationError{
+				field:  "MessageId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MarkConversationReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MarkConversationReadRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MarkConversationReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkConversationReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadRequestValidationError is the validation error returned
+// by MarkConversationReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkConversationReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadRequestValidationError) ErrorName() string {
+	return "MarkConversationReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadRequestValidationError{}
+
+// Validate checks the field values on MarkConversationReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadResponseMultiError, or nil if none found.
+func (m *MarkConversationReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastReadMessageId
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for UnreadConversations
+
+	if len(errors) > 0 {
+		return MarkConversationReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationReadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationReadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MarkConversationReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadResponseValidationError is the validation error returned
+// by MarkConversationReadResponse.Validate if the designated constraints
+// aren't met.
+type MarkConversationReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadResponseValidationError) ErrorName() string {
+	return "MarkConversationReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadResponseValidationError{}
+
+// Validate checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageRequestMultiError, or nil if none found.
+func (m *EditMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = EditMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EditMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := EditMessageRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEntities()) > 100 {
+		err := EditMessageRequestValidationError{
+			field:  "Entities",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EditMessageRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EditMessageRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EditMessageRequestValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EditMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EditMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EditMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by EditMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageRequestMultiError) AllErrors() []error { return m }
+
+// EditMessageRequestValidationError is the validation error returned by
+// EditMessageRequest.Validate if the designated constraints aren't met.
+type EditMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageRequestValidationError) ErrorName() string {
+	return "EditMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageRequestValidationError{}
+
+// Validate checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageResponseMultiError, or nil if none found.
+func (m *EditMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditMessageResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by EditMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EditMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageResponseMultiError) AllErrors() []error { return m }
+
+// EditMessageResponseValidationError is the validation error returned by
+// EditMessageResponse.Validate if the designated constraints aren't met.
+type EditMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageResponseValidationError) ErrorName() string {
+	return "EditMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageResponseValidationError{}
+
+// Validate checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageRequestMultiError, or nil if none found.
+func (m *DeleteMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = DeleteMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DeleteMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DeleteMessageRequest_Mode_NotInLookup[m.GetMode()]; ok {
+		err := DeleteMessageRequestValidationError{
+			field:  "Mode",
+			reason: "value must not be in list [DELETE_MODE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DeleteMode_name[int32(m.GetMode())]; !ok {
+		err := DeleteMessageRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMessageRequestValidationError is the validation error returned by
+// DeleteMessageRequest.Validate if the designated constraints aren't met.
+type DeleteMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageRequestValidationError) ErrorName() string {
+	return "DeleteMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageRequestValidationError{}
+
+var _DeleteMessageRequest_Mode_NotInLookup = map[DeleteMode]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on DeleteMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageResponseMultiError, or nil if none found.
+func (m *DeleteMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageResponseMultiError) AllErrors() []error { return m }
+
+// DeleteMessageResponseValidationError is the validation error returned by
+// DeleteMessageResponse.Validate if the designated constraints aren't met.
+type DeleteMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageResponseValidationError) ErrorName() string {
+	return "DeleteMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageResponseValidationError{}
+
+// Validate checks the field values on GetMessageEditHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageEditHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageEditHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageEditHistoryRequestMultiError, or nil if none found.
+func (m *GetMessageEditHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageEditHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = GetMessageEditHistoryRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetMessageEditHistoryRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMessageEditHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetMessageEditHistoryRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetMessageEditHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMessageEditHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessageEditHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageEditHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageEditHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetMessageEditHistoryRequestValidationError is the validation error returned
+// by GetMessageEditHistoryRequest.Validate if the designated constraints
+// aren't met.
+type GetMessageEditHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageEditHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageEditHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageEditHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageEditHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageEditHistoryRequestValidationError) ErrorName() string {
+	return "GetMessageEditHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageEditHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageEditHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageEditHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageEditHistoryRequestValidationError{}
+
+// Validate checks the field values on GetMessageEditHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageEditHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageEditHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetMessageEditHistoryResponseMultiError, or nil if none found.
+func (m *GetMessageEditHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageEditHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEdits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMessageEditHistoryResponseValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMessageEditHistoryResponseValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessageEditHistoryResponseValidationError{
+					field:  fmt.Sprintf("Edits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMessageEditHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageEditHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMessageEditHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetMessageEditHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageEditHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageEditHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetMessageEditHistoryResponseValidationError is the validation error
+// returned by GetMessageEditHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetMessageEditHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageEditHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageEditHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageEditHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageEditHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageEditHistoryResponseValidationError) ErrorName() string {
+	return "GetMessageEditHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageEditHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageEditHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageEditHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageEditHistoryResponseValidationError{}
+
+// Validate checks the field values on MessageEdit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageEdit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEdit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageEditMultiError, or
+// nil if none found.
+func (m *MessageEdit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEdit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	// no validation rules for EditedAt
+
+	if len(errors) > 0 {
+		return MessageEditMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEditMultiError is an error wrapping multiple validation errors
+// returned by MessageEdit.ValidateAll() if the designated constraints aren't met.
+type MessageEditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEditMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEditMultiError) AllErrors() []error { return m }
+
+// MessageEditValidationError is the validation error returned by
+// MessageEdit.Validate if the designated constraints aren't met.
+type MessageEditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEditValidationError) ErrorName() string { return "MessageEditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEdit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEditValidationError{}
+
+// Validate checks the field values on GetThreadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetThreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetThreadRequestMultiError, or nil if none found.
+func (m *GetThreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetThreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = GetThreadRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetThreadRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := GetThreadRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetThreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetThreadRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetThreadRequestMultiError is an error wrapping multiple validation errors
+// returned by GetThreadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetThreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetThreadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetThreadRequestMultiError) AllErrors() []error { return m }
+
+// GetThreadRequestValidationError is the validation error returned by
+// GetThreadRequest.Validate if the designated constraints aren't met.
+type GetThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadRequestValidationError) ErrorName() string { return "GetThreadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadRequestValidationError{}
+
+// Validate checks the field values on GetThreadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetThreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetThreadResponseMultiError, or nil if none found.
+func (m *GetThreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetThreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetThreadResponseValidationError{
+				field:  "Root",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetThreadResponseValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return GetThreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetThreadResponseMultiError is an error wrapping multiple validation errors
+// returned by GetThreadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetThreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetThreadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetThreadResponseMultiError) AllErrors() []error { return m }
+
+// GetThreadResponseValidationError is the validation error returned by
+// GetThreadResponse.Validate if the designated constraints aren't met.
+type GetThreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadResponseValidationError) ErrorName() string {
+	return "GetThreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetThreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadResponseValidationError{}
+
+// Validate checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesRequestMultiError, or nil if none found.
+func (m *SearchMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SearchMessagesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConversationId() != "" {
+
+		if err := m._validateUuid(m.GetConversationId()); err != nil {
+			err = SearchMessagesRequestValidationError{
+				field:  "ConversationId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSenderId() != "" {
+
+		if err := m._validateUuid(m.GetSenderId()); err != nil {
+			err = SearchMessagesRequestValidationError{
+				field:  "SenderId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetFrom() < 0 {
+		err := SearchMessagesRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() < 0 {
+		err := SearchMessagesRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 50 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SearchMessagesRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SearchMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesRequestMultiError) AllErrors() []error { return m }
+
+// SearchMessagesRequestValidationError is the validation error returned by
+// SearchMessagesRequest.Validate if the designated constraints aren't met.
+type SearchMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesRequestValidationError) ErrorName() string {
+	return "SearchMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesRequestValidationError{}
+
+// Validate checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesResponseMultiError, or nil if none found.
+func (m *SearchMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMessagesResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return SearchMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesResponseMultiError) AllErrors() []error { return m }
+
+// SearchMessagesResponseValidationError is the validation error returned by
+// SearchMessagesResponse.Validate if the designated constraints aren't met.
+type SearchMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesResponseValidationError) ErrorName() string {
+	return "SearchMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesResponseValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UploadAttachmentRequest_Info:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAttachmentRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadAttachmentRequest_Chunk:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on UploadAttachmentInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentInfoMultiError, or nil if none found.
+func (m *UploadAttachmentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUploaderId()); err != nil {
+		err = UploadAttachmentInfoValidationError{
+			field:  "UploaderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFileName()) > 255 {
+		err := UploadAttachmentInfoValidationError{
+			field:  "FileName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UploadAttachmentInfo) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UploadAttachmentInfoMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentInfo.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentInfoMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentInfoValidationError is the validation error returned by
+// UploadAttachmentInfo.Validate if the designated constraints aren't met.
+type UploadAttachmentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentInfoValidationError) ErrorName() string {
+	return "UploadAttachmentInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentInfoValidationError{}
+
+// Validate checks the field values on UploadAttachmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentResponseMultiError, or nil if none found.
+func (m *UploadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentResponseValidationError is the validation error returned by
+// UploadAttachmentResponse.Validate if the designated constraints aren't met.
+type UploadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentResponseValidationError) ErrorName() string {
+	return "UploadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentResponseValidationError{}
+
+// Validate checks the field values on GetAttachmentURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAttachmentURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAttachmentURLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAttachmentURLRequestMultiError, or nil if none found.
+func (m *GetAttachmentURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAttachmentURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetAttachmentId()); err != nil {
+		err = GetAttachmentURLRequestValidationError{
+			field:  "AttachmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetAttachmentURLRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAttachmentURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAttachmentURLRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAttachmentURLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAttachmentURLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAttachmentURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAttachmentURLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAttachmentURLRequestMultiError) AllErrors() []error { return m }
+
+// GetAttachmentURLRequestValidationError is the validation error returned by
+// GetAttachmentURLRequest.Validate if the designated constraints aren't met.
+type GetAttachmentURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAttachmentURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAttachmentURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAttachmentURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAttachmentURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAttachmentURLRequestValidationError) ErrorName() string {
+	return "GetAttachmentURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAttachmentURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAttachmentURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAttachmentURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAttachmentURLRequestValidationError{}
+
+// Validate checks the field values on GetAttachmentURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAttachmentURLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAttachmentURLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAttachmentURLResponseMultiError, or nil if none found.
+func (m *GetAttachmentURLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAttachmentURLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return GetAttachmentURLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAttachmentURLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAttachmentURLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAttachmentURLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAttachmentURLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAttachmentURLResponseMultiError) AllErrors() []error { return m }
+
+// GetAttachmentURLResponseValidationError is the validation error returned by
+// GetAttachmentURLResponse.Validate if the designated constraints aren't met.
+type GetAttachmentURLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAttachmentURLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAttachmentURLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAttachmentURLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAttachmentURLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAttachmentURLResponseValidationError) ErrorName() string {
+	return "GetAttachmentURLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAttachmentURLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAttachmentURLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAttachmentURLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAttachmentURLResponseValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetAttachmentId()); err != nil {
+		err = DownloadAttachmentRequestValidationError{
+			field:  "AttachmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DownloadAttachmentRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *DownloadAttachmentResponse_Attachment:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAttachment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadAttachmentResponse_Chunk:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttachmentId
+
+	// no validation rules for FileName
+
+	// no validation rules for MimeType
+
+	// no validation rules for Size
+
+	// no validation rules for Checksum
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionRequestMultiError, or nil if none found.
+func (m *AddReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = AddReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AddReactionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 16 {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddReactionRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddReactionRequestMultiError is an error wrapping multiple validation errors
+// returned by AddReactionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionRequestMultiError) AllErrors() []error { return m }
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+// Validate checks the field values on AddReactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionResponseMultiError, or nil if none found.
+func (m *AddReactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddReactionResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddReactionResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddReactionResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddReactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddReactionResponseMultiError is an error wrapping multiple validation
+// errors returned by AddReactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddReactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionResponseMultiError) AllErrors() []error { return m }
+
+// AddReactionResponseValidationError is the validation error returned by
+// AddReactionResponse.Validate if the designated constraints aren't met.
+type AddReactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionResponseValidationError) ErrorName() string {
+	return "AddReactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionResponseValidationError{}
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionRequestMultiError, or nil if none found.
+func (m *RemoveReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = RemoveReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveReactionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 16 {
+		err := RemoveReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveReactionRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on RemoveReactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionResponseMultiError, or nil if none found.
+func (m *RemoveReactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RemoveReactionResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RemoveReactionResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RemoveReactionResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveReactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveReactionResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionResponseMultiError) AllErrors() []error { return m }
+
+// RemoveReactionResponseValidationError is the validation error returned by
+// RemoveReactionResponse.Validate if the designated constraints aren't met.
+type RemoveReactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionResponseValidationError) ErrorName() string {
+	return "RemoveReactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionResponseValidationError{}
+
+// Validate checks the field values on ListReactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReactionsRequestMultiError, or nil if none found.
+func (m *ListReactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = ListReactionsRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListReactionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmoji()) > 16 {
+		err := ListReactionsRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListReactionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListReactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListReactionsRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListReactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReactionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReactionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListReactionsRequestValidationError is the validation error returned by
+// ListReactionsRequest.Validate if the designated constraints aren't met.
+type ListReactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReactionsRequestValidationError) ErrorName() string {
+	return "ListReactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReactionsRequestValidationError{}
+
+// Validate checks the field values on ListReactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReactionsResponseMultiError, or nil if none found.
+func (m *ListReactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReactionsResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReactionsResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReactionsResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReactionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReactionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReactionsResponseMultiError) AllErrors() []error { return m }
+
+// ListReactionsResponseValidationError is the validation error returned by
+// ListReactionsResponse.Validate if the designated constraints aren't met.
+type ListReactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReactionsResponseValidationError) ErrorName() string {
+	return "ListReactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReactionsResponseValidationError{}
+
+// Validate checks the field values on Reaction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionMultiError, or nil
+// if none found.
+func (m *Reaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Emoji
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ReactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionMultiError is an error wrapping multiple validation errors returned
+// by Reaction.ValidateAll() if the designated constraints aren't met.
+type ReactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionMultiError) AllErrors() []error { return m }
+
+// ReactionValidationError is the validation error returned by
+// Reaction.Validate if the designated constraints aren't met.
+type ReactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionValidationError) ErrorName() string { return "ReactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionValidationError{}
+
+// Validate checks the field values on ReactionSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReactionSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReactionSummaryMultiError, or nil if none found.
+func (m *ReactionSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Emoji
+
+	// no validation rules for Count
+
+	// no validation rules for ReactedByMe
+
+	if len(errors) > 0 {
+		return ReactionSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionSummaryMultiError is an error wrapping multiple validation errors
+// returned by ReactionSummary.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionSummaryMultiError) AllErrors() []error { return m }
+
+// ReactionSummaryValidationError is the validation error returned by
+// ReactionSummary.Validate if the designated constraints aren't met.
+type ReactionSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionSummaryValidationError) ErrorName() string { return "ReactionSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionSummaryValidationError{}
+
+// Validate checks the field values on StreamMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamMessagesRequestMultiError, or nil if none found.
+func (m *StreamMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = StreamMessagesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LastEventId
+
+	if len(errors) > 0 {
+		return StreamMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *StreamMessagesRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// StreamMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamMessagesRequestMultiError) AllErrors() []error { return m }
+
+// StreamMessagesRequestValidationError is the validation error returned by
+// StreamMessagesRequest.Validate if the designated constraints aren't met.
+type StreamMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamMessagesRequestValidationError) ErrorName() string {
+	return "StreamMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamMessagesRequestValidationError{}
+
+// Validate checks the field values on MessageEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageEventMultiError, or
+// nil if none found.
+func (m *MessageEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	if all {
+		switch v := interface{}(m.GetReaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Reaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Reaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Reaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadReceipt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "ReadReceipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "ReadReceipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadReceipt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "ReadReceipt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTyping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Typing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Typing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTyping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Typing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPresence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Presence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreKeyStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "PreKeyStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "PreKeyStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreKeyStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "PreKeyStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Pin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageEventValidationError{
+					field:  "Pin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageEventValidationError{
+				field:  "Pin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MessageEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEventMultiError is an error wrapping multiple validation errors
+// returned by MessageEvent.ValidateAll() if the designated constraints aren't met.
+type MessageEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEventMultiError) AllErrors() []error { return m }
+
+// MessageEventValidationError is the validation error returned by
+// MessageEvent.Validate if the designated constraints aren't met.
+type MessageEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEventValidationError) ErrorName() string { return "MessageEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEventValidationError{}
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for UserId
+
+	// no validation rules for LastReadMessageId
+
+	// no validation rules for ReadAt
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on CreateConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConversationRequestMultiError, or nil if none found.
+func (m *CreateConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCreatorId()); err != nil {
+		err = CreateConversationRequestValidationError{
+			field:  "CreatorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := CreateConversationRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberIds()) > 500 {
+		err := CreateConversationRequestValidationError{
+			field:  "MemberIds",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMemberIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = CreateConversationRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateConversationRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateConversationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConversationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConversationRequestMultiError) AllErrors() []error { return m }
+
+// CreateConversationRequestValidationError is the validation error returned by
+// CreateConversationRequest.Validate if the designated constraints aren't met.
+type CreateConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConversationRequestValidationError) ErrorName() string {
+	return "CreateConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConversationRequestValidationError{}
+
+// Validate checks the field values on CreateConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConversationResponseMultiError, or nil if none found.
+func (m *CreateConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConversation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConversationResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConversationResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConversation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConversationResponseValidationError{
+				field:  "Conversation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConversationResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateConversationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConversationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConversationResponseMultiError) AllErrors() []error { return m }
+
+// CreateConversationResponseValidationError is the validation error returned
+// by CreateConversationResponse.Validate if the designated constraints aren't met.
+type CreateConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConversationResponseValidationError) ErrorName() string {
+	return "CreateConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConversationResponseValidationError{}
+
+// Validate checks the field values on GetConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConversationRequestMultiError, or nil if none found.
+func (m *GetConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = GetConversationRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetConversationRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetConversationRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetConversationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConversationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConversationRequestMultiError) AllErrors() []error { return m }
+
+// GetConversationRequestValidationError is the validation error returned by
+// GetConversationRequest.Validate if the designated constraints aren't met.
+type GetConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConversationRequestValidationError) ErrorName() string {
+	return "GetConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConversationRequestValidationError{}
+
+// Validate checks the field values on GetConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConversationResponseMultiError, or nil if none found.
+func (m *GetConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConversation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConversationResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConversationResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConversation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConversationResponseValidationError{
+				field:  "Conversation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConversationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetConversationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConversationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConversationResponseMultiError) AllErrors() []error { return m }
+
+// GetConversationResponseValidationError is the validation error returned by
+// GetConversationResponse.Validate if the designated constraints aren't met.
+type GetConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConversationResponseValidationError) ErrorName() string {
+	return "GetConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConversationResponseValidationError{}
+
+// Validate checks the field values on ListConversationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsRequestMultiError, or nil if none found.
+func (m *ListConversationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListConversationsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListConversationsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for Archived
+
+	if len(errors) > 0 {
+		return ListConversationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListConversationsRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListConversationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsRequestMultiError) AllErrors() []error { return m }
+
+// ListConversationsRequestValidationError is the validation error returned by
+// ListConversationsRequest.Validate if the designated constraints aren't met.
+type ListConversationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsRequestValidationError) ErrorName() string {
+	return "ListConversationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsRequestValidationError{}
+
+// Validate checks the field values on ListConversationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsResponseMultiError, or nil if none found.
+func (m *ListConversationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConversations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConversationsResponseValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConversationsResponseValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConversationsResponseValidationError{
+					field:  fmt.Sprintf("Conversations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return ListConversationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConversationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListConversationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsResponseMultiError) AllErrors() []error { return m }
+
+// ListConversationsResponseValidationError is the validation error returned by
+// ListConversationsResponse.Validate if the designated constraints aren't met.
+type ListConversationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsResponseValidationError) ErrorName() string {
+	return "ListConversationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsResponseValidationError{}
+
+// Validate checks the field values on ConversationSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationSummaryMultiError, or nil if none found.
+func (m *ConversationSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConversation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConversation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationSummaryValidationError{
+				field:  "Conversation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationSummaryValidationError{
+				field:  "LastMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UnreadCount
+
+	if all {
+		switch v := interface{}(m.GetPeer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Peer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Peer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationSummaryValidationError{
+				field:  "Peer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastActivityAt
+
+	// no validation rules for LastReadMessageId
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationSummaryValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationSummaryValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConversationSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationSummaryMultiError is an error wrapping multiple validation
+// errors returned by ConversationSummary.ValidateAll() if the designated
+// constraints aren't met.
+type ConversationSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationSummaryMultiError) AllErrors() []error { return m }
+
+// ConversationSummaryValidationError is the validation error returned by
+// ConversationSummary.Validate if the designated constraints aren't met.
+type ConversationSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationSummaryValidationError) ErrorName() string {
+	return "ConversationSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationSummaryValidationError{}
+
+// Validate checks the field values on ConversationSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationSettingsMultiError, or nil if none found.
+func (m *ConversationSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archived
+
+	// no validation rules for Muted
+
+	// no validation rules for MutedUntil
+
+	// no validation rules for MarkedUnread
+
+	if len(errors) > 0 {
+		return ConversationSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationSettingsMultiError is an error wrapping multiple validation
+// errors returned by ConversationSettings.ValidateAll() if the designated
+// constraints aren't met.
+type ConversationSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationSettingsMultiError) AllErrors() []error { return m }
+
+// ConversationSettingsValidationError is the validation error returned by
+// ConversationSettings.Validate if the designated constraints aren't met.
+type ConversationSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationSettingsValidationError) ErrorName() string {
+	return "ConversationSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationSettingsValidationError{}
+
+// Validate checks the field values on MessagePreview with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessagePreview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessagePreview with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessagePreviewMultiError,
+// or nil if none found.
+func (m *MessagePreview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessagePreview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for SenderId
+
+	// no validation rules for Text
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return MessagePreviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessagePreviewMultiError is an error wrapping multiple validation errors
+// returned by MessagePreview.ValidateAll() if the designated constraints
+// aren't met.
+type MessagePreviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessagePreviewMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessagePreviewMultiError) AllErrors() []error { return m }
+
+// MessagePreviewValidationError is the validation error returned by
+// MessagePreview.Validate if the designated constraints aren't met.
+type MessagePreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessagePreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessagePreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessagePreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessagePreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessagePreviewValidationError) ErrorName() string { return "MessagePreviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessagePreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessagePreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessagePreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessagePreviewValidationError{}
+
+// Validate checks the field values on UserSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserSummaryMultiError, or
+// nil if none found.
+func (m *UserSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Nickname
+
+	// no validation rules for AvatarUrl
+
+	if len(errors) > 0 {
+		return UserSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSummaryMultiError is an error wrapping multiple validation errors
+// returned by UserSummary.ValidateAll() if the designated constraints aren't met.
+type UserSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSummaryMultiError) AllErrors() []error { return m }
+
+// UserSummaryValidationError is the validation error returned by
+// UserSummary.Validate if the designated constraints aren't met.
+type UserSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSummaryValidationError) ErrorName() string { return "UserSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSummaryValidationError{}
+
+// Validate checks the field values on AddConversationMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddConversationMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddConversationMembersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddConversationMembersRequestMultiError, or nil if none found.
+func (m *AddConversationMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddConversationMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = AddConversationMembersRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AddConversationMembersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetMemberIds()); l < 1 || l > 500 {
+		err := AddConversationMembersRequestValidationError{
+			field:  "MemberIds",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMemberIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = AddConversationMembersRequestValidationError{
+				field:  fmt.Sprintf("MemberIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddConversationMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddConversationMembersRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddConversationMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by AddConversationMembersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AddConversationMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddConversationMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddConversationMembersRequestMultiError) AllErrors() []error { return m }
+
+// AddConversationMembersRequestValidationError is the validation error
+// returned by AddConversationMembersRequest.Validate if the designated
+// constraints aren't met.
+type AddConversationMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddConversationMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddConversationMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddConversationMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddConversationMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddConversationMembersRequestValidationError) ErrorName() string {
+	return "AddConversationMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddConversationMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddConversationMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddConversationMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddConversationMembersRequestValidationError{}
+
+// Validate checks the field values on AddConversationMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddConversationMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddConversationMembersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddConversationMembersResponseMultiError, or nil if none found.
+func (m *AddConversationMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddConversationMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddConversationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddConversationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddConversationMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddConversationMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddConversationMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by AddConversationMembersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddConversationMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddConversationMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddConversationMembersResponseMultiError) AllErrors() []error { return m }
+
+// AddConversationMembersResponseValidationError is the validation error
+// returned by AddConversationMembersResponse.Validate if the designated
+// constraints aren't met.
+type AddConversationMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddConversationMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddConversationMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddConversationMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddConversationMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddConversationMembersResponseValidationError) ErrorName() string {
+	return "AddConversationMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddConversationMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddConversationMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddConversationMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddConversationMembersResponseValidationError{}
+
+// Validate checks the field values on RemoveConversationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveConversationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveConversationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveConversationMemberRequestMultiError, or nil if none found.
+func (m *RemoveConversationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveConversationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = RemoveConversationMemberRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveConversationMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMemberId()); err != nil {
+		err = RemoveConversationMemberRequestValidationError{
+			field:  "MemberId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveConversationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveConversationMemberRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveConversationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveConversationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveConversationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveConversationMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveConversationMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveConversationMemberRequestValidationError is the validation error
+// returned by RemoveConversationMemberRequest.Validate if the designated
+// constraints aren't met.
+type RemoveConversationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveConversationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveConversationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveConversationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveConversationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveConversationMemberRequestValidationError) ErrorName() string {
+	return "RemoveConversationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveConversationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveConversationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveConversationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveConversationMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveConversationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveConversationMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveConversationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveConversationMemberResponseMultiError, or nil if none found.
+func (m *RemoveConversationMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveConversationMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RemoveConversationMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveConversationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveConversationMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveConversationMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveConversationMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveConversationMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveConversationMemberResponseValidationError is the validation error
+// returned by RemoveConversationMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveConversationMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveConversationMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveConversationMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveConversationMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveConversationMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveConversationMemberResponseValidationError) ErrorName() string {
+	return "RemoveConversationMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveConversationMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveConversationMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveConversationMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveConversationMemberResponseValidationError{}
+
+// Validate checks the field values on UpdateConversationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateConversationMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConversationMemberRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateConversationMemberRoleRequestMultiError, or nil if none found.
+func (m *UpdateConversationMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConversationMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = UpdateConversationMemberRoleRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdateConversationMemberRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetMemberId()); err != nil {
+		err = UpdateConversationMemberRoleRequestValidationError{
+			field:  "MemberId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateConversationMemberRoleRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := UpdateConversationMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [CONVERSATION_MEMBER_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ConversationMemberRole_name[int32(m.GetRole())]; !ok {
+		err := UpdateConversationMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateConversationMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateConversationMemberRoleRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateConversationMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateConversationMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConversationMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConversationMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConversationMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateConversationMemberRoleRequestValidationError is the validation error
+// returned by UpdateConversationMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type UpdateConversationMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConversationMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConversationMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConversationMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConversationMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConversationMemberRoleRequestValidationError) ErrorName() string {
+	return "UpdateConversationMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConversationMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConversationMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConversationMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConversationMemberRoleRequestValidationError{}
+
+var _UpdateConversationMemberRoleRequest_Role_NotInLookup = map[ConversationMemberRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdateConversationMemberRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateConversationMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConversationMemberRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateConversationMemberRoleResponseMultiError, or nil if none found.
+func (m *UpdateConversationMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConversationMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UpdateConversationMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConversationMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateConversationMemberRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConversationMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConversationMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConversationMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateConversationMemberRoleResponseValidationError is the validation error
+// returned by UpdateConversationMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type UpdateConversationMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConversationMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConversationMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConversationMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConversationMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConversationMemberRoleResponseValidationError) ErrorName() string {
+	return "UpdateConversationMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConversationMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConversationMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConversationMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConversationMemberRoleResponseValidationError{}
+
+// Validate checks the field values on LeaveConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveConversationRequestMultiError, or nil if none found.
+func (m *LeaveConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = LeaveConversationRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = LeaveConversationRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LeaveConversationRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// LeaveConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaveConversationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LeaveConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveConversationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveConversationRequestMultiError) AllErrors() []error { return m }
+
+// LeaveConversationRequestValidationError is the validation error returned by
+// LeaveConversationRequest.Validate if the designated constraints aren't met.
+type LeaveConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveConversationRequestValidationError) ErrorName() string {
+	return "LeaveConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveConversationRequestValidationError{}
+
+// Validate checks the field values on LeaveConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveConversationResponseMultiError, or nil if none found.
+func (m *LeaveConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return LeaveConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveConversationResponseMultiError is an error wrapping multiple validation
+// errors returned by LeaveConversationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type LeaveConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveConversationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveConversationResponseMultiError) AllErrors() []error { return m }
+
+// LeaveConversationResponseValidationError is the validation error returned by
+// LeaveConversationResponse.Validate if the designated constraints aren't met.
+type LeaveConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveConversationResponseValidationError) ErrorName() string {
+	return "LeaveConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveConversationResponseValidationError{}
+
+// Validate checks the field values on SetMessageTTLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMessageTTLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMessageTTLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMessageTTLRequestMultiError, or nil if none found.
+func (m *SetMessageTTLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMessageTTLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = SetMessageTTLRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetMessageTTLRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTtlSeconds(); val < 0 || val > 31536000 {
+		err := SetMessageTTLRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be inside range [0, 31536000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetMessageTTLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetMessageTTLRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetMessageTTLRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMessageTTLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMessageTTLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMessageTTLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMessageTTLRequestMultiError) AllErrors() []error { return m }
+
+// SetMessageTTLRequestValidationError is the validation error returned by
+// SetMessageTTLRequest.Validate if the designated constraints aren't met.
+type SetMessageTTLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMessageTTLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMessageTTLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMessageTTLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMessageTTLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMessageTTLRequestValidationError) ErrorName() string {
+	return "SetMessageTTLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMessageTTLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMessageTTLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMessageTTLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMessageTTLRequestValidationError{}
+
+// Validate checks the field values on SetMessageTTLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMessageTTLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMessageTTLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMessageTTLResponseMultiError, or nil if none found.
+func (m *SetMessageTTLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMessageTTLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConversation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetMessageTTLResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetMessageTTLResponseValidationError{
+					field:  "Conversation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConversation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetMessageTTLResponseValidationError{
+				field:  "Conversation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetMessageTTLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMessageTTLResponseMultiError is an error wrapping multiple validation
+// errors returned by SetMessageTTLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetMessageTTLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMessageTTLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMessageTTLResponseMultiError) AllErrors() []error { return m }
+
+// SetMessageTTLResponseValidationError is the validation error returned by
+// SetMessageTTLResponse.Validate if the designated constraints aren't met.
+type SetMessageTTLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMessageTTLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMessageTTLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMessageTTLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMessageTTLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMessageTTLResponseValidationError) ErrorName() string {
+	return "SetMessageTTLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMessageTTLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMessageTTLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMessageTTLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMessageTTLResponseValidationError{}
+
+// Validate checks the field values on SetConversationArchivedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConversationArchivedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConversationArchivedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetConversationArchivedRequestMultiError, or nil if none found.
+func (m *SetConversationArchivedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConversationArchivedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = SetConversationArchivedRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetConversationArchivedRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Archived
+
+	if len(errors) > 0 {
+		return SetConversationArchivedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetConversationArchivedRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetConversationArchivedRequestMultiError is an error wrapping multiple
+// validation errors returned by SetConversationArchivedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetConversationArchivedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConversationArchivedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConversationArchivedRequestMultiError) AllErrors() []error { return m }
+
+// SetConversationArchivedRequestValidationError is the validation error
+// returned by SetConversationArchivedRequest.Validate if the designated
+// constraints aren't met.
+type SetConversationArchivedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConversationArchivedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConversationArchivedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConversationArchivedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConversationArchivedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConversationArchivedRequestValidationError) ErrorName() string {
+	return "SetConversationArchivedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConversationArchivedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConversationArchivedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConversationArchivedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConversationArchivedRequestValidationError{}
+
+// Validate checks the field values on SetConversationArchivedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConversationArchivedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConversationArchivedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetConversationArchivedResponseMultiError, or nil if none found.
+func (m *SetConversationArchivedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConversationArchivedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetConversationArchivedResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetConversationArchivedResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetConversationArchivedResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetConversationArchivedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetConversationArchivedResponseMultiError is an error wrapping multiple
+// validation errors returned by SetConversationArchivedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetConversationArchivedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConversationArchivedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConversationArchivedResponseMultiError) AllErrors() []error { return m }
+
+// SetConversationArchivedResponseValidationError is the validation error
+// returned by SetConversationArchivedResponse.Validate if the designated
+// constraints aren't met.
+type SetConversationArchivedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConversationArchivedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConversationArchivedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConversationArchivedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConversationArchivedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConversationArchivedResponseValidationError) ErrorName() string {
+	return "SetConversationArchivedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConversationArchivedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConversationArchivedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConversationArchivedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConversationArchivedResponseValidationError{}
+
+// Validate checks the field values on SetConversationMutedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConversationMutedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConversationMutedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetConversationMutedRequestMultiError, or nil if none found.
+func (m *SetConversationMutedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConversationMutedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = SetConversationMutedRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetConversationMutedRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Muted
+
+	if m.GetMutedUntil() < 0 {
+		err := SetConversationMutedRequestValidationError{
+			field:  "MutedUntil",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetConversationMutedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetConversationMutedRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetConversationMutedRequestMultiError is an error wrapping multiple
+// validation errors returned by SetConversationMutedRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetConversationMutedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConversationMutedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConversationMutedRequestMultiError) AllErrors() []error { return m }
+
+// SetConversationMutedRequestValidationError is the validation error returned
+// by SetConversationMutedRequest.Validate if the designated constraints
+// aren't met.
+type SetConversationMutedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConversationMutedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConversationMutedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConversationMutedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConversationMutedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConversationMutedRequestValidationError) ErrorName() string {
+	return "SetConversationMutedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConversationMutedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConversationMutedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConversationMutedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConversationMutedRequestValidationError{}
+
+// Validate checks the field values on SetConversationMutedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConversationMutedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConversationMutedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetConversationMutedResponseMultiError, or nil if none found.
+func (m *SetConversationMutedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConversationMutedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetConversationMutedResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetConversationMutedResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetConversationMutedResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetConversationMutedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetConversationMutedResponseMultiError is an error wrapping multiple
+// validation errors returned by SetConversationMutedResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetConversationMutedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConversationMutedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConversationMutedResponseMultiError) AllErrors() []error { return m }
+
+// SetConversationMutedResponseValidationError is the validation error returned
+// by SetConversationMutedResponse.Validate if the designated constraints
+// aren't met.
+type SetConversationMutedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConversationMutedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConversationMutedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConversationMutedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConversationMutedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConversationMutedResponseValidationError) ErrorName() string {
+	return "SetConversationMutedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConversationMutedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConversationMutedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConversationMutedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConversationMutedResponseValidationError{}
+
+// Validate checks the field values on MarkConversationUnreadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationUnreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationUnreadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkConversationUnreadRequestMultiError, or nil if none found.
+func (m *MarkConversationUnreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationUnreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = MarkConversationUnreadRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = MarkConversationUnreadRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkConversationUnreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MarkConversationUnreadRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MarkConversationUnreadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationUnreadRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MarkConversationUnreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationUnreadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationUnreadRequestMultiError) AllErrors() []error { return m }
+
+// MarkConversationUnreadRequestValidationError is the validation error
+// returned by MarkConversationUnreadRequest.Validate if the designated
+// constraints aren't met.
+type MarkConversationUnreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationUnreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationUnreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationUnreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationUnreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationUnreadRequestValidationError) ErrorName() string {
+	return "MarkConversationUnreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationUnreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationUnreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationUnreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationUnreadRequestValidationError{}
+
+// Validate checks the field values on MarkConversationUnreadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationUnreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationUnreadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkConversationUnreadResponseMultiError, or nil if none found.
+func (m *MarkConversationUnreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationUnreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadConversations
+
+	if len(errors) > 0 {
+		return MarkConversationUnreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationUnreadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationUnreadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MarkConversationUnreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationUnreadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationUnreadResponseMultiError) AllErrors() []error { return m }
+
+// MarkConversationUnreadResponseValidationError is the validation error
+// returned by MarkConversationUnreadResponse.Validate if the designated
+// constraints aren't met.
+type MarkConversationUnreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationUnreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationUnreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationUnreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationUnreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationUnreadResponseValidationError) ErrorName() string {
+	return "MarkConversationUnreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationUnreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationUnreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationUnreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationUnreadResponseValidationError{}
+
+// Validate checks the field values on SetTypingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetTypingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTypingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTypingRequestMultiError, or nil if none found.
+func (m *SetTypingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTypingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = SetTypingRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetTypingRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Typing
+
+	if len(errors) > 0 {
+		return SetTypingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetTypingRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetTypingRequestMultiError is an error wrapping multiple validation errors
+// returned by SetTypingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetTypingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTypingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTypingRequestMultiError) AllErrors() []error { return m }
+
+// SetTypingRequestValidationError is the validation error returned by
+// SetTypingRequest.Validate if the designated constraints aren't met.
+type SetTypingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTypingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTypingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTypingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTypingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTypingRequestValidationError) ErrorName() string { return "SetTypingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetTypingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTypingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTypingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTypingRequestValidationError{}
+
+// Validate checks the field values on SetTypingResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetTypingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTypingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTypingResponseMultiError, or nil if none found.
+func (m *SetTypingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTypingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetTypingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTypingResponseMultiError is an error wrapping multiple validation errors
+// returned by SetTypingResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetTypingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTypingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTypingResponseMultiError) AllErrors() []error { return m }
+
+// SetTypingResponseValidationError is the validation error returned by
+// SetTypingResponse.Validate if the designated constraints aren't met.
+type SetTypingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTypingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTypingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTypingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTypingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTypingResponseValidationError) ErrorName() string {
+	return "SetTypingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTypingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTypingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTypingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTypingResponseValidationError{}
+
+// Validate checks the field values on UpdatePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePresenceRequestMultiError, or nil if none found.
+func (m *UpdatePresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdatePresenceRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Online
+
+	if len(errors) > 0 {
+		return UpdatePresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePresenceRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePresenceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePresenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePresenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePresenceRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePresenceRequestValidationError is the validation error returned by
+// UpdatePresenceRequest.Validate if the designated constraints aren't met.
+type UpdatePresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePresenceRequestValidationError) ErrorName() string {
+	return "UpdatePresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePresenceRequestValidationError{}
+
+// Validate checks the field values on UpdatePresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePresenceResponseMultiError, or nil if none found.
+func (m *UpdatePresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HeartbeatIntervalSeconds
+
+	if len(errors) > 0 {
+		return UpdatePresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePresenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePresenceResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePresenceResponseValidationError is the validation error returned by
+// UpdatePresenceResponse.Validate if the designated constraints aren't met.
+type UpdatePresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePresenceResponseValidationError) ErrorName() string {
+	return "UpdatePresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePresenceResponseValidationError{}
+
+// Validate checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceRequestMultiError, or nil if none found.
+func (m *GetPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetPresenceRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := GetPresenceRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = GetPresenceRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPresenceRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceRequestValidationError is the validation error returned by
+// GetPresenceRequest.Validate if the designated constraints aren't met.
+type GetPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceRequestValidationError) ErrorName() string {
+	return "GetPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceRequestValidationError{}
+
+// Validate checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceResponseMultiError, or nil if none found.
+func (m *GetPresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPresences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPresenceResponseValidationError{
+					field:  fmt.Sprintf("Presences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceResponseMultiError) AllErrors() []error { return m }
+
+// GetPresenceResponseValidationError is the validation error returned by
+// GetPresenceResponse.Validate if the designated constraints aren't met.
+type GetPresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceResponseValidationError) ErrorName() string {
+	return "GetPresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceResponseValidationError{}
+
+// Validate checks the field values on UserPresence with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPresence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPresence with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPresenceMultiError, or
+// nil if none found.
+func (m *UserPresence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPresence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Online
+
+	// no validation rules for LastSeenAt
+
+	// no validation rules for LastSeenHidden
+
+	if len(errors) > 0 {
+		return UserPresenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPresenceMultiError is an error wrapping multiple validation errors
+// returned by UserPresence.ValidateAll() if the designated constraints aren't met.
+type UserPresenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPresenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPresenceMultiError) AllErrors() []error { return m }
+
+// UserPresenceValidationError is the validation error returned by
+// UserPresence.Validate if the designated constraints aren't met.
+type UserPresenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPresenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPresenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPresenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPresenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPresenceValidationError) ErrorName() string { return "UserPresenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPresenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPresence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPresenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPresenceValidationError{}
+
+// Validate checks the field values on UpdatePresenceSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePresenceSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePresenceSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdatePresenceSettingsRequestMultiError, or nil if none found.
+func (m *UpdatePresenceSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePresenceSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdatePresenceSettingsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HideLastSeen
+
+	if len(errors) > 0 {
+		return UpdatePresenceSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePresenceSettingsRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePresenceSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdatePresenceSettingsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdatePresenceSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePresenceSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePresenceSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePresenceSettingsRequestValidationError is the validation error
+// returned by UpdatePresenceSettingsRequest.Validate if the designated
+// constraints aren't met.
+type UpdatePresenceSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePresenceSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePresenceSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePresenceSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePresenceSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePresenceSettingsRequestValidationError) ErrorName() string {
+	return "UpdatePresenceSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePresenceSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePresenceSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePresenceSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePresenceSettingsRequestValidationError{}
+
+// Validate checks the field values on UpdatePresenceSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePresenceSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePresenceSettingsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdatePresenceSettingsResponseMultiError, or nil if none found.
+func (m *UpdatePresenceSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePresenceSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UpdatePresenceSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePresenceSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdatePresenceSettingsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdatePresenceSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePresenceSettingsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePresenceSettingsResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePresenceSettingsResponseValidationError is the validation error
+// returned by UpdatePresenceSettingsResponse.Validate if the designated
+// constraints aren't met.
+type UpdatePresenceSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePresenceSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePresenceSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePresenceSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePresenceSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePresenceSettingsResponseValidationError) ErrorName() string {
+	return "UpdatePresenceSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePresenceSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePresenceSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePresenceSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePresenceSettingsResponseValidationError{}
+
+// Validate checks the field values on UpdateMessagingSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMessagingSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMessagingSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMessagingSettingsRequestMultiError, or nil if none found.
+func (m *UpdateMessagingSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMessagingSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdateMessagingSettingsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateMessagingSettingsRequest_MessagingPolicy_NotInLookup[m.GetMessagingPolicy()]; ok {
+		err := UpdateMessagingSettingsRequestValidationError{
+			field:  "MessagingPolicy",
+			reason: "value must not be in list [MESSAGING_POLICY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MessagingPolicy_name[int32(m.GetMessagingPolicy())]; !ok {
+		err := UpdateMessagingSettingsRequestValidationError{
+			field:  "MessagingPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateMessagingSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateMessagingSettingsRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateMessagingSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMessagingSettingsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMessagingSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMessagingSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMessagingSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMessagingSettingsRequestValidationError is the validation error
+// returned by UpdateMessagingSettingsRequest.Validate if the designated
+// constraints aren't met.
+type UpdateMessagingSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMessagingSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMessagingSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMessagingSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMessagingSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMessagingSettingsRequestValidationError) ErrorName() string {
+	return "UpdateMessagingSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMessagingSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMessagingSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMessagingSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMessagingSettingsRequestValidationError{}
+
+var _UpdateMessagingSettingsRequest_MessagingPolicy_NotInLookup = map[MessagingPolicy]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdateMessagingSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMessagingSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMessagingSettingsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMessagingSettingsResponseMultiError, or nil if none found.
+func (m *UpdateMessagingSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMessagingSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UpdateMessagingSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMessagingSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateMessagingSettingsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMessagingSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMessagingSettingsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMessagingSettingsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMessagingSettingsResponseValidationError is the validation error
+// returned by UpdateMessagingSettingsResponse.Validate if the designated
+// constraints aren't met.
+type UpdateMessagingSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMessagingSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMessagingSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMessagingSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMessagingSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMessagingSettingsResponseValidationError) ErrorName() string {
+	return "UpdateMessagingSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMessagingSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMessagingSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMessagingSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMessagingSettingsResponseValidationError{}
+
+// Validate checks the field values on GetMessagingSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessagingSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessagingSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessagingSettingsRequestMultiError, or nil if none found.
+func (m *GetMessagingSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessagingSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetMessagingSettingsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMessagingSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetMessagingSettingsRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetMessagingSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMessagingSettingsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessagingSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessagingSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessagingSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetMessagingSettingsRequestValidationError is the validation error returned
+// by GetMessagingSettingsRequest.Validate if the designated constraints
+// aren't met.
+type GetMessagingSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessagingSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessagingSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessagingSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessagingSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessagingSettingsRequestValidationError) ErrorName() string {
+	return "GetMessagingSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessagingSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessagingSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessagingSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessagingSettingsRequestValidationError{}
+
+// Validate checks the field values on GetMessagingSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessagingSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessagingSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessagingSettingsResponseMultiError, or nil if none found.
+func (m *GetMessagingSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessagingSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessagingPolicy
+
+	if len(errors) > 0 {
+		return GetMessagingSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessagingSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMessagingSettingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessagingSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessagingSettingsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessagingSettingsResponseMultiError) AllErrors() []error { return m }
+
+// GetMessagingSettingsResponseValidationError is the validation error returned
+// by GetMessagingSettingsResponse.Validate if the designated constraints
+// aren't met.
+type GetMessagingSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessagingSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessagingSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessagingSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessagingSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessagingSettingsResponseValidationError) ErrorName() string {
+	return "GetMessagingSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessagingSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessagingSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessagingSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessagingSettingsResponseValidationError{}
+
+// Validate checks the field values on PinMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageRequestMultiError, or nil if none found.
+func (m *PinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = PinMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = PinMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PinMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PinMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by PinMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageRequestMultiError) AllErrors() []error { return m }
+
+// PinMessageRequestValidationError is the validation error returned by
+// PinMessageRequest.Validate if the designated constraints aren't met.
+type PinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageRequestValidationError) ErrorName() string {
+	return "PinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageRequestValidationError{}
+
+// Validate checks the field values on PinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageResponseMultiError, or nil if none found.
+func (m *PinMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPinnedMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PinMessageResponseValidationError{
+					field:  "PinnedMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PinMessageResponseValidationError{
+					field:  "PinnedMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPinnedMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PinMessageResponseValidationError{
+				field:  "PinnedMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PinMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinMessageResponseMultiError is an error wrapping multiple validation errors
+// returned by PinMessageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageResponseMultiError) AllErrors() []error { return m }
+
+// PinMessageResponseValidationError is the validation error returned by
+// PinMessageResponse.Validate if the designated constraints aren't met.
+type PinMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageResponseValidationError) ErrorName() string {
+	return "PinMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageResponseValidationError{}
+
+// Validate checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageRequestMultiError, or nil if none found.
+func (m *UnpinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = UnpinMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnpinMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnpinMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnpinMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageRequestMultiError) AllErrors() []error { return m }
+
+// UnpinMessageRequestValidationError is the validation error returned by
+// UnpinMessageRequest.Validate if the designated constraints aren't met.
+type UnpinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageRequestValidationError) ErrorName() string {
+	return "UnpinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageRequestValidationError{}
+
+// Validate checks the field values on UnpinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageResponseMultiError, or nil if none found.
+func (m *UnpinMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UnpinMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageResponseMultiError) AllErrors() []error { return m }
+
+// UnpinMessageResponseValidationError is the validation error returned by
+// UnpinMessageResponse.Validate if the designated constraints aren't met.
+type UnpinMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageResponseValidationError) ErrorName() string {
+	return "UnpinMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageResponseValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesRequestMultiError, or nil if none found.
+func (m *ListPinnedMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = ListPinnedMessagesRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListPinnedMessagesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPinnedMessagesRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPinnedMessagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPinnedMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesRequestValidationError is the validation error returned by
+// ListPinnedMessagesRequest.Validate if the designated constraints aren't met.
+type ListPinnedMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesRequestValidationError) ErrorName() string {
+	return "ListPinnedMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesRequestValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesResponseMultiError, or nil if none found.
+func (m *ListPinnedMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPinnedMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("PinnedMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("PinnedMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPinnedMessagesResponseValidationError{
+					field:  fmt.Sprintf("PinnedMessages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPinnedMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPinnedMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesResponseValidationError is the validation error returned
+// by ListPinnedMessagesResponse.Validate if the designated constraints aren't met.
+type ListPinnedMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesResponseValidationError) ErrorName() string {
+	return "ListPinnedMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesResponseValidationError{}
+
+// Validate checks the field values on PinnedMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PinnedMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinnedMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PinnedMessageMultiError, or
+// nil if none found.
+func (m *PinnedMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinnedMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PinnedMessageValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PinnedBy
+
+	// no validation rules for PinnedAt
+
+	if len(errors) > 0 {
+		return PinnedMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinnedMessageMultiError is an error wrapping multiple validation errors
+// returned by PinnedMessage.ValidateAll() if the designated constraints
+// aren't met.
+type PinnedMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinnedMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinnedMessageMultiError) AllErrors() []error { return m }
+
+// PinnedMessageValidationError is the validation error returned by
+// PinnedMessage.Validate if the designated constraints aren't met.
+type PinnedMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinnedMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinnedMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinnedMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinnedMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinnedMessageValidationError) ErrorName() string { return "PinnedMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PinnedMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinnedMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinnedMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinnedMessageValidationError{}
+
+// Validate checks the field values on ListScheduledMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledMessagesRequestMultiError, or nil if none found.
+func (m *ListScheduledMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListScheduledMessagesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListScheduledMessagesRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListScheduledMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledMessagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListScheduledMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListScheduledMessagesRequestValidationError is the validation error returned
+// by ListScheduledMessagesRequest.Validate if the designated constraints
+// aren't met.
+type ListScheduledMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledMessagesRequestValidationError) ErrorName() string {
+	return "ListScheduledMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledMessagesRequestValidationError{}
+
+// Validate checks the field values on ListScheduledMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledMessagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListScheduledMessagesResponseMultiError, or nil if none found.
+func (m *ListScheduledMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScheduledMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScheduledMessagesResponseValidationError{
+						field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScheduledMessagesResponseValidationError{
+						field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScheduledMessagesResponseValidationError{
+					field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledMessagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListScheduledMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListScheduledMessagesResponseValidationError is the validation error
+// returned by ListScheduledMessagesResponse.Validate if the designated
+// constraints aren't met.
+type ListScheduledMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledMessagesResponseValidationError) ErrorName() string {
+	return "ListScheduledMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledMessagesResponseValidationError{}
+
+// Validate checks the field values on CancelScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledMessageRequestMultiError, or nil if none found.
+func (m *CancelScheduledMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = CancelScheduledMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetScheduledMessageId()); err != nil {
+		err = CancelScheduledMessageRequestValidationError{
+			field:  "ScheduledMessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelScheduledMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelScheduledMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelScheduledMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledMessageRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledMessageRequestValidationError is the validation error
+// returned by CancelScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledMessageRequestValidationError) ErrorName() string {
+	return "CancelScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledMessageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledMessageResponseMultiError, or nil if none found.
+func (m *CancelScheduledMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return CancelScheduledMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledMessageResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledMessageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledMessageResponseMultiError) AllErrors() []error { return m }
+
+// CancelScheduledMessageResponseValidationError is the validation error
+// returned by CancelScheduledMessageResponse.Validate if the designated
+// constraints aren't met.
+type CancelScheduledMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledMessageResponseValidationError) ErrorName() string {
+	return "CancelScheduledMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledMessageResponseValidationError{}
+
+// Validate checks the field values on RescheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleMessageRequestMultiError, or nil if none found.
+func (m *RescheduleMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RescheduleMessageRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetScheduledMessageId()); err != nil {
+		err = RescheduleMessageRequestValidationError{
+			field:  "ScheduledMessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSendAt() <= 0 {
+		err := RescheduleMessageRequestValidationError{
+			field:  "SendAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RescheduleMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RescheduleMessageRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RescheduleMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by RescheduleMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RescheduleMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleMessageRequestMultiError) AllErrors() []error { return m }
+
+// RescheduleMessageRequestValidationError is the validation error returned by
+// RescheduleMessageRequest.Validate if the designated constraints aren't met.
+type RescheduleMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleMessageRequestValidationError) ErrorName() string {
+	return "RescheduleMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleMessageRequestValidationError{}
+
+// Validate checks the field values on RescheduleMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleMessageResponseMultiError, or nil if none found.
+func (m *RescheduleMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScheduledMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RescheduleMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RescheduleMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RescheduleMessageResponseValidationError{
+				field:  "ScheduledMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RescheduleMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by RescheduleMessageResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RescheduleMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleMessageResponseMultiError) AllErrors() []error { return m }
+
+// RescheduleMessageResponseValidationError is the validation error returned by
+// RescheduleMessageResponse.Validate if the designated constraints aren't met.
+type RescheduleMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleMessageResponseValidationError) ErrorName() string {
+	return "RescheduleMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleMessageResponseValidationError{}
+
+// Validate checks the field values on ExportConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportConversationRequestMultiError, or nil if none found.
+func (m *ExportConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetConversationId()); err != nil {
+		err = ExportConversationRequestValidationError{
+			field:  "ConversationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ExportConversationRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportConversationRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportConversationRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [EXPORT_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportConversationRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportConversationRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportConversationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ExportConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportConversationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportConversationRequestMultiError) AllErrors() []error { return m }
+
+// ExportConversationRequestValidationError is the validation error returned by
+// ExportConversationRequest.Validate if the designated constraints aren't met.
+type ExportConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportConversationRequestValidationError) ErrorName() string {
+	return "ExportConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportConversationRequestValidationError{}
+
+var _ExportConversationRequest_Format_NotInLookup = map[ExportFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExportConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportConversationResponseMultiError, or nil if none found.
+func (m *ExportConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *ExportConversationResponse_Info:
+		if v == nil {
+			err := ExportConversationResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportConversationResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportConversationResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportConversationResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExportConversationResponse_Chunk:
+		if v == nil {
+			err := ExportConversationResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ExportConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportConversationResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportConversationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportConversationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportConversationResponseMultiError) AllErrors() []error { return m }
+
+// ExportConversationResponseValidationError is the validation error returned
+// by ExportConversationResponse.Validate if the designated constraints aren't met.
+type ExportConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportConversationResponseValidationError) ErrorName() string {
+	return "ExportConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportConversationResponseValidationError{}
+
+// Validate checks the field values on ExportInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportInfoMultiError, or
+// nil if none found.
+func (m *ExportInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for MimeType
+
+	if len(errors) > 0 {
+		return ExportInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportInfoMultiError is an error wrapping multiple validation errors
+// returned by ExportInfo.ValidateAll() if the designated constraints aren't met.
+type ExportInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportInfoMultiError) AllErrors() []error { return m }
+
+// ExportInfoValidationError is the validation error returned by
+// ExportInfo.Validate if the designated constraints aren't met.
+type ExportInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportInfoValidationError) ErrorName() string { return "ExportInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportInfoValidationError{}
+
+// Validate checks the field values on GetUpdatesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUpdatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUpdatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUpdatesRequestMultiError, or nil if none found.
+func (m *GetUpdatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUpdatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUpdatesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSinceSeq() < 0 {
+		err := GetUpdatesRequestValidationError{
+			field:  "SinceSeq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 500 {
+		err := GetUpdatesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUpdatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUpdatesRequest) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUpdatesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUpdatesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUpdatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUpdatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUpdatesRequestMultiError) AllErrors() []error { return m }
+
+// GetUpdatesRequestValidationError is the validation error returned by
+// GetUpdatesRequest.Validate if the designated constraints aren't met.
+type GetUpdatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUpdatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUpdatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUpdatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUpdatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUpdatesRequestValidationError) ErrorName() string {
+	return "GetUpdatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUpdatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUpdatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUpdatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUpdatesRequestValidationError{}
+
+// Validate checks the field values on GetUpdatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUpdatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUpdatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUpdatesResponseMultiError, or nil if none found.
+func (m *GetUpdatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUpdatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUpdates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUpdatesResponseValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUpdatesResponseValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUpdatesResponseValidationError{
+					field:  fmt.Sprintf("Updates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LatestSeq
+
+	// no validation rules for HasMore
+
+	// no validation rules for ResyncRequired
+
+	if len(errors) > 0 {
+		return GetUpdatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUpdatesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUpdatesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUpdatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUpdatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUpdatesResponseMultiError) AllErrors() []error { return m }
+
+// GetUpdatesResponseValidationError is the validation error returned by
+// GetUpdatesResponse.Validate if the designated constraints aren't met.
+type GetUpdatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUpdatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUpdatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUpdatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUpdatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUpdatesResponseValidationError) ErrorName() string {
+	return "GetUpdatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUpdatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUpdatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUpdatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUpdatesResponseValidationError{}
+
+// Validate checks the field values on SyncUpdate with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncUpdate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncUpdate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncUpdateMultiError, or
+// nil if none found.
+func (m *SyncUpdate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncUpdate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for Type
+
+	// no validation rules for ConversationId
+
+	// no validation rules for MessageId
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncUpdateValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncUpdateValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncUpdateValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for MemberId
+
+	// no validation rules for Role
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return SyncUpdateMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncUpdateMultiError is an error wrapping multiple validation errors
+// returned by SyncUpdate.ValidateAll() if the designated constraints aren't met.
+type SyncUpdateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncUpdateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncUpdateMultiError) AllErrors() []error { return m }
+
+// SyncUpdateValidationError is the validation error returned by
+// SyncUpdate.Validate if the designated constraints aren't met.
+type SyncUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncUpdateValidationError) ErrorName() string { return "SyncUpdateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncUpdateValidationError{}
+
+// Validate checks the field values on ScheduledMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScheduledMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduledMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduledMessageMultiError, or nil if none found.
+func (m *ScheduledMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduledMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledMessageId
+
+	// no validation rules for SenderId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for Content
+
+	// no validation rules for ReplyToMessageId
+
+	// no validation rules for SendAt
+
+	// no validation rules for State
+
+	// no validation rules for FailureReason
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScheduledMessageValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScheduledMessageValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScheduledMessageValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScheduledMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduledMessageMultiError is an error wrapping multiple validation errors
+// returned by ScheduledMessage.ValidateAll() if the designated constraints
+// aren't met.
+type ScheduledMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduledMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduledMessageMultiError) AllErrors() []error { return m }
+
+// ScheduledMessageValidationError is the validation error returned by
+// ScheduledMessage.Validate if the designated constraints aren't met.
+type ScheduledMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledMessageValidationError) ErrorName() string { return "ScheduledMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduledMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledMessageValidationError{}
+
+// Validate checks the field values on TypingIndicator with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TypingIndicator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TypingIndicator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TypingIndicatorMultiError, or nil if none found.
+func (m *TypingIndicator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TypingIndicator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for UserId
+
+	// no validation rules for Typing
+
+	if len(errors) > 0 {
+		return TypingIndicatorMultiError(errors)
+	}
+
+	return nil
+}
+
+// TypingIndicatorMultiError is an error wrapping multiple validation errors
+// returned by TypingIndicator.ValidateAll() if the designated constraints
+// aren't met.
+type TypingIndicatorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TypingIndicatorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TypingIndicatorMultiError) AllErrors() []error { return m }
+
+// TypingIndicatorValidationError is the validation error returned by
+// TypingIndicator.Validate if the designated constraints aren't met.
+type TypingIndicatorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypingIndicatorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypingIndicatorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypingIndicatorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypingIndicatorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypingIndicatorValidationError) ErrorName() string { return "TypingIndicatorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypingIndicatorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTypingIndicator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TypingIndicatorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypingIndicatorValidationError{}
+
+// Validate checks the field values on Conversation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Conversation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Conversation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConversationMultiError, or
+// nil if none found.
+func (m *Conversation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Conversation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Type
+
+	// no validation rules for Title
+
+	// no validation rules for CreatorId
+
+	// no validation rules for CreatedAt
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConversationValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConversationValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConversationValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MessageTtlSeconds
+
+	if len(errors) > 0 {
+		return ConversationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationMultiError is an error wrapping multiple validation errors
+// returned by Conversation.ValidateAll() if the designated constraints aren't met.
+type ConversationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationMultiError) AllErrors() []error { return m }
+
+// ConversationValidationError is the validation error returned by
+// Conversation.Validate if the designated constraints aren't met.
+type ConversationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationValidationError) ErrorName() string { return "ConversationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConversationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationValidationError{}
+
+// Validate checks the field values on ConversationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationMemberMultiError, or nil if none found.
+func (m *ConversationMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for JoinedAt
+
+	if len(errors) > 0 {
+		return ConversationMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationMemberMultiError is an error wrapping multiple validation errors
+// returned by ConversationMember.ValidateAll() if the designated constraints
+// aren't met.
+type ConversationMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationMemberMultiError) AllErrors() []error { return m }
+
+// ConversationMemberValidationError is the validation error returned by
+// ConversationMember.Validate if the designated constraints aren't met.
+type ConversationMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationMemberValidationError) ErrorName() string {
+	return "ConversationMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationMemberValidationError{}
+
+// Validate checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Message) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MessageMultiError, or nil if none found.
+func (m *Message) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Message) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMessageId()); err != nil {
+		err = MessageValidationError{
+			field:  "MessageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSenderId()); err != nil {
+		err = MessageValidationError{
+			field:  "SenderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRecipientId() != "" {
+
+		if err := m._validateUuid(m.GetRecipientId()); err != nil {
+			err = MessageValidationError{
+				field:  "RecipientId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Status
+
+	// no validation rules for ConversationId
+
+	// no validation rules for Edited
+
+	// no validation rules for EditedAt
+
+	// no validation rules for Deleted
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReplyToMessageId
+
+	// no validation rules for ThreadRootId
+
+	// no validation rules for ReplyCount
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for SenderDeviceId
+
+	for idx, item := range m.GetEncryptedPayloads() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("EncryptedPayloads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DeliveredAt
+
+	// no validation rules for ReadAt
+
+	for idx, item := range m.GetMentions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Mentions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	switch v := m.Payload.(type) {
+	case *Message_Text:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetText()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Text",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Text",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetText()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "Text",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Message_Image:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetImage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Image",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Image",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Message_File:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFile()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "File",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "File",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Message_Location:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLocation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Location",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Location",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Message_Contact:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetContact()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Contact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "Contact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContact()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "Contact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Message_SystemEvent:
+		if v == nil {
+			err := MessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSystemEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "SystemEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  "SystemEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSystemEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  "SystemEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return MessageMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Message) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MessageMultiError is an error wrapping multiple validation errors returned
+// by Message.ValidateAll() if the designated constraints aren't met.
+type MessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageMultiError) AllErrors() []error { return m }
+
+// MessageValidationError is the validation error returned by Message.Validate
+// if the designated constraints aren't met.
+type MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageValidationError{}
+
+// Validate checks the field values on TextContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TextContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TextContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TextContentMultiError, or
+// nil if none found.
+func (m *TextContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TextContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 4096 {
+		err := TextContentValidationError{
+			field:  "Text",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEntities()) > 100 {
+		err := TextContentValidationError{
+			field:  "Entities",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TextContentValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TextContentValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TextContentValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TextContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// TextContentMultiError is an error wrapping multiple validation errors
+// returned by TextContent.ValidateAll() if the designated constraints aren't met.
+type TextContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TextContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TextContentMultiError) AllErrors() []error { return m }
+
+// TextContentValidationError is the validation error returned by
+// TextContent.Validate if the designated constraints aren't met.
+type TextContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TextContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TextContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TextContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TextContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TextContentValidationError) ErrorName() string { return "TextContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TextContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTextContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TextContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TextContentValidationError{}
+
+// Validate checks the field values on TextEntity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TextEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TextEntity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TextEntityMultiError, or
+// nil if none found.
+func (m *TextEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TextEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _TextEntity_Type_NotInLookup[m.GetType()]; ok {
+		err := TextEntityValidationError{
+			field:  "Type",
+			reason: "value must not be in list [TEXT_ENTITY_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TextEntityType_name[int32(m.GetType())]; !ok {
+		err := TextEntityValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := TextEntityValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLength() <= 0 {
+		err := TextEntityValidationError{
+			field:  "Length",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+			err := TextEntityValidationError{
+				field:  "Url",
+				reason: "value length must be at most 2048 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetUrl()); err != nil {
+			err = TextEntityValidationError{
+				field:  "Url",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := TextEntityValidationError{
+				field:  "Url",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 32 {
+		err := TextEntityValidationError{
+			field:  "Language",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TextEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// TextEntityMultiError is an error wrapping multiple validation errors
+// returned by TextEntity.ValidateAll() if the designated constraints aren't met.
+type TextEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TextEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TextEntityMultiError) AllErrors() []error { return m }
+
+// TextEntityValidationError is the validation error returned by
+// TextEntity.Validate if the designated constraints aren't met.
+type TextEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TextEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TextEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TextEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TextEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TextEntityValidationError) ErrorName() string { return "TextEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TextEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTextEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TextEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TextEntityValidationError{}
+
+var _TextEntity_Type_NotInLookup = map[TextEntityType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ImageContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageContentMultiError, or
+// nil if none found.
+func (m *ImageContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetAttachmentId()); err != nil {
+		err = ImageContentValidationError{
+			field:  "AttachmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCaption()) > 1024 {
+		err := ImageContentValidationError{
+			field:  "Caption",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImageContentMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ImageContent) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ImageContentMultiError is an error wrapping multiple validation errors
+// returned by ImageContent.ValidateAll() if the designated constraints aren't met.
+type ImageContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageContentMultiError) AllErrors() []error { return m }
+
+// ImageContentValidationError is the validation error returned by
+// ImageContent.Validate if the designated constraints aren't met.
+type ImageContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageContentValidationError) ErrorName() string { return "ImageContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageContentValidationError{}
+
+// Validate checks the field values on FileContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileContentMultiError, or
+// nil if none found.
+func (m *FileContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetAttachmentId()); err != nil {
+		err = FileContentValidationError{
+			field:  "AttachmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCaption()) > 1024 {
+		err := FileContentValidationError{
+			field:  "Caption",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FileContentMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FileContent) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FileContentMultiError is an error wrapping multiple validation errors
+// returned by FileContent.ValidateAll() if the designated constraints aren't met.
+type FileContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileContentMultiError) AllErrors() []error { return m }
+
+// FileContentValidationError is the validation error returned by
+// FileContent.Validate if the designated constraints aren't met.
+type FileContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileContentValidationError) ErrorName() string { return "FileContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileContentValidationError{}
+
+// Validate checks the field values on LocationContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocationContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocationContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocationContentMultiError, or nil if none found.
+func (m *LocationContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocationContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := LocationContentValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := LocationContentValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 256 {
+		err := LocationContentValidationError{
+			field:  "Title",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 512 {
+		err := LocationContentValidationError{
+			field:  "Address",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LocationContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationContentMultiError is an error wrapping multiple validation errors
+// returned by LocationContent.ValidateAll() if the designated constraints
+// aren't met.
+type LocationContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationContentMultiError) AllErrors() []error { return m }
+
+// LocationContentValidationError is the validation error returned by
+// LocationContent.Validate if the designated constraints aren't met.
+type LocationContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationContentValidationError) ErrorName() string { return "LocationContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocationContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationContentValidationError{}
+
+// Validate checks the field values on ContactContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContactContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContactContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContactContentMultiError,
+// or nil if none found.
+func (m *ContactContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContactContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := ContactContentValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPhoneNumber() != "" {
+
+		if !_ContactContent_PhoneNumber_Pattern.MatchString(m.GetPhoneNumber()) {
+			err := ContactContentValidationError{
+				field:  "PhoneNumber",
+				reason: "value does not match regex pattern \"^\\\\+?[0-9 ()-]{3,32}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = ContactContentValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = ContactContentValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetMessagesRequestValidationError{}
+	if len(errors) > 0 {
+		return ContactContentMultiError(errors)
+	}
 
-// Validate checks the field values on GetMessagesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMessagesResponse) Validate() error {
-	return m.validate(false)
+	return nil
 }
 
-// ValidateAll checks the field values on GetMessagesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetMessagesResponseMultiError, or nil if none found.
-func (m *GetMessagesResponse) ValidateAll() error {
-	return m.validate(true)
-}
+func (m *ContactContent) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-func (m *GetMessagesResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
 	}
 
-	var errors []error
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
 
-	for idx, item := range m.GetMessages() {
-		_, _ = idx, item
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetMessagesResponseValidationError{
-						field:  fmt.Sprintf("Messages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetMessagesResponseValidationError{
-						field:  fmt.Sprintf("Messages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetMessagesResponseValidationError{
-					field:  fmt.Sprintf("Messages[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
 			}
 		}
+	}
+
+	return nil
+}
 
+func (m *ContactContent) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
 	}
+	addr = a.Address
 
-	if len(errors) > 0 {
-		return GetMessagesResponseMultiError(errors)
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *ContactContent) _validateUuid(uuid string) error {
+	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// GetMessagesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetMessagesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetMessagesResponseMultiError []error
+// ContactContentMultiError is an error wrapping multiple validation errors
+// returned by ContactContent.ValidateAll() if the designated constraints
+// aren't met.
+type ContactContentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMessagesResponseMultiError) Error() string {
+func (m ContactContentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -539,11 +15570,11 @@ func (m GetMessagesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMessagesResponseMultiError) AllErrors() []error { return m }
+func (m ContactContentMultiError) AllErrors() []error { return m }
 
-// GetMessagesResponseValidationError is the validation error returned by
-// GetMessagesResponse.Validate if the designated constraints aren't met.
-type GetMessagesResponseValidationError struct {
+// ContactContentValidationError is the validation error returned by
+// ContactContent.Validate if the designated constraints aren't met.
+type ContactContentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -551,24 +15582,22 @@ type GetMessagesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMessagesResponseValidationError) Field() string { return e.field }
+func (e ContactContentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMessagesResponseValidationError) Reason() string { return e.reason }
+func (e ContactContentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMessagesResponseValidationError) Cause() error { return e.cause }
+func (e ContactContentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMessagesResponseValidationError) Key() bool { return e.key }
+func (e ContactContentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMessagesResponseValidationError) ErrorName() string {
-	return "GetMessagesResponseValidationError"
-}
+func (e ContactContentValidationError) ErrorName() string { return "ContactContentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetMessagesResponseValidationError) Error() string {
+func (e ContactContentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -580,14 +15609,14 @@ func (e GetMessagesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMessagesResponse.%s: %s%s",
+		"invalid %sContactContent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMessagesResponseValidationError{}
+var _ error = ContactContentValidationError{}
 
 var _ interface {
 	Field() string
@@ -595,78 +15624,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMessagesResponseValidationError{}
+} = ContactContentValidationError{}
 
-// Validate checks the field values on UpdateMessageStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+var _ContactContent_PhoneNumber_Pattern = regexp.MustCompile("^\\+?[0-9 ()-]{3,32}$")
+
+// Validate checks the field values on SystemEventContent with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMessageStatusRequest) Validate() error {
+func (m *SystemEventContent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMessageStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SystemEventContent with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateMessageStatusRequestMultiError, or nil if none found.
-func (m *UpdateMessageStatusRequest) ValidateAll() error {
+// SystemEventContentMultiError, or nil if none found.
+func (m *SystemEventContent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMessageStatusRequest) validate(all bool) error {
+func (m *SystemEventContent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetMessageId()); err != nil {
-		err = UpdateMessageStatusRequestValidationError{
-			field:  "MessageId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateUuid(m.GetUserId()); err != nil {
-		err = UpdateMessageStatusRequestValidationError{
-			field:  "UserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Type
 
-	// no validation rules for Status
+	// no validation rules for ActorId
 
-	if len(errors) > 0 {
-		return UpdateMessageStatusRequestMultiError(errors)
-	}
+	// no validation rules for MessageTtlSeconds
 
-	return nil
-}
+	// no validation rules for MessageId
 
-func (m *UpdateMessageStatusRequest) _validateUuid(uuid string) error {
-	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return SystemEventContentMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMessageStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateMessageStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateMessageStatusRequestMultiError []error
+// SystemEventContentMultiError is an error wrapping multiple validation errors
+// returned by SystemEventContent.ValidateAll() if the designated constraints
+// aren't met.
+type SystemEventContentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMessageStatusRequestMultiError) Error() string {
+func (m SystemEventContentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -675,11 +15680,11 @@ func (m UpdateMessageStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMessageStatusRequestMultiError) AllErrors() []error { return m }
+func (m SystemEventContentMultiError) AllErrors() []error { return m }
 
-// UpdateMessageStatusRequestValidationError is the validation error returned
-// by UpdateMessageStatusRequest.Validate if the designated constraints aren't met.
-type UpdateMessageStatusRequestValidationError struct {
+// SystemEventContentValidationError is the validation error returned by
+// SystemEventContent.Validate if the designated constraints aren't met.
+type SystemEventContentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -687,24 +15692,24 @@ type UpdateMessageStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMessageStatusRequestValidationError) Field() string { return e.field }
+func (e SystemEventContentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMessageStatusRequestValidationError) Reason() string { return e.reason }
+func (e SystemEventContentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMessageStatusRequestValidationError) Cause() error { return e.cause }
+func (e SystemEventContentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMessageStatusRequestValidationError) Key() bool { return e.key }
+func (e SystemEventContentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMessageStatusRequestValidationError) ErrorName() string {
-	return "UpdateMessageStatusRequestValidationError"
+func (e SystemEventContentValidationError) ErrorName() string {
+	return "SystemEventContentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateMessageStatusRequestValidationError) Error() string {
+func (e SystemEventContentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -716,14 +15721,14 @@ func (e UpdateMessageStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMessageStatusRequest.%s: %s%s",
+		"invalid %sSystemEventContent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMessageStatusRequestValidationError{}
+var _ error = SystemEventContentValidationError{}
 
 var _ interface {
 	Field() string
@@ -731,46 +15736,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMessageStatusRequestValidationError{}
+} = SystemEventContentValidationError{}
 
-// Validate checks the field values on UpdateMessageStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMessageStatusResponse) Validate() error {
+// Validate checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Mention) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMessageStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateMessageStatusResponseMultiError, or nil if none found.
-func (m *UpdateMessageStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MentionMultiError, or nil if none found.
+func (m *Mention) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMessageStatusResponse) validate(all bool) error {
+func (m *Mention) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for UserId
+
+	// no validation rules for Offset
+
+	// no validation rules for Length
 
 	if len(errors) > 0 {
-		return UpdateMessageStatusResponseMultiError(errors)
+		return MentionMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMessageStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateMessageStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateMessageStatusResponseMultiError []error
+// MentionMultiError is an error wrapping multiple validation errors returned
+// by Mention.ValidateAll() if the designated constraints aren't met.
+type MentionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMessageStatusResponseMultiError) Error() string {
+func (m MentionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -779,12 +15786,11 @@ func (m UpdateMessageStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMessageStatusResponseMultiError) AllErrors() []error { return m }
+func (m MentionMultiError) AllErrors() []error { return m }
 
-// UpdateMessageStatusResponseValidationError is the validation error returned
-// by UpdateMessageStatusResponse.Validate if the designated constraints
-// aren't met.
-type UpdateMessageStatusResponseValidationError struct {
+// MentionValidationError is the validation error returned by Mention.Validate
+// if the designated constraints aren't met.
+type MentionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -792,24 +15798,22 @@ type UpdateMessageStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMessageStatusResponseValidationError) Field() string { return e.field }
+func (e MentionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMessageStatusResponseValidationError) Reason() string { return e.reason }
+func (e MentionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMessageStatusResponseValidationError) Cause() error { return e.cause }
+func (e MentionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMessageStatusResponseValidationError) Key() bool { return e.key }
+func (e MentionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMessageStatusResponseValidationError) ErrorName() string {
-	return "UpdateMessageStatusResponseValidationError"
-}
+func (e MentionValidationError) ErrorName() string { return "MentionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateMessageStatusResponseValidationError) Error() string {
+func (e MentionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -821,14 +15825,14 @@ func (e UpdateMessageStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMessageStatusResponse.%s: %s%s",
+		"invalid %sMention.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMessageStatusResponseValidationError{}
+var _ error = MentionValidationError{}
 
 var _ interface {
 	Field() string
@@ -836,32 +15840,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMessageStatusResponseValidationError{}
+} = MentionValidationError{}
 
-// Validate checks the field values on Message with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Message) Validate() error {
+// Validate checks the field values on EncryptedPayload with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EncryptedPayload) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Message with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MessageMultiError, or nil if none found.
-func (m *Message) ValidateAll() error {
+// ValidateAll checks the field values on EncryptedPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EncryptedPayloadMultiError, or nil if none found.
+func (m *EncryptedPayload) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Message) validate(all bool) error {
+func (m *EncryptedPayload) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetMessageId()); err != nil {
-		err = MessageValidationError{
-			field:  "MessageId",
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EncryptedPayloadValidationError{
+			field:  "UserId",
 			reason: "value must be a valid UUID",
 			cause:  err,
 		}
@@ -871,9 +15876,9 @@ func (m *Message) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetSenderId()); err != nil {
-		err = MessageValidationError{
-			field:  "SenderId",
+	if err := m._validateUuid(m.GetDeviceId()); err != nil {
+		err = EncryptedPayloadValidationError{
+			field:  "DeviceId",
 			reason: "value must be a valid UUID",
 			cause:  err,
 		}
@@ -883,11 +15888,10 @@ func (m *Message) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetRecipientId()); err != nil {
-		err = MessageValidationError{
-			field:  "RecipientId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if _, ok := _EncryptedPayload_Type_NotInLookup[m.GetType()]; ok {
+		err := EncryptedPayloadValidationError{
+			field:  "Type",
+			reason: "value must not be in list [ENCRYPTED_PAYLOAD_TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -895,10 +15899,10 @@ func (m *Message) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetContent()) < 1 {
-		err := MessageValidationError{
-			field:  "Content",
-			reason: "value length must be at least 1 runes",
+	if _, ok := EncryptedPayloadType_name[int32(m.GetType())]; !ok {
+		err := EncryptedPayloadValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -906,18 +15910,25 @@ func (m *Message) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Timestamp
-
-	// no validation rules for Status
+	if l := len(m.GetCiphertext()); l < 1 || l > 65536 {
+		err := EncryptedPayloadValidationError{
+			field:  "Ciphertext",
+			reason: "value length must be between 1 and 65536 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return MessageMultiError(errors)
+		return EncryptedPayloadMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *Message) _validateUuid(uuid string) error {
+func (m *EncryptedPayload) _validateUuid(uuid string) error {
 	if matched := _messaging_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -925,12 +15936,13 @@ func (m *Message) _validateUuid(uuid string) error {
 	return nil
 }
 
-// MessageMultiError is an error wrapping multiple validation errors returned
-// by Message.ValidateAll() if the designated constraints aren't met.
-type MessageMultiError []error
+// EncryptedPayloadMultiError is an error wrapping multiple validation errors
+// returned by EncryptedPayload.ValidateAll() if the designated constraints
+// aren't met.
+type EncryptedPayloadMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MessageMultiError) Error() string {
+func (m EncryptedPayloadMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -939,11 +15951,11 @@ func (m MessageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MessageMultiError) AllErrors() []error { return m }
+func (m EncryptedPayloadMultiError) AllErrors() []error { return m }
 
-// MessageValidationError is the validation error returned by Message.Validate
-// if the designated constraints aren't met.
-type MessageValidationError struct {
+// EncryptedPayloadValidationError is the validation error returned by
+// EncryptedPayload.Validate if the designated constraints aren't met.
+type EncryptedPayloadValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -951,22 +15963,22 @@ type MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageValidationError) Field() string { return e.field }
+func (e EncryptedPayloadValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageValidationError) Reason() string { return e.reason }
+func (e EncryptedPayloadValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageValidationError) Cause() error { return e.cause }
+func (e EncryptedPayloadValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageValidationError) Key() bool { return e.key }
+func (e EncryptedPayloadValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+func (e EncryptedPayloadValidationError) ErrorName() string { return "EncryptedPayloadValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MessageValidationError) Error() string {
+func (e EncryptedPayloadValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -978,14 +15990,14 @@ func (e MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessage.%s: %s%s",
+		"invalid %sEncryptedPayload.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageValidationError{}
+var _ error = EncryptedPayloadValidationError{}
 
 var _ interface {
 	Field() string
@@ -993,4 +16005,8 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageValidationError{}
+} = EncryptedPayloadValidationError{}
+
+var _EncryptedPayload_Type_NotInLookup = map[EncryptedPayloadType]struct{}{
+	0: {},
+}
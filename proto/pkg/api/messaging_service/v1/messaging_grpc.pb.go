@@ -19,9 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessagingService_SendMessage_FullMethodName         = "/api.messaging_service.v1.MessagingService/SendMessage"
-	MessagingService_GetMessages_FullMethodName         = "/api.messaging_service.v1.MessagingService/GetMessages"
-	MessagingService_UpdateMessageStatus_FullMethodName = "/api.messaging_service.v1.MessagingService/UpdateMessageStatus"
+	MessagingService_SendMessage_FullMethodName                  = "/api.messaging_service.v1.MessagingService/SendMessage"
+	MessagingService_GetMessages_FullMethodName                  = "/api.messaging_service.v1.MessagingService/GetMessages"
+	MessagingService_UpdateMessageStatus_FullMethodName          = "/api.messaging_service.v1.MessagingService/UpdateMessageStatus"
+	MessagingService_MarkConversationRead_FullMethodName         = "/api.messaging_service.v1.MessagingService/MarkConversationRead"
+	MessagingService_StreamMessages_FullMethodName               = "/api.messaging_service.v1.MessagingService/StreamMessages"
+	MessagingService_EditMessage_FullMethodName                  = "/api.messaging_service.v1.MessagingService/EditMessage"
+	MessagingService_DeleteMessage_FullMethodName                = "/api.messaging_service.v1.MessagingService/DeleteMessage"
+	MessagingService_GetMessageEditHistory_FullMethodName        = "/api.messaging_service.v1.MessagingService/GetMessageEditHistory"
+	MessagingService_AddReaction_FullMethodName                  = "/api.messaging_service.v1.MessagingService/AddReaction"
+	MessagingService_RemoveReaction_FullMethodName               = "/api.messaging_service.v1.MessagingService/RemoveReaction"
+	MessagingService_ListReactions_FullMethodName                = "/api.messaging_service.v1.MessagingService/ListReactions"
+	MessagingService_GetThread_FullMethodName                    = "/api.messaging_service.v1.MessagingService/GetThread"
+	MessagingService_SearchMessages_FullMethodName               = "/api.messaging_service.v1.MessagingService/SearchMessages"
+	MessagingService_UploadAttachment_FullMethodName             = "/api.messaging_service.v1.MessagingService/UploadAttachment"
+	MessagingService_GetAttachmentURL_FullMethodName             = "/api.messaging_service.v1.MessagingService/GetAttachmentURL"
+	MessagingService_DownloadAttachment_FullMethodName           = "/api.messaging_service.v1.MessagingService/DownloadAttachment"
+	MessagingService_CreateConversation_FullMethodName           = "/api.messaging_service.v1.MessagingService/CreateConversation"
+	MessagingService_ListConversations_FullMethodName            = "/api.messaging_service.v1.MessagingService/ListConversations"
+	MessagingService_GetConversation_FullMethodName              = "/api.messaging_service.v1.MessagingService/GetConversation"
+	MessagingService_AddConversationMembers_FullMethodName       = "/api.messaging_service.v1.MessagingService/AddConversationMembers"
+	MessagingService_RemoveConversationMember_FullMethodName     = "/api.messaging_service.v1.MessagingService/RemoveConversationMember"
+	MessagingService_UpdateConversationMemberRole_FullMethodName = "/api.messaging_service.v1.MessagingService/UpdateConversationMemberRole"
+	MessagingService_LeaveConversation_FullMethodName            = "/api.messaging_service.v1.MessagingService/LeaveConversation"
+	MessagingService_SetMessageTTL_FullMethodName                = "/api.messaging_service.v1.MessagingService/SetMessageTTL"
+	MessagingService_SetConversationArchived_FullMethodName      = "/api.messaging_service.v1.MessagingService/SetConversationArchived"
+	MessagingService_SetConversationMuted_FullMethodName         = "/api.messaging_service.v1.MessagingService/SetConversationMuted"
+	MessagingService_MarkConversationUnread_FullMethodName       = "/api.messaging_service.v1.MessagingService/MarkConversationUnread"
+	MessagingService_SetTyping_FullMethodName                    = "/api.messaging_service.v1.MessagingService/SetTyping"
+	MessagingService_UpdatePresence_FullMethodName               = "/api.messaging_service.v1.MessagingService/UpdatePresence"
+	MessagingService_GetPresence_FullMethodName                  = "/api.messaging_service.v1.MessagingService/GetPresence"
+	MessagingService_UpdatePresenceSettings_FullMethodName       = "/api.messaging_service.v1.MessagingService/UpdatePresenceSettings"
+	MessagingService_UpdateMessagingSettings_FullMethodName      = "/api.messaging_service.v1.MessagingService/UpdateMessagingSettings"
+	MessagingService_GetMessagingSettings_FullMethodName         = "/api.messaging_service.v1.MessagingService/GetMessagingSettings"
+	MessagingService_PinMessage_FullMethodName                   = "/api.messaging_service.v1.MessagingService/PinMessage"
+	MessagingService_UnpinMessage_FullMethodName                 = "/api.messaging_service.v1.MessagingService/UnpinMessage"
+	MessagingService_ListPinnedMessages_FullMethodName           = "/api.messaging_service.v1.MessagingService/ListPinnedMessages"
+	MessagingService_ListScheduledMessages_FullMethodName        = "/api.messaging_service.v1.MessagingService/ListScheduledMessages"
+	MessagingService_CancelScheduledMessage_FullMethodName       = "/api.messaging_service.v1.MessagingService/CancelScheduledMessage"
+	MessagingService_RescheduleMessage_FullMethodName            = "/api.messaging_service.v1.MessagingService/RescheduleMessage"
+	MessagingService_ExportConversation_FullMethodName           = "/api.messaging_service.v1.MessagingService/ExportConversation"
+	MessagingService_GetUpdates_FullMethodName                   = "/api.messaging_service.v1.MessagingService/GetUpdates"
 )
 
 // MessagingServiceClient is the client API for MessagingService service.
@@ -36,6 +74,88 @@ type MessagingServiceClient interface {
 	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	// Обновление статуса сообщения
 	UpdateMessageStatus(ctx context.Context, in *UpdateMessageStatusRequest, opts ...grpc.CallOption) (*UpdateMessageStatusResponse, error)
+	// Отметка беседы прочитанной до сообщения, все более ранние сообщения считаются прочитанными
+	MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadResponse, error)
+	// Поток новых сообщений и изменений их статусов для пользователя.
+	// Только gRPC: требуется JWT владельца потока в метаданных authorization, клиенты подключаются к WebSocket шлюза
+	StreamMessages(ctx context.Context, in *StreamMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MessageEvent], error)
+	// Редактирование сообщения
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error)
+	// Удаление сообщения
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error)
+	// История правок сообщения
+	GetMessageEditHistory(ctx context.Context, in *GetMessageEditHistoryRequest, opts ...grpc.CallOption) (*GetMessageEditHistoryResponse, error)
+	// Добавление реакции на сообщение
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	// Удаление реакции с сообщения
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	// Список пользователей, отреагировавших на сообщение
+	ListReactions(ctx context.Context, in *ListReactionsRequest, opts ...grpc.CallOption) (*ListReactionsResponse, error)
+	// Ответы в ветке сообщения
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error)
+	// Полнотекстовый поиск по сообщениям бесед пользователя
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Загрузка файла для отправки в сообщении.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// Получение ссылки на скачивание вложения с ограниченным сроком действия
+	GetAttachmentURL(ctx context.Context, in *GetAttachmentURLRequest, opts ...grpc.CallOption) (*GetAttachmentURLResponse, error)
+	// Скачивание вложения по подписанной ссылке.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	// Создание групповой беседы
+	CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error)
+	// Список бесед пользователя, начиная с последней активности
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	// Получение беседы с участниками
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
+	// Добавление участников в беседу
+	AddConversationMembers(ctx context.Context, in *AddConversationMembersRequest, opts ...grpc.CallOption) (*AddConversationMembersResponse, error)
+	// Исключение участника из беседы
+	RemoveConversationMember(ctx context.Context, in *RemoveConversationMemberRequest, opts ...grpc.CallOption) (*RemoveConversationMemberResponse, error)
+	// Изменение роли участника беседы
+	UpdateConversationMemberRole(ctx context.Context, in *UpdateConversationMemberRoleRequest, opts ...grpc.CallOption) (*UpdateConversationMemberRoleResponse, error)
+	// Выход из беседы
+	LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error)
+	// Таймер исчезающих сообщений беседы. Действует на сообщения, отправленные после изменения
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+	// Перенос беседы в архив и возврат из архива. Настройка личная и не видна другим участникам
+	SetConversationArchived(ctx context.Context, in *SetConversationArchivedRequest, opts ...grpc.CallOption) (*SetConversationArchivedResponse, error)
+	// Отключение уведомлений о новых сообщениях беседы до времени или навсегда.
+	// Уведомления об упоминаниях приходят и в заглушенной беседе
+	SetConversationMuted(ctx context.Context, in *SetConversationMutedRequest, opts ...grpc.CallOption) (*SetConversationMutedResponse, error)
+	// Отметка беседы непрочитанной. Отметка снимается при следующем прочтении беседы
+	MarkConversationUnread(ctx context.Context, in *MarkConversationUnreadRequest, opts ...grpc.CallOption) (*MarkConversationUnreadResponse, error)
+	// Индикатор набора сообщения в беседе
+	SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*SetTypingResponse, error)
+	// Сигнал присутствия пользователя в сети
+	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error)
+	// Присутствие в сети для списка пользователей
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+	// Настройки видимости присутствия пользователя
+	UpdatePresenceSettings(ctx context.Context, in *UpdatePresenceSettingsRequest, opts ...grpc.CallOption) (*UpdatePresenceSettingsResponse, error)
+	// Изменение настроек личных сообщений: кто может писать пользователю
+	UpdateMessagingSettings(ctx context.Context, in *UpdateMessagingSettingsRequest, opts ...grpc.CallOption) (*UpdateMessagingSettingsResponse, error)
+	// Получение настроек личных сообщений
+	GetMessagingSettings(ctx context.Context, in *GetMessagingSettingsRequest, opts ...grpc.CallOption) (*GetMessagingSettingsResponse, error)
+	// Закрепление сообщения в беседе
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	// Открепление сообщения в беседе
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	// Закрепленные сообщения беседы
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	// Запланированные сообщения пользователя
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	// Отмена запланированного сообщения
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
+	// Перенос времени отправки запланированного сообщения
+	RescheduleMessage(ctx context.Context, in *RescheduleMessageRequest, opts ...grpc.CallOption) (*RescheduleMessageResponse, error)
+	// Выгрузка истории беседы участником или сотрудником поддержки.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportConversationResponse], error)
+	// Изменения для пользователя после известного клиенту номера журнала синхронизации.
+	// Клиент, вернувшийся в сеть, применяет их по порядку вместо полной загрузки бесед
+	GetUpdates(ctx context.Context, in *GetUpdatesRequest, opts ...grpc.CallOption) (*GetUpdatesResponse, error)
 }
 
 type messagingServiceClient struct {
@@ -76,6 +196,416 @@ func (c *messagingServiceClient) UpdateMessageStatus(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *messagingServiceClient) MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*MarkConversationReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationReadResponse)
+	err := c.cc.Invoke(ctx, MessagingService_MarkConversationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) StreamMessages(ctx context.Context, in *StreamMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MessageEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MessagingService_ServiceDesc.Streams[0], MessagingService_StreamMessages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMessagesRequest, MessageEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_StreamMessagesClient = grpc.ServerStreamingClient[MessageEvent]
+
+func (c *messagingServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetMessageEditHistory(ctx context.Context, in *GetMessageEditHistoryRequest, opts ...grpc.CallOption) (*GetMessageEditHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageEditHistoryResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetMessageEditHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, MessagingService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, MessagingService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) ListReactions(ctx context.Context, in *ListReactionsRequest, opts ...grpc.CallOption) (*ListReactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactionsResponse)
+	err := c.cc.Invoke(ctx, MessagingService_ListReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MessagingService_ServiceDesc.Streams[1], MessagingService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *messagingServiceClient) GetAttachmentURL(ctx context.Context, in *GetAttachmentURLRequest, opts ...grpc.CallOption) (*GetAttachmentURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentURLResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetAttachmentURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MessagingService_ServiceDesc.Streams[2], MessagingService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *messagingServiceClient) CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateConversationResponse)
+	err := c.cc.Invoke(ctx, MessagingService_CreateConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, MessagingService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) AddConversationMembers(ctx context.Context, in *AddConversationMembersRequest, opts ...grpc.CallOption) (*AddConversationMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddConversationMembersResponse)
+	err := c.cc.Invoke(ctx, MessagingService_AddConversationMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) RemoveConversationMember(ctx context.Context, in *RemoveConversationMemberRequest, opts ...grpc.CallOption) (*RemoveConversationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveConversationMemberResponse)
+	err := c.cc.Invoke(ctx, MessagingService_RemoveConversationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UpdateConversationMemberRole(ctx context.Context, in *UpdateConversationMemberRoleRequest, opts ...grpc.CallOption) (*UpdateConversationMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationMemberRoleResponse)
+	err := c.cc.Invoke(ctx, MessagingService_UpdateConversationMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveConversationResponse)
+	err := c.cc.Invoke(ctx, MessagingService_LeaveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMessageTTLResponse)
+	err := c.cc.Invoke(ctx, MessagingService_SetMessageTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) SetConversationArchived(ctx context.Context, in *SetConversationArchivedRequest, opts ...grpc.CallOption) (*SetConversationArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationArchivedResponse)
+	err := c.cc.Invoke(ctx, MessagingService_SetConversationArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) SetConversationMuted(ctx context.Context, in *SetConversationMutedRequest, opts ...grpc.CallOption) (*SetConversationMutedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationMutedResponse)
+	err := c.cc.Invoke(ctx, MessagingService_SetConversationMuted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) MarkConversationUnread(ctx context.Context, in *MarkConversationUnreadRequest, opts ...grpc.CallOption) (*MarkConversationUnreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationUnreadResponse)
+	err := c.cc.Invoke(ctx, MessagingService_MarkConversationUnread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*SetTypingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTypingResponse)
+	err := c.cc.Invoke(ctx, MessagingService_SetTyping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePresenceResponse)
+	err := c.cc.Invoke(ctx, MessagingService_UpdatePresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UpdatePresenceSettings(ctx context.Context, in *UpdatePresenceSettingsRequest, opts ...grpc.CallOption) (*UpdatePresenceSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePresenceSettingsResponse)
+	err := c.cc.Invoke(ctx, MessagingService_UpdatePresenceSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UpdateMessagingSettings(ctx context.Context, in *UpdateMessagingSettingsRequest, opts ...grpc.CallOption) (*UpdateMessagingSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMessagingSettingsResponse)
+	err := c.cc.Invoke(ctx, MessagingService_UpdateMessagingSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) GetMessagingSettings(ctx context.Context, in *GetMessagingSettingsRequest, opts ...grpc.CallOption) (*GetMessagingSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessagingSettingsResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetMessagingSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_ListScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) RescheduleMessage(ctx context.Context, in *RescheduleMessageRequest, opts ...grpc.CallOption) (*RescheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleMessageResponse)
+	err := c.cc.Invoke(ctx, MessagingService_RescheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceClient) ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportConversationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MessagingService_ServiceDesc.Streams[3], MessagingService_ExportConversation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportConversationRequest, ExportConversationResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_ExportConversationClient = grpc.ServerStreamingClient[ExportConversationResponse]
+
+func (c *messagingServiceClient) GetUpdates(ctx context.Context, in *GetUpdatesRequest, opts ...grpc.CallOption) (*GetUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUpdatesResponse)
+	err := c.cc.Invoke(ctx, MessagingService_GetUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessagingServiceServer is the server API for MessagingService service.
 // All implementations must embed UnimplementedMessagingServiceServer
 // for forward compatibility.
@@ -88,96 +618,944 @@ type MessagingServiceServer interface {
 	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
 	// Обновление статуса сообщения
 	UpdateMessageStatus(context.Context, *UpdateMessageStatusRequest) (*UpdateMessageStatusResponse, error)
+	// Отметка беседы прочитанной до сообщения, все более ранние сообщения считаются прочитанными
+	MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadResponse, error)
+	// Поток новых сообщений и изменений их статусов для пользователя.
+	// Только gRPC: требуется JWT владельца потока в метаданных authorization, клиенты подключаются к WebSocket шлюза
+	StreamMessages(*StreamMessagesRequest, grpc.ServerStreamingServer[MessageEvent]) error
+	// Редактирование сообщения
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
+	// Удаление сообщения
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error)
+	// История правок сообщения
+	GetMessageEditHistory(context.Context, *GetMessageEditHistoryRequest) (*GetMessageEditHistoryResponse, error)
+	// Добавление реакции на сообщение
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	// Удаление реакции с сообщения
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	// Список пользователей, отреагировавших на сообщение
+	ListReactions(context.Context, *ListReactionsRequest) (*ListReactionsResponse, error)
+	// Ответы в ветке сообщения
+	GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error)
+	// Полнотекстовый поиск по сообщениям бесед пользователя
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Загрузка файла для отправки в сообщении.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// Получение ссылки на скачивание вложения с ограниченным сроком действия
+	GetAttachmentURL(context.Context, *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error)
+	// Скачивание вложения по подписанной ссылке.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	// Создание групповой беседы
+	CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error)
+	// Список бесед пользователя, начиная с последней активности
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	// Получение беседы с участниками
+	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	// Добавление участников в беседу
+	AddConversationMembers(context.Context, *AddConversationMembersRequest) (*AddConversationMembersResponse, error)
+	// Исключение участника из беседы
+	RemoveConversationMember(context.Context, *RemoveConversationMemberRequest) (*RemoveConversationMemberResponse, error)
+	// Изменение роли участника беседы
+	UpdateConversationMemberRole(context.Context, *UpdateConversationMemberRoleRequest) (*UpdateConversationMemberRoleResponse, error)
+	// Выход из беседы
+	LeaveConversation(context.Context, *LeaveConversationRequest) (*LeaveConversationResponse, error)
+	// Таймер исчезающих сообщений беседы. Действует на сообщения, отправленные после изменения
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error)
+	// Перенос беседы в архив и возврат из архива. Настройка личная и не видна другим участникам
+	SetConversationArchived(context.Context, *SetConversationArchivedRequest) (*SetConversationArchivedResponse, error)
+	// Отключение уведомлений о новых сообщениях беседы до времени или навсегда.
+	// Уведомления об упоминаниях приходят и в заглушенной беседе
+	SetConversationMuted(context.Context, *SetConversationMutedRequest) (*SetConversationMutedResponse, error)
+	// Отметка беседы непрочитанной. Отметка снимается при следующем прочтении беседы
+	MarkConversationUnread(context.Context, *MarkConversationUnreadRequest) (*MarkConversationUnreadResponse, error)
+	// Индикатор набора сообщения в беседе
+	SetTyping(context.Context, *SetTypingRequest) (*SetTypingResponse, error)
+	// Сигнал присутствия пользователя в сети
+	UpdatePresence(context.Context, *UpdatePresenceRequest) (*UpdatePresenceResponse, error)
+	// Присутствие в сети для списка пользователей
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
+	// Настройки видимости присутствия пользователя
+	UpdatePresenceSettings(context.Context, *UpdatePresenceSettingsRequest) (*UpdatePresenceSettingsResponse, error)
+	// Изменение настроек личных сообщений: кто может писать пользователю
+	UpdateMessagingSettings(context.Context, *UpdateMessagingSettingsRequest) (*UpdateMessagingSettingsResponse, error)
+	// Получение настроек личных сообщений
+	GetMessagingSettings(context.Context, *GetMessagingSettingsRequest) (*GetMessagingSettingsResponse, error)
+	// Закрепление сообщения в беседе
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	// Открепление сообщения в беседе
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	// Закрепленные сообщения беседы
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	// Запланированные сообщения пользователя
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	// Отмена запланированного сообщения
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
+	// Перенос времени отправки запланированного сообщения
+	RescheduleMessage(context.Context, *RescheduleMessageRequest) (*RescheduleMessageResponse, error)
+	// Выгрузка истории беседы участником или сотрудником поддержки.
+	// Первое сообщение потока содержит сведения о файле, остальные — его содержимое
+	ExportConversation(*ExportConversationRequest, grpc.ServerStreamingServer[ExportConversationResponse]) error
+	// Изменения для пользователя после известного клиенту номера журнала синхронизации.
+	// Клиент, вернувшийся в сеть, применяет их по порядку вместо полной загрузки бесед
+	GetUpdates(context.Context, *GetUpdatesRequest) (*GetUpdatesResponse, error)
 	mustEmbedUnimplementedMessagingServiceServer()
 }
 
-// UnimplementedMessagingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMessagingServiceServer struct{}
+// UnimplementedMessagingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMessagingServiceServer struct{}
+
+func (UnimplementedMessagingServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (UnimplementedMessagingServiceServer) UpdateMessageStatus(context.Context, *UpdateMessageStatusRequest) (*UpdateMessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMessageStatus not implemented")
+}
+func (UnimplementedMessagingServiceServer) MarkConversationRead(context.Context, *MarkConversationReadRequest) (*MarkConversationReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationRead not implemented")
+}
+func (UnimplementedMessagingServiceServer) StreamMessages(*StreamMessagesRequest, grpc.ServerStreamingServer[MessageEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMessages not implemented")
+}
+func (UnimplementedMessagingServiceServer) EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetMessageEditHistory(context.Context, *GetMessageEditHistoryRequest) (*GetMessageEditHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageEditHistory not implemented")
+}
+func (UnimplementedMessagingServiceServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedMessagingServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedMessagingServiceServer) ListReactions(context.Context, *ListReactionsRequest) (*ListReactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactions not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedMessagingServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedMessagingServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetAttachmentURL(context.Context, *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentURL not implemented")
+}
+func (UnimplementedMessagingServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedMessagingServiceServer) CreateConversation(context.Context, *CreateConversationRequest) (*CreateConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConversation not implemented")
+}
+func (UnimplementedMessagingServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedMessagingServiceServer) AddConversationMembers(context.Context, *AddConversationMembersRequest) (*AddConversationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConversationMembers not implemented")
+}
+func (UnimplementedMessagingServiceServer) RemoveConversationMember(context.Context, *RemoveConversationMemberRequest) (*RemoveConversationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveConversationMember not implemented")
+}
+func (UnimplementedMessagingServiceServer) UpdateConversationMemberRole(context.Context, *UpdateConversationMemberRoleRequest) (*UpdateConversationMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversationMemberRole not implemented")
+}
+func (UnimplementedMessagingServiceServer) LeaveConversation(context.Context, *LeaveConversationRequest) (*LeaveConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveConversation not implemented")
+}
+func (UnimplementedMessagingServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedMessagingServiceServer) SetConversationArchived(context.Context, *SetConversationArchivedRequest) (*SetConversationArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationArchived not implemented")
+}
+func (UnimplementedMessagingServiceServer) SetConversationMuted(context.Context, *SetConversationMutedRequest) (*SetConversationMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationMuted not implemented")
+}
+func (UnimplementedMessagingServiceServer) MarkConversationUnread(context.Context, *MarkConversationUnreadRequest) (*MarkConversationUnreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationUnread not implemented")
+}
+func (UnimplementedMessagingServiceServer) SetTyping(context.Context, *SetTypingRequest) (*SetTypingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTyping not implemented")
+}
+func (UnimplementedMessagingServiceServer) UpdatePresence(context.Context, *UpdatePresenceRequest) (*UpdatePresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedMessagingServiceServer) UpdatePresenceSettings(context.Context, *UpdatePresenceSettingsRequest) (*UpdatePresenceSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresenceSettings not implemented")
+}
+func (UnimplementedMessagingServiceServer) UpdateMessagingSettings(context.Context, *UpdateMessagingSettingsRequest) (*UpdateMessagingSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMessagingSettings not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetMessagingSettings(context.Context, *GetMessagingSettingsRequest) (*GetMessagingSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagingSettings not implemented")
+}
+func (UnimplementedMessagingServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedMessagingServiceServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (UnimplementedMessagingServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) RescheduleMessage(context.Context, *RescheduleMessageRequest) (*RescheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMessage not implemented")
+}
+func (UnimplementedMessagingServiceServer) ExportConversation(*ExportConversationRequest, grpc.ServerStreamingServer[ExportConversationResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportConversation not implemented")
+}
+func (UnimplementedMessagingServiceServer) GetUpdates(context.Context, *GetUpdatesRequest) (*GetUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdates not implemented")
+}
+func (UnimplementedMessagingServiceServer) mustEmbedUnimplementedMessagingServiceServer() {}
+func (UnimplementedMessagingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMessagingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessagingServiceServer will
+// result in compilation errors.
+type UnsafeMessagingServiceServer interface {
+	mustEmbedUnimplementedMessagingServiceServer()
+}
+
+func RegisterMessagingServiceServer(s grpc.ServiceRegistrar, srv MessagingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMessagingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MessagingService_ServiceDesc, srv)
+}
+
+func _MessagingService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetMessages(ctx, req.(*GetMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_UpdateMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).UpdateMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_UpdateMessageStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).UpdateMessageStatus(ctx, req.(*UpdateMessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_MarkConversationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).MarkConversationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_MarkConversationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).MarkConversationRead(ctx, req.(*MarkConversationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_StreamMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessagingServiceServer).StreamMessages(m, &grpc.GenericServerStream[StreamMessagesRequest, MessageEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_StreamMessagesServer = grpc.ServerStreamingServer[MessageEvent]
+
+func _MessagingService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetMessageEditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageEditHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetMessageEditHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetMessageEditHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetMessageEditHistory(ctx, req.(*GetMessageEditHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_ListReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).ListReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_ListReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).ListReactions(ctx, req.(*ListReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MessagingServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _MessagingService_GetAttachmentURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetAttachmentURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetAttachmentURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetAttachmentURL(ctx, req.(*GetAttachmentURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessagingServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _MessagingService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).CreateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_CreateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_AddConversationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConversationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).AddConversationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_AddConversationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).AddConversationMembers(ctx, req.(*AddConversationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_RemoveConversationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveConversationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).RemoveConversationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_RemoveConversationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).RemoveConversationMember(ctx, req.(*RemoveConversationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_UpdateConversationMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).UpdateConversationMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_UpdateConversationMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).UpdateConversationMemberRole(ctx, req.(*UpdateConversationMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_LeaveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).LeaveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_LeaveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).LeaveConversation(ctx, req.(*LeaveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SetMessageTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_SetConversationArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SetConversationArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SetConversationArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SetConversationArchived(ctx, req.(*SetConversationArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_SetConversationMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationMutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SetConversationMuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SetConversationMuted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SetConversationMuted(ctx, req.(*SetConversationMutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_MarkConversationUnread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationUnreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).MarkConversationUnread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_MarkConversationUnread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).MarkConversationUnread(ctx, req.(*MarkConversationUnreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedMessagingServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+func _MessagingService_SetTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).SetTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_SetTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).SetTyping(ctx, req.(*SetTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMessagingServiceServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+
+func _MessagingService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).UpdatePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_UpdatePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMessagingServiceServer) UpdateMessageStatus(context.Context, *UpdateMessageStatusRequest) (*UpdateMessageStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMessageStatus not implemented")
+
+func _MessagingService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMessagingServiceServer) mustEmbedUnimplementedMessagingServiceServer() {}
-func (UnimplementedMessagingServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeMessagingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MessagingServiceServer will
-// result in compilation errors.
-type UnsafeMessagingServiceServer interface {
-	mustEmbedUnimplementedMessagingServiceServer()
+func _MessagingService_UpdatePresenceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).UpdatePresenceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_UpdatePresenceSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).UpdatePresenceSettings(ctx, req.(*UpdatePresenceSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMessagingServiceServer(s grpc.ServiceRegistrar, srv MessagingServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMessagingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MessagingService_UpdateMessagingSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMessagingSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MessagingService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).UpdateMessagingSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_UpdateMessagingSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).UpdateMessagingSettings(ctx, req.(*UpdateMessagingSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MessagingService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMessageRequest)
+func _MessagingService_GetMessagingSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagingSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessagingServiceServer).SendMessage(ctx, in)
+		return srv.(MessagingServiceServer).GetMessagingSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MessagingService_SendMessage_FullMethodName,
+		FullMethod: MessagingService_GetMessagingSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessagingServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+		return srv.(MessagingServiceServer).GetMessagingSettings(ctx, req.(*GetMessagingSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MessagingService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesRequest)
+func _MessagingService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessagingServiceServer).GetMessages(ctx, in)
+		return srv.(MessagingServiceServer).PinMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MessagingService_GetMessages_FullMethodName,
+		FullMethod: MessagingService_PinMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessagingServiceServer).GetMessages(ctx, req.(*GetMessagesRequest))
+		return srv.(MessagingServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MessagingService_UpdateMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMessageStatusRequest)
+func _MessagingService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessagingServiceServer).UpdateMessageStatus(ctx, in)
+		return srv.(MessagingServiceServer).UnpinMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MessagingService_UpdateMessageStatus_FullMethodName,
+		FullMethod: MessagingService_UnpinMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessagingServiceServer).UpdateMessageStatus(ctx, req.(*UpdateMessageStatusRequest))
+		return srv.(MessagingServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_ListScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_RescheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).RescheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_RescheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).RescheduleMessage(ctx, req.(*RescheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingService_ExportConversation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportConversationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessagingServiceServer).ExportConversation(m, &grpc.GenericServerStream[ExportConversationRequest, ExportConversationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MessagingService_ExportConversationServer = grpc.ServerStreamingServer[ExportConversationResponse]
+
+func _MessagingService_GetUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceServer).GetUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessagingService_GetUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceServer).GetUpdates(ctx, req.(*GetUpdatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -201,7 +1579,164 @@ var MessagingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMessageStatus",
 			Handler:    _MessagingService_UpdateMessageStatus_Handler,
 		},
+		{
+			MethodName: "MarkConversationRead",
+			Handler:    _MessagingService_MarkConversationRead_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _MessagingService_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _MessagingService_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "GetMessageEditHistory",
+			Handler:    _MessagingService_GetMessageEditHistory_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _MessagingService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _MessagingService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListReactions",
+			Handler:    _MessagingService_ListReactions_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _MessagingService_GetThread_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _MessagingService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "GetAttachmentURL",
+			Handler:    _MessagingService_GetAttachmentURL_Handler,
+		},
+		{
+			MethodName: "CreateConversation",
+			Handler:    _MessagingService_CreateConversation_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _MessagingService_ListConversations_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _MessagingService_GetConversation_Handler,
+		},
+		{
+			MethodName: "AddConversationMembers",
+			Handler:    _MessagingService_AddConversationMembers_Handler,
+		},
+		{
+			MethodName: "RemoveConversationMember",
+			Handler:    _MessagingService_RemoveConversationMember_Handler,
+		},
+		{
+			MethodName: "UpdateConversationMemberRole",
+			Handler:    _MessagingService_UpdateConversationMemberRole_Handler,
+		},
+		{
+			MethodName: "LeaveConversation",
+			Handler:    _MessagingService_LeaveConversation_Handler,
+		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _MessagingService_SetMessageTTL_Handler,
+		},
+		{
+			MethodName: "SetConversationArchived",
+			Handler:    _MessagingService_SetConversationArchived_Handler,
+		},
+		{
+			MethodName: "SetConversationMuted",
+			Handler:    _MessagingService_SetConversationMuted_Handler,
+		},
+		{
+			MethodName: "MarkConversationUnread",
+			Handler:    _MessagingService_MarkConversationUnread_Handler,
+		},
+		{
+			MethodName: "SetTyping",
+			Handler:    _MessagingService_SetTyping_Handler,
+		},
+		{
+			MethodName: "UpdatePresence",
+			Handler:    _MessagingService_UpdatePresence_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _MessagingService_GetPresence_Handler,
+		},
+		{
+			MethodName: "UpdatePresenceSettings",
+			Handler:    _MessagingService_UpdatePresenceSettings_Handler,
+		},
+		{
+			MethodName: "UpdateMessagingSettings",
+			Handler:    _MessagingService_UpdateMessagingSettings_Handler,
+		},
+		{
+			MethodName: "GetMessagingSettings",
+			Handler:    _MessagingService_GetMessagingSettings_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _MessagingService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _MessagingService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _MessagingService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _MessagingService_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _MessagingService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "RescheduleMessage",
+			Handler:    _MessagingService_RescheduleMessage_Handler,
+		},
+		{
+			MethodName: "GetUpdates",
+			Handler:    _MessagingService_GetUpdates_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMessages",
+			Handler:       _MessagingService_StreamMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _MessagingService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _MessagingService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportConversation",
+			Handler:       _MessagingService_ExportConversation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "messaging_service/v1/messaging.proto",
 }
@@ -1132,6 +1132,277 @@ var _ interface {
 	ErrorName() string
 } = SearchUsersResponseValidationError{}
 
+// Validate checks the field values on GetUsersByNicknamesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersByNicknamesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersByNicknamesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersByNicknamesRequestMultiError, or nil if none found.
+func (m *GetUsersByNicknamesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersByNicknamesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNicknames()); l < 1 || l > 50 {
+		err := GetUsersByNicknamesRequestValidationError{
+			field:  "Nicknames",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetUsersByNicknamesRequest_Nicknames_Unique := make(map[string]struct{}, len(m.GetNicknames()))
+
+	for idx, item := range m.GetNicknames() {
+		_, _ = idx, item
+
+		if _, exists := _GetUsersByNicknamesRequest_Nicknames_Unique[item]; exists {
+			err := GetUsersByNicknamesRequestValidationError{
+				field:  fmt.Sprintf("Nicknames[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetUsersByNicknamesRequest_Nicknames_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Nicknames[idx]
+	}
+
+	if len(errors) > 0 {
+		return GetUsersByNicknamesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersByNicknamesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUsersByNicknamesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUsersByNicknamesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersByNicknamesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersByNicknamesRequestMultiError) AllErrors() []error { return m }
+
+// GetUsersByNicknamesRequestValidationError is the validation error returned
+// by GetUsersByNicknamesRequest.Validate if the designated constraints aren't met.
+type GetUsersByNicknamesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersByNicknamesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersByNicknamesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersByNicknamesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersByNicknamesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersByNicknamesRequestValidationError) ErrorName() string {
+	return "GetUsersByNicknamesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsersByNicknamesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersByNicknamesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersByNicknamesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersByNicknamesRequestValidationError{}
+
+// Validate checks the field values on GetUsersByNicknamesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersByNicknamesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersByNicknamesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersByNicknamesResponseMultiError, or nil if none found.
+func (m *GetUsersByNicknamesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersByNicknamesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsersByNicknamesResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsersByNicknamesResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsersByNicknamesResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUsersByNicknamesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersByNicknamesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUsersByNicknamesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUsersByNicknamesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersByNicknamesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersByNicknamesResponseMultiError) AllErrors() []error { return m }
+
+// GetUsersByNicknamesResponseValidationError is the validation error returned
+// by GetUsersByNicknamesResponse.Validate if the designated constraints
+// aren't met.
+type GetUsersByNicknamesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersByNicknamesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersByNicknamesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersByNicknamesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersByNicknamesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersByNicknamesResponseValidationError) ErrorName() string {
+	return "GetUsersByNicknamesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsersByNicknamesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersByNicknamesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersByNicknamesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersByNicknamesResponseValidationError{}
+
 // Validate checks the field values on UserProfile with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUser_FullMethodName           = "/api.user_service.v1.UserService/GetUser"
-	UserService_CreateUserProfile_FullMethodName = "/api.user_service.v1.UserService/CreateUserProfile"
-	UserService_UpdateUserProfile_FullMethodName = "/api.user_service.v1.UserService/UpdateUserProfile"
-	UserService_SearchUsers_FullMethodName       = "/api.user_service.v1.UserService/SearchUsers"
+	UserService_GetUser_FullMethodName             = "/api.user_service.v1.UserService/GetUser"
+	UserService_CreateUserProfile_FullMethodName   = "/api.user_service.v1.UserService/CreateUserProfile"
+	UserService_UpdateUserProfile_FullMethodName   = "/api.user_service.v1.UserService/UpdateUserProfile"
+	UserService_SearchUsers_FullMethodName         = "/api.user_service.v1.UserService/SearchUsers"
+	UserService_GetUsersByNicknames_FullMethodName = "/api.user_service.v1.UserService/GetUsersByNicknames"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -39,6 +40,8 @@ type UserServiceClient interface {
 	UpdateUserProfile(ctx context.Context, in *UpdateUserProfileRequest, opts ...grpc.CallOption) (*UpdateUserProfileResponse, error)
 	// Поиск пользователей
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	// Получение профилей по точным никнеймам, используется другими сервисами
+	GetUsersByNicknames(ctx context.Context, in *GetUsersByNicknamesRequest, opts ...grpc.CallOption) (*GetUsersByNicknamesResponse, error)
 }
 
 type userServiceClient struct {
@@ -89,6 +92,16 @@ func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequ
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersByNicknames(ctx context.Context, in *GetUsersByNicknamesRequest, opts ...grpc.CallOption) (*GetUsersByNicknamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByNicknamesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByNicknames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -103,6 +116,8 @@ type UserServiceServer interface {
 	UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error)
 	// Поиск пользователей
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// Получение профилей по точным никнеймам, используется другими сервисами
+	GetUsersByNicknames(context.Context, *GetUsersByNicknamesRequest) (*GetUsersByNicknamesResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -125,6 +140,9 @@ func (UnimplementedUserServiceServer) UpdateUserProfile(context.Context, *Update
 func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersByNicknames(context.Context, *GetUsersByNicknamesRequest) (*GetUsersByNicknamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByNicknames not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,6 +236,24 @@ func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUsersByNicknames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByNicknamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByNicknames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByNicknames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByNicknames(ctx, req.(*GetUsersByNicknamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +277,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchUsers",
 			Handler:    _UserService_SearchUsers_Handler,
 		},
+		{
+			MethodName: "GetUsersByNicknames",
+			Handler:    _UserService_GetUsersByNicknames_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service/v1/user.proto",
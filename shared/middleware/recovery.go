@@ -26,3 +26,21 @@ func PanicRecoveryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// StreamPanicRecoveryInterceptor — аналог PanicRecoveryInterceptor для потоковых вызовов
+func StreamPanicRecoveryInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("Recovered from panic in stream %s: %v", info.FullMethod, r)
+				err = status.Errorf(codes.Internal, "Internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -11,6 +11,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// userIDKey — ключ контекста с пользователем из проверенного JWT
+type userIDKey struct{}
+
+// UserIDFromContext возвращает пользователя, проверенного UnaryAuthInterceptor или StreamAuthInterceptor
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(string)
+	return userID, ok && userID != ""
+}
+
 func UnaryAuthInterceptor(jwtSecret string) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -18,33 +27,82 @@ func UnaryAuthInterceptor(jwtSecret string) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata not provided")
+		ctx, err := authenticate(ctx, jwtSecret)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization token not provided")
+// StreamAuthInterceptor проверяет JWT у перечисленных потоковых методов, остальные пропускает без проверки
+func StreamAuthInterceptor(jwtSecret string, methods ...string) grpc.StreamServerInterceptor {
+	protected := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		protected[method] = true
+	}
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !protected[info.FullMethod] {
+			return handler(srv, ss)
 		}
-
-		tokenString := authHeaders[0]
-		if len(tokenString) > 7 && tokenString[:7] == "Bearer " {
-			tokenString = tokenString[7:]
-		} else {
-			return nil, status.Error(codes.Unauthenticated, "invalid token format")
+		ctx, err := authenticate(ss.Context(), jwtSecret)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtSecret), nil
-		})
+// authenticatedStream подменяет контекст потока контекстом с пользователем
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		if err != nil || !token.Valid {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
-		}
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
 
-		ctx = context.WithValue(ctx, "userID", claims.UserID)
+// authenticate проверяет JWT из метаданных authorization и добавляет пользователя в контекст
+func authenticate(ctx context.Context, jwtSecret string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata not provided")
+	}
 
-		return handler(ctx, req)
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization token not provided")
+	}
+
+	tokenString := authHeaders[0]
+	if len(tokenString) > 7 && tokenString[:7] == "Bearer " {
+		tokenString = tokenString[7:]
+	} else {
+		return nil, status.Error(codes.Unauthenticated, "invalid token format")
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(jwtSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodHS384.Alg(), jwt.SigningMethodHS512.Alg()}))
+
+	if err != nil || !token.Valid {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid token claims")
+	}
+	userID, _ := claims["user_id"].(string)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "token does not contain user_id")
 	}
+
+	return context.WithValue(ctx, userIDKey{}, userID), nil
 }
@@ -0,0 +1,155 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	defaultMaxLen    = 1000
+	defaultRetention = 24 * time.Hour
+	defaultBlock     = 5 * time.Second
+	retryInterval    = time.Second
+)
+
+// Message — событие, прочитанное из топика
+type Message struct {
+	ID      string
+	Type    string
+	Payload []byte
+}
+
+// Broker — общий для всех реплик брокер событий поверх Redis Streams.
+// Каждый топик хранит ограниченный хвост событий, поэтому подписчик может
+// продолжить чтение с последнего полученного идентификатора.
+type Broker interface {
+	Publish(ctx context.Context, topic, eventType string, payload []byte) (string, error)
+	Subscribe(ctx context.Context, topic, lastID string) (*Subscription, error)
+}
+
+type redisBroker struct {
+	client    *redis.Client
+	maxLen    int64
+	retention time.Duration
+}
+
+func NewRedisBroker(client *redis.Client) Broker {
+	return &redisBroker{
+		client:    client,
+		maxLen:    defaultMaxLen,
+		retention: defaultRetention,
+	}
+}
+
+// UserTopic возвращает имя топика персональных событий пользователя
+func UserTopic(userID string) string {
+	return fmt.Sprintf("user:%s", userID)
+}
+
+func streamKey(topic string) string {
+	return fmt.Sprintf("events:%s", topic)
+}
+
+func (b *redisBroker) Publish(ctx context.Context, topic, eventType string, payload []byte) (string, error) {
+	key := streamKey(topic)
+
+	pipe := b.client.TxPipeline()
+	add := pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: b.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"type":    eventType,
+			"payload": payload,
+		},
+	})
+	pipe.Expire(ctx, key, b.retention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to publish event to %s: %w", topic, err)
+	}
+	return add.Val(), nil
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, topic, lastID string) (*Subscription, error) {
+	key := streamKey(topic)
+
+	// Без lastID читаем только события, опубликованные после подписки.
+	// Фиксируем текущий хвост потока сразу, чтобы не потерять события между чтениями.
+	if lastID == "" {
+		tail, err := b.client.XRevRangeN(ctx, key, "+", "-", 1).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tail of %s: %w", topic, err)
+		}
+		lastID = "0-0"
+		if len(tail) > 0 {
+			lastID = tail[0].ID
+		}
+	}
+
+	sub := &Subscription{
+		messages: make(chan Message),
+	}
+	go sub.run(ctx, b.client, key, lastID)
+	return sub, nil
+}
+
+// Subscription — подписка на топик. Канал сообщений закрывается при отмене контекста.
+type Subscription struct {
+	messages chan Message
+}
+
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+func (s *Subscription) run(ctx context.Context, client *redis.Client, key, lastID string) {
+	defer close(s.messages)
+
+	for ctx.Err() == nil {
+		streams, err := client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{key, lastID},
+			Block:   defaultBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("error reading stream %s: %v", key, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				lastID = msg.ID
+				select {
+				case s.messages <- decodeMessage(msg):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func decodeMessage(msg redis.XMessage) Message {
+	eventType, _ := msg.Values["type"].(string)
+	payload, _ := msg.Values["payload"].(string)
+	return Message{
+		ID:      msg.ID,
+		Type:    eventType,
+		Payload: []byte(payload),
+	}
+}
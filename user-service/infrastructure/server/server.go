@@ -29,9 +29,10 @@ func SetupGRPCServer(session *gocql.Session) (*grpc.Server, error) {
 	createUserUC := user.NewCreateUserProfileUsecase(userRepo)
 	updateUserUC := user.NewUpdateUserProfileUsecase(userRepo)
 	searchUsersUC := user.NewSearchUsersUsecase(userRepo)
+	getUsersByNicknamesUC := user.NewGetUsersByNicknamesUsecase(userRepo)
 
 	// Инициализация gRPC хендлера
-	userHandler := handlers.NewUserHandler(getUserUC, createUserUC, updateUserUC, searchUsersUC)
+	userHandler := handlers.NewUserHandler(getUserUC, createUserUC, updateUserUC, searchUsersUC, getUsersByNicknamesUC)
 
 	recoveryInterceptor := middleware.PanicRecoveryInterceptor()
 	// Создание gRPC сервера и регистрация сервисов
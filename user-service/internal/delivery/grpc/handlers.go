@@ -13,10 +13,11 @@ import (
 
 type UserHandler struct {
 	pb.UnimplementedUserServiceServer
-	getUserUsecase           user.GetUserUsecase
-	createUserProfileUsecase user.CreateUserProfileUsecase
-	updateUserProfileUsecase user.UpdateUserProfileUsecase
-	searchUsersUsecase       user.SearchUsersUsecase
+	getUserUsecase             user.GetUserUsecase
+	createUserProfileUsecase   user.CreateUserProfileUsecase
+	updateUserProfileUsecase   user.UpdateUserProfileUsecase
+	searchUsersUsecase         user.SearchUsersUsecase
+	getUsersByNicknamesUsecase user.GetUsersByNicknamesUsecase
 }
 
 func NewUserHandler(
@@ -24,12 +25,14 @@ func NewUserHandler(
 	createUserUC user.CreateUserProfileUsecase,
 	updateUserUC user.UpdateUserProfileUsecase,
 	searchUsersUC user.SearchUsersUsecase,
+	getUsersByNicknamesUC user.GetUsersByNicknamesUsecase,
 ) *UserHandler {
 	return &UserHandler{
-		getUserUsecase:           getUserUC,
-		createUserProfileUsecase: createUserUC,
-		updateUserProfileUsecase: updateUserUC,
-		searchUsersUsecase:       searchUsersUC,
+		getUserUsecase:             getUserUC,
+		createUserProfileUsecase:   createUserUC,
+		updateUserProfileUsecase:   updateUserUC,
+		searchUsersUsecase:         searchUsersUC,
+		getUsersByNicknamesUsecase: getUsersByNicknamesUC,
 	}
 }
 
@@ -111,6 +114,26 @@ func (h *UserHandler) SearchUsers(ctx context.Context, req *pb.SearchUsersReques
 	}, nil
 }
 
+func (h *UserHandler) GetUsersByNicknames(ctx context.Context, req *pb.GetUsersByNicknamesRequest) (*pb.GetUsersByNicknamesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+	}
+
+	profiles, err := h.getUsersByNicknamesUsecase.Execute(ctx, req.Nicknames)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting users by nicknames: %v", err)
+	}
+
+	protoProfiles := make([]*pb.UserProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		protoProfiles = append(protoProfiles, mapProfileToProto(profile))
+	}
+
+	return &pb.GetUsersByNicknamesResponse{
+		Users: protoProfiles,
+	}, nil
+}
+
 func mapProfileToProto(profile *models.UserProfile) *pb.UserProfile {
 	return &pb.UserProfile{
 		UserId:    profile.UserID.String(),
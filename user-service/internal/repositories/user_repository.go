@@ -16,6 +16,7 @@ type UserRepository interface {
 	UpdateUserProfile(ctx context.Context, profile *models.UserProfile) error
 	SearchUsers(ctx context.Context, query string, limit, offset int) ([]*models.UserProfile, error)
 	NicknameExists(ctx context.Context, nickname string) (bool, error)
+	GetUsersByNicknames(ctx context.Context, nicknames []string) ([]*models.UserProfile, error)
 }
 
 type userRepository struct {
@@ -107,3 +108,24 @@ func (r *userRepository) NicknameExists(ctx context.Context, nickname string) (b
 
 	return count > 0, nil
 }
+
+// GetUsersByNicknames получает профили по точным никнеймам.
+// Вторичный индекс не поддерживает IN, поэтому никнеймы ищутся по одному.
+func (r *userRepository) GetUsersByNicknames(ctx context.Context, nicknames []string) ([]*models.UserProfile, error) {
+	stmt, names := qb.Select("user_profiles").
+		Where(qb.Eq("nickname")).
+		ToCql()
+
+	var profiles []*models.UserProfile
+	for _, nickname := range nicknames {
+		var found []*models.UserProfile
+		q := gocqlx.Query(r.session.Query(stmt), names).WithContext(ctx)
+		err := q.BindMap(qb.M{"nickname": nickname}).SelectRelease(&found)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, found...)
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+
+	"github.com/malytinKonstantin/go-messenger-mono/user-service/internal/models"
+	"github.com/malytinKonstantin/go-messenger-mono/user-service/internal/repositories"
+)
+
+type GetUsersByNicknamesUsecase interface {
+	Execute(ctx context.Context, nicknames []string) ([]*models.UserProfile, error)
+}
+
+type getUsersByNicknamesUsecase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewGetUsersByNicknamesUsecase(userRepo repositories.UserRepository) GetUsersByNicknamesUsecase {
+	return &getUsersByNicknamesUsecase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *getUsersByNicknamesUsecase) Execute(ctx context.Context, nicknames []string) ([]*models.UserProfile, error) {
+	return uc.userRepo.GetUsersByNicknames(ctx, nicknames)
+}